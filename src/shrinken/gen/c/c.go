@@ -0,0 +1,1028 @@
+package c
+
+import (
+	"fmt"
+	"math"
+	"path/filepath"
+	"shrinken/gen"
+	"shrinken/sddl"
+	"shrinken/sddl/ast"
+	"strings"
+)
+
+// C backend generates header and source file per SDDL package, for C99 compilers. C has no
+// namespaces, so names of types and functions are prefixed by package name (game.net.Player
+// becomes game_net_Player). Structs and classes become structs, derived types contain their base
+// type as first member named base, so pointer to derived type can be cast to pointer of its base
+// type. Root of class hierarchy holds pointer to shrinken_class describing actual class of object as
+// its first member class_, which is written as subtype tag. Enums become enums. Every type gets
+// <type>_encode and <type>_decode functions and types marked with message attribute also get
+// <type>_serialize and <type>_deserialize. Messages marked with delta attribute also get
+// <type>_serialize_delta and <type>_deserialize_delta, which encode only fields changed against
+// baseline instance. Messages are also written with their IDs by <type>_serialize_any, which
+// <package>_decode_any dispatches to functions of <package>_handler.
+// Encoding writes into caller provided buffer. Strings, dynamic arrays and class references are
+// decoded into memory from shrinken_allocator given to reader, which can be arena over caller
+// provided buffer, so that nothing is allocated on heap.
+
+type generator struct{}
+
+func init() {
+	gen.Register("c", &generator{})
+}
+
+func (g *generator) Generate(parsed *sddl.SDDLTree, outputPath string) error {
+	schema, err := gen.NewSchema(parsed)
+	if err != nil {
+		return err
+	}
+
+	// headers can't include each other, so packages can't depend on each other
+	if cycle := schema.DependencyCycle(); cycle != nil {
+		names := make([]string, len(cycle))
+		for i, pkg := range cycle {
+			names[i] = pkg.Name
+		}
+		return fmt.Errorf("C backend doesn't support circular dependency between packages (%v)", strings.Join(names, ", "))
+	}
+
+	err = gen.WriteFile(filepath.Join(outputPath, "shrinken.h"), []byte(runtimeHeader))
+	if err != nil {
+		return err
+	}
+
+	err = gen.WriteFile(filepath.Join(outputPath, "shrinken.c"), []byte(runtimeSource))
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range schema.Packages {
+		f := &packageFile{
+			schema:   schema,
+			pkg:      pkg,
+			defaults: make(map[*gen.Struct]bool),
+		}
+
+		header, source, err := f.generate()
+		if err != nil {
+			return err
+		}
+
+		err = gen.WriteFile(filepath.Join(outputPath, pkg.ExportedName+".h"), header)
+		if err != nil {
+			return err
+		}
+
+		err = gen.WriteFile(filepath.Join(outputPath, pkg.ExportedName+".c"), source)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type packageFile struct {
+	schema   *gen.Schema
+	pkg      *gen.Package
+	defaults map[*gen.Struct]bool // classes whose default instance is used for encoding NULL references
+	huffman  gen.HuffmanTables
+	err      error
+}
+
+func (f *packageFile) generate() ([]byte, []byte, error) {
+	guard := strings.ToUpper(prefix(f.pkg)) + "_H"
+	structs := f.pkg.DeclarationOrder()
+
+	h := gen.NewCodeWriter("    ")
+	h.Line("/* Code generated by shrinken. DO NOT EDIT. */")
+	h.Line("")
+	h.Line("#ifndef %v", guard)
+	h.Line("#define %v", guard)
+	h.Line("")
+	h.Line("#include \"shrinken.h\"")
+	for _, dep := range f.pkg.Dependencies() {
+		h.Line("#include \"%v.h\"", dep.ExportedName)
+	}
+	h.Line("")
+	h.Line("#ifdef __cplusplus")
+	h.Line("extern \"C\" {")
+	h.Line("#endif")
+	h.Line("")
+
+	for _, s := range f.pkg.Structs {
+		h.Line("typedef struct %v %v;", structName(s), structName(s))
+	}
+	if len(f.pkg.Structs) > 0 {
+		h.Line("")
+	}
+
+	for _, e := range f.pkg.Enums {
+		f.writeEnum(h, e)
+	}
+
+	for _, s := range structs {
+		f.writeStruct(h, s)
+	}
+
+	for _, e := range f.pkg.Enums {
+		f.writeDeclarations(h, enumName(e), e.IsMessage, e.Id)
+	}
+	for _, s := range structs {
+		f.writeDeclarations(h, structName(s), s.IsMessage, s.Id)
+		if s.HasDelta {
+			f.writeDeltaDeclarations(h, s)
+		}
+		if inHierarchy(s) {
+			h.Line("extern const shrinken_class %v;", className(s))
+			h.Line("extern const shrinken_class* const %v[%v];", subtypesName(s), len(s.Subtypes()))
+			h.Line("")
+		}
+	}
+
+	messages := f.pkg.Messages()
+	if len(messages) > 0 {
+		f.writeHandler(h, messages)
+	}
+
+	h.Line("#ifdef __cplusplus")
+	h.Line("}")
+	h.Line("#endif")
+	h.Line("")
+	h.Line("#endif")
+
+	body := gen.NewCodeWriter("    ")
+	for _, e := range f.pkg.Enums {
+		f.writeEnumFunctions(body, e)
+	}
+	for _, s := range structs {
+		f.writeStructFunctions(body, s)
+	}
+	if len(messages) > 0 {
+		f.writeDecodeAny(body, messages)
+	}
+
+	c := gen.NewCodeWriter("    ")
+	c.Line("/* Code generated by shrinken. DO NOT EDIT. */")
+	c.Line("")
+	c.Line("#include \"%v.h\"", f.pkg.ExportedName)
+	c.Line("")
+
+	// defaults are zero initialized, which is also value of new objects in other languages
+	defaults := false
+	for _, pkg := range f.schema.Packages {
+		for _, s := range pkg.Structs {
+			if f.defaults[s] {
+				c.Line("static const %v %v;", structName(s), defaultName(s))
+				defaults = true
+			}
+		}
+	}
+	if defaults {
+		c.Line("")
+	}
+	for i, code := range f.huffman.Tables {
+		c.Line("/* Huffman code of %v */", code.Name)
+		c.Line("static const uint16_t huffman%v_codes[] = {%v};", i, gen.JoinInts(code.Codes))
+		c.Line("static const uint8_t huffman%v_lengths[] = {%v};", i, gen.JoinInts(code.Lengths))
+		c.Line("static const uint16_t huffman%v_counts[] = {%v};", i, gen.JoinInts(code.Counts))
+		c.Line("static const uint8_t huffman%v_symbols[] = {%v};", i, gen.JoinInts(code.Symbols))
+		c.Line("static const shrinken_huffman huffman%v = {huffman%v_codes, huffman%v_lengths, %v, huffman%v_counts, %v, huffman%v_symbols};",
+			i, i, i, len(code.Codes), i, code.MaxLength(), i)
+		c.Line("")
+	}
+	c.Raw(string(body.Bytes()))
+
+	return h.Bytes(), c.Bytes(), f.err
+}
+
+func (f *packageFile) writeEnum(w *gen.CodeWriter, e *gen.Enum) {
+	name := enumName(e)
+
+	w.Line("typedef enum %v {", name)
+	w.Indent()
+	for i, value := range e.Values {
+		if i < len(e.Values)-1 {
+			w.Line("%v_%v = %v,", name, value, i)
+		} else {
+			w.Line("%v_%v = %v", name, value, i)
+		}
+	}
+	w.Dedent()
+	w.Line("} %v;", name)
+	w.Line("")
+}
+
+func (f *packageFile) writeStruct(w *gen.CodeWriter, s *gen.Struct) {
+	w.Line("struct %v {", structName(s))
+	w.Indent()
+	if s.Base != nil {
+		w.Line("%v base;", structName(s.Base))
+	} else if inHierarchy(s) {
+		w.Line("const shrinken_class* class_;")
+	}
+	for _, field := range s.Fields {
+		w.Line("%v;", f.declare(field.Codec, fieldName(field.ExportedName)))
+	}
+	if s.Base == nil && len(s.Fields) == 0 && !inHierarchy(s) {
+		// C doesn't allow empty structs
+		w.Line("uint8_t unused_;")
+	}
+	w.Dedent()
+	w.Line("};")
+	w.Line("")
+}
+
+func (f *packageFile) writeDeclarations(w *gen.CodeWriter, name string, isMessage bool, id uint16) {
+	w.Line("void %v_encode(shrinken_writer* w, const %v* v);", name, name)
+	w.Line("void %v_decode(shrinken_reader* r, %v* v);", name, name)
+	if isMessage {
+		w.Line("/* %v_serialize writes v to buf and stores number of written bytes to size */", name)
+		w.Line("shrinken_error %v_serialize(const %v* v, uint8_t* buf, size_t capacity, size_t* size);", name, name)
+		w.Line("shrinken_error %v_deserialize(%v* v, const uint8_t* data, size_t size, const shrinken_allocator* allocator);", name, name)
+		w.Line("#define %v_ID %v", name, id)
+		w.Line("/* %v_serialize_any writes %v_ID followed by v */", name, name)
+		w.Line("shrinken_error %v_serialize_any(const %v* v, uint8_t* buf, size_t capacity, size_t* size);", name, name)
+	}
+	w.Line("")
+}
+
+func (f *packageFile) writeDeltaDeclarations(w *gen.CodeWriter, s *gen.Struct) {
+	name := structName(s)
+
+	w.Line("bool %v_differs(const %v* v, const %v* baseline);", name, name, name)
+	w.Line("void %v_encode_delta(shrinken_writer* w, const %v* v, const %v* baseline);", name, name, name)
+	w.Line("void %v_decode_delta(shrinken_reader* r, %v* v);", name, name)
+	if s.IsDelta {
+		w.Line("/* %v_serialize_delta writes fields of v changed against baseline to buf */", name)
+		w.Line("shrinken_error %v_serialize_delta(const %v* v, const %v* baseline, uint8_t* buf, size_t capacity, size_t* size);", name, name, name)
+		w.Line("/* %v_deserialize_delta applies delta to v, which has to hold baseline the delta was written against */", name)
+		w.Line("shrinken_error %v_deserialize_delta(%v* v, const uint8_t* data, size_t size, const shrinken_allocator* allocator);", name, name)
+	}
+	w.Line("")
+}
+
+func (f *packageFile) writeEnumFunctions(w *gen.CodeWriter, e *gen.Enum) {
+	name := enumName(e)
+	codec := e.Codec()
+
+	w.Line("void %v_encode(shrinken_writer* w, const %v* v) {", name, name)
+	w.Indent()
+	w.Line("if ((uint32_t)*v >= %v) {", len(e.Values))
+	w.Indent()
+	w.Line("shrinken_writer_fail(w, SHRINKEN_ERROR_INVALID_VALUE);")
+	w.Line("return;")
+	w.Dedent()
+	w.Line("}")
+	w.Line("shrinken_write_bits(w, (uint64_t)*v, %v);", codec.Bits)
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("void %v_decode(shrinken_reader* r, %v* v) {", name, name)
+	w.Indent()
+	w.Line("uint64_t value = shrinken_read_bits(r, %v);", codec.Bits)
+	w.Line("if (value >= %v) {", len(e.Values))
+	w.Indent()
+	w.Line("shrinken_reader_fail(r, SHRINKEN_ERROR_INVALID_VALUE);")
+	w.Line("value = 0;")
+	w.Dedent()
+	w.Line("}")
+	w.Line("*v = (%v)value;", name)
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if e.IsMessage {
+		f.writeMessageFunctions(w, name, e.ByteAligned)
+	}
+}
+
+func (f *packageFile) writeStructFunctions(w *gen.CodeWriter, s *gen.Struct) {
+	name := structName(s)
+
+	w.Line("void %v_encode(shrinken_writer* w, const %v* v) {", name, name)
+	w.Indent()
+	if s.Base != nil {
+		w.Line("%v_encode(w, &v->base);", structName(s.Base))
+	} else if len(s.Fields) == 0 {
+		w.Line("(void)w;")
+		w.Line("(void)v;")
+	}
+	if c := s.Quaternion; c != nil {
+		q := c.Quantization
+		w.Line("shrinken_write_quaternion(w, %v, %v, %v, UINT64_C(%v), %v);", componentFields(s, "v->"), q.MinString(), q.MaxString(), q.Steps, c.Bits)
+	} else if c := s.Normalized; c != nil {
+		w.Line("shrinken_write_normalized(w, %v, UINT64_C(%v), %v);", componentFields(s, "v->"), c.Quantization.Steps, c.Bits)
+	} else {
+		for _, field := range s.Fields {
+			f.encode(w, field.Codec, "v->"+fieldName(field.ExportedName), 0)
+		}
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("void %v_decode(shrinken_reader* r, %v* v) {", name, name)
+	w.Indent()
+	if s.Base != nil {
+		w.Line("%v_decode(r, &v->base);", structName(s.Base))
+	} else if len(s.Fields) == 0 {
+		w.Line("(void)r;")
+		if !inHierarchy(s) {
+			w.Line("v->unused_ = 0;")
+		} else {
+			w.Line("(void)v;")
+		}
+	}
+	if c := s.Quaternion; c != nil {
+		q := c.Quantization
+		w.Line("shrinken_read_quaternion(r, %v, %v, %v, UINT64_C(%v), %v);", componentFields(s, "&v->"), q.MinString(), q.MaxString(), q.Steps, c.Bits)
+	} else if c := s.Normalized; c != nil {
+		w.Line("shrinken_read_normalized(r, %v, UINT64_C(%v), %v);", componentFields(s, "&v->"), c.Quantization.Steps, c.Bits)
+	} else {
+		for _, field := range s.Fields {
+			f.decode(w, field.Codec, "v->"+fieldName(field.ExportedName), 0)
+		}
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if s.IsMessage {
+		f.writeMessageFunctions(w, name, s.ByteAligned)
+	}
+
+	if s.HasDelta {
+		f.writeDeltaFunctions(w, s)
+	}
+
+	if inHierarchy(s) {
+		f.writeClass(w, s)
+	}
+}
+
+// componentFields returns comma separated components of quaternion or normalized vector struct, each
+// prefixed by prefix
+func componentFields(s *gen.Struct, prefix string) string {
+	names := make([]string, len(s.Fields))
+	for i, field := range s.Fields {
+		names[i] = prefix + fieldName(field.ExportedName)
+	}
+	return strings.Join(names, ", ")
+}
+
+// writeClass defines descriptor of class and list of subtypes, which can be written to its references
+func (f *packageFile) writeClass(w *gen.CodeWriter, s *gen.Struct) {
+	name := structName(s)
+
+	w.Line("static void %v_encode_class(shrinken_writer* w, const void* v) {", name)
+	w.Indent()
+	w.Line("%v_encode(w, (const %v*)v);", name, name)
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("static void %v_decode_class(shrinken_reader* r, void* v) {", name)
+	w.Indent()
+	w.Line("%v_decode(r, (%v*)v);", name, name)
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("const shrinken_class %v = {%q, sizeof(%v), %v_encode_class, %v_decode_class};", className(s), s.QualifiedName(), name, name, name)
+	w.Line("")
+
+	// derived classes can be declared in packages which depend on this one, so their headers can't be included
+	subtypes := s.Subtypes()
+	external := false
+	for _, subtype := range subtypes {
+		if subtype.Package != f.pkg {
+			w.Line("extern const shrinken_class %v;", className(subtype))
+			external = true
+		}
+	}
+	if external {
+		w.Line("")
+	}
+
+	w.Line("const shrinken_class* const %v[%v] = {", subtypesName(s), len(subtypes))
+	w.Indent()
+	for i, subtype := range subtypes {
+		if i < len(subtypes)-1 {
+			w.Line("&%v,", className(subtype))
+		} else {
+			w.Line("&%v", className(subtype))
+		}
+	}
+	w.Dedent()
+	w.Line("};")
+	w.Line("")
+}
+
+func (f *packageFile) writeMessageFunctions(w *gen.CodeWriter, name string, byteAligned bool) {
+	w.Line("shrinken_error %v_serialize(const %v* v, uint8_t* buf, size_t capacity, size_t* size) {", name, name)
+	w.Indent()
+	w.Line("shrinken_writer w;")
+	w.Line("shrinken_writer_init(&w, buf, capacity);")
+	if byteAligned {
+		w.Line("w.byte_aligned = true;")
+	}
+	w.Line("%v_encode(&w, v);", name)
+	w.Line("if (size != NULL) {")
+	w.Indent()
+	w.Line("*size = shrinken_writer_size(&w);")
+	w.Dedent()
+	w.Line("}")
+	w.Line("return w.error;")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("shrinken_error %v_deserialize(%v* v, const uint8_t* data, size_t size, const shrinken_allocator* allocator) {", name, name)
+	w.Indent()
+	w.Line("shrinken_reader r;")
+	w.Line("shrinken_reader_init(&r, data, size, allocator);")
+	if byteAligned {
+		w.Line("r.byte_aligned = true;")
+	}
+	w.Line("%v_decode(&r, v);", name)
+	w.Line("return r.error;")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("shrinken_error %v_serialize_any(const %v* v, uint8_t* buf, size_t capacity, size_t* size) {", name, name)
+	w.Indent()
+	w.Line("size_t id_size, message_size;")
+	w.Line("shrinken_error error = shrinken_write_message_id(%v_ID, buf, capacity, &id_size);", name)
+	w.Line("if (error == SHRINKEN_OK) {")
+	w.Indent()
+	w.Line("error = %v_serialize(v, buf + id_size, capacity - id_size, &message_size);", name)
+	w.Dedent()
+	w.Line("}")
+	w.Line("if (error == SHRINKEN_OK && size != NULL) {")
+	w.Indent()
+	w.Line("*size = id_size + message_size;")
+	w.Dedent()
+	w.Line("}")
+	w.Line("return error;")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+}
+
+// writeHandler declares handler with function for each message of package, which decode_any calls with
+// decoded message
+func (f *packageFile) writeHandler(w *gen.CodeWriter, messages []*gen.Message) {
+	name := prefix(f.pkg) + "_handler"
+
+	w.Line("/* %v handles messages decoded by %v_decode_any, each of them is passed to its function along", name, prefix(f.pkg))
+	w.Line("   with ctx. Messages without function are skipped. */")
+	w.Line("typedef struct %v {", name)
+	w.Indent()
+	for _, m := range messages {
+		w.Line("shrinken_error (*handle_%v)(void* ctx, %v* v);", gen.UpperFirst(m.ExportedName), messageName(m))
+	}
+	w.Line("void* ctx;")
+	w.Dedent()
+	w.Line("} %v;", name)
+	w.Line("")
+	w.Line("/* %v_decode_any deserializes message written by serialize_any function and passes it to handler,", prefix(f.pkg))
+	w.Line("   ID of message of another package is SHRINKEN_ERROR_UNKNOWN_MESSAGE */")
+	w.Line("shrinken_error %v_decode_any(const uint8_t* data, size_t size, const shrinken_allocator* allocator, const %v* handler);", prefix(f.pkg), name)
+	w.Line("")
+}
+
+func (f *packageFile) writeDecodeAny(w *gen.CodeWriter, messages []*gen.Message) {
+	w.Line("shrinken_error %v_decode_any(const uint8_t* data, size_t size, const shrinken_allocator* allocator, const %v_handler* handler) {", prefix(f.pkg), prefix(f.pkg))
+	w.Indent()
+	w.Line("uint16_t id;")
+	w.Line("size_t id_size;")
+	w.Line("shrinken_error error = shrinken_read_message_id(&id, data, size, &id_size);")
+	w.Line("if (error != SHRINKEN_OK) {")
+	w.Indent()
+	w.Line("return error;")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+	w.Line("switch (id) {")
+	for _, m := range messages {
+		name := messageName(m)
+		handle := "handler->handle_" + gen.UpperFirst(m.ExportedName)
+		w.Line("case %v_ID: {", name)
+		w.Indent()
+		w.Line("%v v;", name)
+		w.Line("error = %v_deserialize(&v, data + id_size, size - id_size, allocator);", name)
+		w.Line("if (error != SHRINKEN_OK || %v == NULL) {", handle)
+		w.Indent()
+		w.Line("return error;")
+		w.Dedent()
+		w.Line("}")
+		w.Line("return %v(handler->ctx, &v);", handle)
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Line("default:")
+	w.Indent()
+	w.Line("return SHRINKEN_ERROR_UNKNOWN_MESSAGE;")
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+}
+
+// writeDeltaFunctions defines functions comparing struct with baseline and encoding it as delta against it
+func (f *packageFile) writeDeltaFunctions(w *gen.CodeWriter, s *gen.Struct) {
+	name := structName(s)
+	fields := s.AllFields()
+	paths := fieldPaths(s)
+
+	// C doesn't allow arrays of zero length
+	size := len(fields)
+	if size == 0 {
+		size = 1
+	}
+
+	w.Line("static void %v_changed_fields(const %v* v, const %v* baseline, bool* changed) {", name, name, name)
+	w.Indent()
+	f.writeDefaults(w, s, "v", "baseline")
+	for i, field := range fields {
+		f.compare(w, field.Codec, "v->"+paths[i], "baseline->"+paths[i], fmt.Sprintf("changed[%v]", i), 0)
+	}
+	if len(fields) == 0 {
+		w.Line("(void)v;")
+		w.Line("(void)baseline;")
+		w.Line("changed[0] = false;")
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("bool %v_differs(const %v* v, const %v* baseline) {", name, name, name)
+	w.Indent()
+	w.Line("bool changed[%v];", size)
+	w.Line("%v_changed_fields(v, baseline, changed);", name)
+	w.Line("for (uint32_t i = 0; i < %v; i++) {", len(fields))
+	w.Indent()
+	w.Line("if (changed[i]) {")
+	w.Indent()
+	w.Line("return true;")
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Line("return false;")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("void %v_encode_delta(shrinken_writer* w, const %v* v, const %v* baseline) {", name, name, name)
+	w.Indent()
+	w.Line("bool changed[%v];", size)
+	f.writeDefaults(w, s, "v", "baseline")
+	w.Line("%v_changed_fields(v, baseline, changed);", name)
+	w.Line("for (uint32_t i = 0; i < %v; i++) {", len(fields))
+	w.Indent()
+	w.Line("shrinken_write_bool(w, changed[i]);")
+	w.Dedent()
+	w.Line("}")
+	for i, field := range fields {
+		c := field.Codec
+		w.Line("if (changed[%v]) {", i)
+		w.Indent()
+		if c.IsDelta() && c.Struct.IsClass {
+			w.Line("%v_encode_delta(w, v->%v, baseline->%v);", structName(c.Struct), paths[i], paths[i])
+		} else if c.IsDelta() {
+			w.Line("%v_encode_delta(w, &v->%v, &baseline->%v);", structName(c.Struct), paths[i], paths[i])
+		} else {
+			f.encode(w, c, "v->"+paths[i], 0)
+		}
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("void %v_decode_delta(shrinken_reader* r, %v* v) {", name, name)
+	w.Indent()
+	w.Line("bool changed[%v];", size)
+	w.Line("for (uint32_t i = 0; i < %v; i++) {", len(fields))
+	w.Indent()
+	w.Line("changed[i] = shrinken_read_bool(r);")
+	w.Dedent()
+	w.Line("}")
+	if len(fields) == 0 {
+		w.Line("(void)v;")
+		w.Line("(void)changed;")
+	}
+	for i, field := range fields {
+		c := field.Codec
+		expr := "v->" + paths[i]
+		w.Line("if (changed[%v]) {", i)
+		w.Indent()
+		if c.IsDelta() && c.Struct.IsClass {
+			// missing object of baseline is default one
+			f.defaults[c.Struct] = true
+			w.Line("if (%v == NULL) {", expr)
+			w.Indent()
+			w.Line("%v = (%v*)shrinken_read_alloc(r, 1, sizeof(%v));", expr, structName(c.Struct), structName(c.Struct))
+			w.Line("if (%v != NULL) {", expr)
+			w.Indent()
+			w.Line("*%v = %v;", expr, defaultName(c.Struct))
+			w.Dedent()
+			w.Line("}")
+			w.Dedent()
+			w.Line("}")
+			w.Line("if (%v != NULL) {", expr)
+			w.Indent()
+			w.Line("%v_decode_delta(r, %v);", structName(c.Struct), expr)
+			w.Dedent()
+			w.Line("}")
+		} else if c.IsDelta() {
+			w.Line("%v_decode_delta(r, &%v);", structName(c.Struct), expr)
+		} else {
+			f.decode(w, c, expr, 0)
+		}
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if s.IsDelta {
+		w.Line("shrinken_error %v_serialize_delta(const %v* v, const %v* baseline, uint8_t* buf, size_t capacity, size_t* size) {", name, name, name)
+		w.Indent()
+		w.Line("shrinken_writer w;")
+		w.Line("shrinken_writer_init(&w, buf, capacity);")
+		if s.ByteAligned {
+			w.Line("w.byte_aligned = true;")
+		}
+		w.Line("%v_encode_delta(&w, v, baseline);", name)
+		w.Line("if (size != NULL) {")
+		w.Indent()
+		w.Line("*size = shrinken_writer_size(&w);")
+		w.Dedent()
+		w.Line("}")
+		w.Line("return w.error;")
+		w.Dedent()
+		w.Line("}")
+		w.Line("")
+
+		w.Line("shrinken_error %v_deserialize_delta(%v* v, const uint8_t* data, size_t size, const shrinken_allocator* allocator) {", name, name)
+		w.Indent()
+		w.Line("shrinken_reader r;")
+		w.Line("shrinken_reader_init(&r, data, size, allocator);")
+		if s.ByteAligned {
+			w.Line("r.byte_aligned = true;")
+		}
+		w.Line("%v_decode_delta(&r, v);", name)
+		w.Line("return r.error;")
+		w.Dedent()
+		w.Line("}")
+		w.Line("")
+	}
+}
+
+// writeDefaults replaces NULL class pointers by default instance
+func (f *packageFile) writeDefaults(w *gen.CodeWriter, s *gen.Struct, names ...string) {
+	if !s.IsClass {
+		return
+	}
+	f.defaults[s] = true
+	for _, name := range names {
+		w.Line("if (%v == NULL) {", name)
+		w.Indent()
+		w.Line("%v = &%v;", name, defaultName(s))
+		w.Dedent()
+		w.Line("}")
+	}
+}
+
+// compare writes statements setting target to whether value a is changed against b, as defined for delta
+// encoding. References to polymorphic classes are always changed.
+func (f *packageFile) compare(w *gen.CodeWriter, c *gen.Codec, a, b, target string, depth int) {
+	switch c.Kind {
+	case gen.StructCodec:
+		if c.IsPolymorphic() {
+			w.Line("%v = true;", target)
+		} else if c.Struct.IsClass {
+			w.Line("%v = %v_differs(%v, %v);", target, structName(c.Struct), a, b)
+		} else {
+			w.Line("%v = %v_differs(&%v, &%v);", target, structName(c.Struct), a, b)
+		}
+	case gen.StringCodec:
+		w.Line("%v = shrinken_string_differs(&%v, &%v);", target, a, b)
+	case gen.ArrayCodec:
+		i := fmt.Sprintf("i%v", depth)
+		if c.IsDynamic() {
+			w.Line("%v = %v.count != %v.count;", target, a, b)
+			w.Line("for (uint32_t %v = 0; !%v && %v < %v.count; %v++) {", i, target, i, a, i)
+			w.Indent()
+			f.compare(w, c.Elem, fmt.Sprintf("%v.data[%v]", a, i), fmt.Sprintf("%v.data[%v]", b, i), target, depth+1)
+		} else {
+			w.Line("%v = false;", target)
+			w.Line("for (uint32_t %v = 0; !%v && %v < %v; %v++) {", i, target, i, c.Size, i)
+			w.Indent()
+			f.compare(w, c.Elem, fmt.Sprintf("%v[%v]", a, i), fmt.Sprintf("%v[%v]", b, i), target, depth+1)
+		}
+		w.Dedent()
+		w.Line("}")
+	default:
+		w.Line("%v = %v != %v;", target, a, b)
+	}
+}
+
+func (f *packageFile) encode(w *gen.CodeWriter, c *gen.Codec, expr string, depth int) {
+	switch c.Kind {
+	case gen.BoolCodec:
+		w.Line("shrinken_write_bool(w, %v);", expr)
+	case gen.IntCodec, gen.CharCodec:
+		if c.IsASCII() {
+			w.Line("shrinken_write_ascii_char(w, %v);", expr)
+		} else if c.Huffman != nil && c.Signed {
+			w.Line("shrinken_write_signed_range_symbol(w, %v, %v, %v, &huffman%v);", expr, signedLiteral(c.Range.Min), signedLiteral(c.Range.Max), f.huffman.Index(c.Huffman))
+		} else if c.Huffman != nil {
+			w.Line("shrinken_write_unsigned_range_symbol(w, %v, UINT64_C(%v), UINT64_C(%v), &huffman%v);", expr, c.Range.Min, c.Range.Max, f.huffman.Index(c.Huffman))
+		} else if c.Range != nil && c.Signed {
+			w.Line("shrinken_write_signed_range(w, %v, %v, %v, %v);", expr, signedLiteral(c.Range.Min), signedLiteral(c.Range.Max), c.Bits)
+		} else if c.Range != nil {
+			w.Line("shrinken_write_unsigned_range(w, %v, UINT64_C(%v), UINT64_C(%v), %v);", expr, c.Range.Min, c.Range.Max, c.Bits)
+		} else if c.Encoding == gen.VarintEncoding {
+			w.Line("shrinken_write_varint(w, (uint64_t)%v, %v);", expr, c.Bits)
+		} else if c.Encoding == gen.ZigZagEncoding {
+			w.Line("shrinken_write_zigzag(w, %v);", expr)
+		} else {
+			w.Line("shrinken_write_bits(w, (uint64_t)%v, %v);", expr, c.Bits)
+		}
+	case gen.FloatCodec:
+		if q := c.Quantization; q != nil {
+			w.Line("shrinken_write_quantized(w, %v, %v, %v, UINT64_C(%v), %v);", expr, q.MinString(), q.MaxString(), q.Steps, c.Bits)
+		} else if c.Bits == 32 {
+			w.Line("shrinken_write_float(w, %v);", expr)
+		} else {
+			w.Line("shrinken_write_double(w, %v);", expr)
+		}
+	case gen.StringCodec:
+		if c.IsASCII() {
+			w.Line("shrinken_write_ascii(w, &%v, UINT64_C(%v), %v);", expr, c.MaxLength, c.CountBits)
+		} else {
+			w.Line("shrinken_write_string(w, &%v, UINT64_C(%v), %v);", expr, c.MaxLength, c.CountBits)
+		}
+	case gen.EnumCodec:
+		if c.Huffman != nil {
+			w.Line("shrinken_write_symbol(w, (uint64_t)%v, &huffman%v);", expr, f.huffman.Index(c.Huffman))
+		} else {
+			w.Line("%v_encode(w, &%v);", enumName(c.Enum), expr)
+		}
+	case gen.StructCodec:
+		if inHierarchy(c.Struct) {
+			f.defaults[c.Struct] = true
+			w.Line("shrinken_write_class(w, %v != NULL ? %v : &%v, %v, %v, %v);", expr, expr, defaultName(c.Struct), subtypesName(c.Struct), len(c.Subtypes), c.Bits)
+		} else if c.Struct.IsClass {
+			// NULL references are encoded as default objects
+			f.defaults[c.Struct] = true
+			w.Line("%v_encode(w, %v != NULL ? %v : &%v);", structName(c.Struct), expr, expr, defaultName(c.Struct))
+		} else {
+			w.Line("%v_encode(w, &%v);", structName(c.Struct), expr)
+		}
+	case gen.ArrayCodec:
+		i := fmt.Sprintf("i%v", depth)
+		if c.IsDynamic() {
+			w.Line("shrinken_write_count(w, %v.count, UINT64_C(%v), %v);", expr, c.MaxCount, c.CountBits)
+			w.Line("for (uint32_t %v = 0; %v < %v.count; %v++) {", i, i, expr, i)
+			w.Indent()
+			f.encode(w, c.Elem, fmt.Sprintf("%v.data[%v]", expr, i), depth+1)
+		} else {
+			w.Line("for (uint32_t %v = 0; %v < %v; %v++) {", i, i, c.Size, i)
+			w.Indent()
+			f.encode(w, c.Elem, fmt.Sprintf("%v[%v]", expr, i), depth+1)
+		}
+		w.Dedent()
+		w.Line("}")
+	}
+}
+
+func (f *packageFile) decode(w *gen.CodeWriter, c *gen.Codec, expr string, depth int) {
+	switch c.Kind {
+	case gen.BoolCodec:
+		w.Line("%v = shrinken_read_bool(r);", expr)
+	case gen.IntCodec, gen.CharCodec:
+		if c.IsASCII() {
+			w.Line("%v = shrinken_read_ascii_char(r);", expr)
+		} else if c.Huffman != nil && c.Signed {
+			w.Line("%v = (%v)shrinken_read_signed_range_symbol(r, %v, &huffman%v);", expr, f.cType(c), signedLiteral(c.Range.Min), f.huffman.Index(c.Huffman))
+		} else if c.Huffman != nil {
+			w.Line("%v = (%v)shrinken_read_unsigned_range_symbol(r, UINT64_C(%v), &huffman%v);", expr, f.cType(c), c.Range.Min, f.huffman.Index(c.Huffman))
+		} else if c.Range != nil && c.Signed {
+			w.Line("%v = (%v)shrinken_read_signed_range(r, %v, %v, %v);", expr, f.cType(c), signedLiteral(c.Range.Min), signedLiteral(c.Range.Max), c.Bits)
+		} else if c.Range != nil {
+			w.Line("%v = (%v)shrinken_read_unsigned_range(r, UINT64_C(%v), UINT64_C(%v), %v);", expr, f.cType(c), c.Range.Min, c.Range.Max, c.Bits)
+		} else if c.Encoding == gen.VarintEncoding {
+			w.Line("%v = (%v)shrinken_read_varint(r, %v);", expr, f.cType(c), c.Bits)
+		} else if c.Encoding == gen.ZigZagEncoding {
+			w.Line("%v = (%v)shrinken_read_zigzag(r, %v);", expr, f.cType(c), c.Bits)
+		} else {
+			w.Line("%v = (%v)shrinken_read_bits(r, %v);", expr, f.cType(c), c.Bits)
+		}
+	case gen.FloatCodec:
+		if q := c.Quantization; q != nil {
+			w.Line("%v = shrinken_read_quantized(r, %v, %v, UINT64_C(%v), %v);", expr, q.MinString(), q.MaxString(), q.Steps, c.Bits)
+		} else if c.Bits == 32 {
+			w.Line("%v = shrinken_read_float(r);", expr)
+		} else {
+			w.Line("%v = shrinken_read_double(r);", expr)
+		}
+	case gen.StringCodec:
+		if c.IsASCII() {
+			w.Line("shrinken_read_ascii(r, &%v, UINT64_C(%v), %v);", expr, c.MaxLength, c.CountBits)
+		} else {
+			w.Line("shrinken_read_string(r, &%v, UINT64_C(%v), %v);", expr, c.MaxLength, c.CountBits)
+		}
+	case gen.EnumCodec:
+		if c.Huffman != nil {
+			w.Line("%v = (%v)shrinken_read_symbol(r, &huffman%v);", expr, enumName(c.Enum), f.huffman.Index(c.Huffman))
+		} else {
+			w.Line("%v_decode(r, &%v);", enumName(c.Enum), expr)
+		}
+	case gen.StructCodec:
+		name := structName(c.Struct)
+		if inHierarchy(c.Struct) {
+			w.Line("%v = (%v*)shrinken_read_class(r, %v, %v, %v);", expr, name, subtypesName(c.Struct), len(c.Subtypes), c.Bits)
+		} else if c.Struct.IsClass {
+			w.Line("%v = (%v*)shrinken_read_alloc(r, 1, sizeof(%v));", expr, name, name)
+			w.Line("if (%v != NULL) {", expr)
+			w.Indent()
+			w.Line("%v_decode(r, %v);", name, expr)
+			w.Dedent()
+			w.Line("}")
+		} else {
+			w.Line("%v_decode(r, &%v);", name, expr)
+		}
+	case gen.ArrayCodec:
+		i := fmt.Sprintf("i%v", depth)
+		if c.IsDynamic() {
+			w.Line("%v.count = shrinken_read_count(r, UINT64_C(%v), %v, UINT64_C(%v));", expr, c.MaxCount, c.CountBits, c.Elem.MinBits())
+			w.Line("%v.data = shrinken_read_alloc(r, %v.count, sizeof(*%v.data));", expr, expr, expr)
+			w.Line("if (%v.data == NULL) {", expr)
+			w.Indent()
+			w.Line("%v.count = 0;", expr)
+			w.Dedent()
+			w.Line("}")
+			w.Line("for (uint32_t %v = 0; %v < %v.count; %v++) {", i, i, expr, i)
+			w.Indent()
+			f.decode(w, c.Elem, fmt.Sprintf("%v.data[%v]", expr, i), depth+1)
+		} else {
+			w.Line("for (uint32_t %v = 0; %v < %v; %v++) {", i, i, c.Size, i)
+			w.Indent()
+			f.decode(w, c.Elem, fmt.Sprintf("%v[%v]", expr, i), depth+1)
+		}
+		w.Dedent()
+		w.Line("}")
+	}
+}
+
+// declare returns declaration of variable with given name. Arrays are declared inside out,
+// dynamic arrays become anonymous structs holding pointer to elements and their count.
+func (f *packageFile) declare(c *gen.Codec, name string) string {
+	if c.Kind != gen.ArrayCodec {
+		if strings.HasPrefix(name, "*") {
+			return f.cType(c) + "* " + name[1:]
+		}
+		return f.cType(c) + " " + name
+	}
+
+	if c.IsDynamic() {
+		return fmt.Sprintf("struct { %v; uint32_t count; } %v", f.declare(c.Elem, "*data"), name)
+	}
+
+	// pointer to array needs parentheses, int32_t (*data)[3] instead of int32_t *data[3]
+	if strings.HasPrefix(name, "*") {
+		name = "(" + name + ")"
+	}
+	return f.declare(c.Elem, fmt.Sprintf("%v[%v]", name, c.Size))
+}
+
+func (f *packageFile) cType(c *gen.Codec) string {
+	switch c.Kind {
+	case gen.StructCodec:
+		if c.Struct.IsClass {
+			return structName(c.Struct) + "*"
+		}
+		return structName(c.Struct)
+	case gen.EnumCodec:
+		return enumName(c.Enum)
+	}
+
+	switch c.Type.GenericType {
+	case ast.Integer32:
+		return "int32_t"
+	case ast.Integer64:
+		return "int64_t"
+	case ast.Short:
+		return "int16_t"
+	case ast.UnsignedInteger32, ast.Char:
+		return "uint32_t"
+	case ast.UnsignedInteger64:
+		return "uint64_t"
+	case ast.UnsignedShort:
+		return "uint16_t"
+	case ast.Byte:
+		return "uint8_t"
+	case ast.Bool:
+		return "bool"
+	case ast.String:
+		return "shrinken_string"
+	case ast.Float:
+		return "float"
+	case ast.Double:
+		return "double"
+	}
+
+	f.err = fmt.Errorf("C backend doesn't support type %v", c.Type.GenericType.String())
+	return ""
+}
+
+// signedLiteral returns int64_t literal of two's complement value, the smallest one can't be written as literal
+func signedLiteral(value uint64) string {
+	if int64(value) == math.MinInt64 {
+		return "INT64_MIN"
+	}
+	return fmt.Sprintf("INT64_C(%v)", int64(value))
+}
+
+// prefix returns prefix of names declared in package
+func prefix(pkg *gen.Package) string {
+	return strings.Replace(pkg.ExportedName, ".", "_", -1)
+}
+
+func structName(s *gen.Struct) string {
+	return prefix(s.Package) + "_" + gen.UpperFirst(s.ExportedName)
+}
+
+func enumName(e *gen.Enum) string {
+	return prefix(e.Package) + "_" + gen.UpperFirst(e.ExportedName)
+}
+
+func messageName(m *gen.Message) string {
+	if m.Struct != nil {
+		return structName(m.Struct)
+	}
+	return enumName(m.Enum)
+}
+
+func className(s *gen.Struct) string {
+	return structName(s) + "_class"
+}
+
+func subtypesName(s *gen.Struct) string {
+	return structName(s) + "_subtypes"
+}
+
+// inHierarchy reports whether class has base or derived classes, so its objects carry their class
+func inHierarchy(s *gen.Struct) bool {
+	return s.IsClass && len(s.Root().Derived) > 0
+}
+
+func defaultName(s *gen.Struct) string {
+	return structName(s) + "_default"
+}
+
+// fieldPaths returns member paths of all fields of s, in order of AllFields
+func fieldPaths(s *gen.Struct) []string {
+	paths := make([]string, 0)
+	if s.Base != nil {
+		for _, path := range fieldPaths(s.Base) {
+			paths = append(paths, "base."+path)
+		}
+	}
+	for _, field := range s.Fields {
+		paths = append(paths, fieldName(field.ExportedName))
+	}
+	return paths
+}
+
+func fieldName(name string) string {
+	name = gen.SnakeCase(name)
+	if keywords[name] {
+		return name + "_"
+	}
+	return name
+}
+
+// keywords are reserved in C or C++, base and class_ are also reserved for members holding base struct and class
+var keywords = map[string]bool{
+	"auto": true, "break": true, "case": true, "char": true, "const": true, "continue": true,
+	"default": true, "do": true, "double": true, "else": true, "enum": true, "extern": true,
+	"float": true, "for": true, "goto": true, "if": true, "inline": true, "int": true, "long": true,
+	"register": true, "restrict": true, "return": true, "short": true, "signed": true,
+	"sizeof": true, "static": true, "struct": true, "switch": true, "typedef": true, "union": true,
+	"unsigned": true, "void": true, "volatile": true, "while": true, "bool": true, "true": true,
+	"false": true, "class": true, "delete": true, "friend": true, "namespace": true, "new": true,
+	"operator": true, "private": true, "protected": true, "public": true, "template": true,
+	"this": true, "throw": true, "try": true, "catch": true, "using": true, "virtual": true,
+	"base": true, "unused_": true, "class_": true,
+}
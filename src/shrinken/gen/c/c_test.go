@@ -0,0 +1,226 @@
+package c
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"shrinken/gen"
+	"shrinken/wire/wiretest"
+	"strings"
+	"testing"
+)
+
+func generate(t *testing.T, filename string, expectedToSucceed bool) string {
+	tree := wiretest.Parse(t, filename)
+
+	dir, err := ioutil.TempDir("", "shrinken")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = gen.Generate(tree, "c", dir)
+	if (err == nil) != expectedToSucceed {
+		os.RemoveAll(dir)
+		if expectedToSucceed {
+			t.Fatal("C code couldn't be generated!", err)
+		} else {
+			t.Fatal("C code was generated, but expected generation to fail!")
+		}
+	}
+
+	return dir
+}
+
+func TestBasic(t *testing.T) {
+	dir := generate(t, "../../sddl/test_data/single_file/basic.sddl", true)
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"shrinken.h", "shrinken.c", "com.github.namespace.c"} {
+		_, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal("Generated file is missing!", err)
+		}
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, "com.github.namespace.h"))
+	if err != nil {
+		t.Fatal("Generated header is missing!", err)
+	}
+	code := string(b)
+
+	expected := []string{
+		"typedef struct com_github_namespace_Player com_github_namespace_Player;",
+		"com_github_namespace_Entity base;",
+		"com_github_namespace_Vector3 pos;",
+		"void com_github_namespace_Player_decode(shrinken_reader* r, com_github_namespace_Player* v);",
+		"shrinken_error com_github_namespace_Player_deserialize(com_github_namespace_Player* v, const uint8_t* data, size_t size, const shrinken_allocator* allocator);",
+		"shrinken_error (*handle_Player)(void* ctx, com_github_namespace_Player* v);",
+	}
+	for _, e := range expected {
+		if !strings.Contains(code, e) {
+			t.Fatalf("Generated code doesn't contain %q", e)
+		}
+	}
+
+	// Entity contains Vector3 by value, so it has to be declared first
+	if strings.Index(code, "struct com_github_namespace_Vector3 {") > strings.Index(code, "struct com_github_namespace_Entity {") {
+		t.Fatal("Struct Vector3 is declared after struct Entity which contains it")
+	}
+}
+
+func TestCircularPackages(t *testing.T) {
+	dir := generate(t, "../../sddl/test_data/multipkg/same_name/", false)
+	os.RemoveAll(dir)
+}
+
+const conformanceMain = `#include <stdio.h>
+#include <stdlib.h>
+#include <string.h>
+#include "conformance.h"
+
+static char line[8192];
+static uint8_t input[4096];
+static uint8_t delta[4096];
+static uint8_t output[4096];
+static uint8_t memory[16384];
+
+static size_t read_hex(uint8_t* buf, size_t capacity) {
+    size_t size = 0;
+    unsigned value;
+    if (fgets(line, sizeof(line), stdin) == NULL) {
+        return 0;
+    }
+    for (const char* p = line; size < capacity && sscanf(p, "%2x", &value) == 1; p += 2) {
+        buf[size++] = (uint8_t)value;
+    }
+    return size;
+}
+
+static void print_hex(const uint8_t* data, size_t size) {
+    for (size_t i = 0; i < size; i++) {
+        printf("%02x", data[i]);
+    }
+    printf("\n");
+}
+
+static void check(shrinken_error err, const char* what) {
+    if (err != SHRINKEN_OK) {
+        fprintf(stderr, "%s failed with %d\n", what, (int)err);
+        exit(1);
+    }
+}
+
+static shrinken_error print_sample(void* ctx, conformance_Sample* v) {
+    size_t size;
+    shrinken_error err = conformance_Sample_serialize_any(v, output, sizeof(output), &size);
+    (void)ctx;
+    print_hex(output, size);
+    return err;
+}
+
+static shrinken_error print_aligned(void* ctx, conformance_Aligned* v) {
+    size_t size;
+    shrinken_error err = conformance_Aligned_serialize_any(v, output, sizeof(output), &size);
+    (void)ctx;
+    print_hex(output, size);
+    return err;
+}
+
+static shrinken_error print_coded(void* ctx, conformance_Coded* v) {
+    size_t size;
+    shrinken_error err = conformance_Coded_serialize_any(v, output, sizeof(output), &size);
+    (void)ctx;
+    print_hex(output, size);
+    return err;
+}
+
+static shrinken_error print_bulk(void* ctx, conformance_Bulk* v) {
+    size_t size;
+    shrinken_error err = conformance_Bulk_serialize_any(v, output, sizeof(output), &size);
+    (void)ctx;
+    print_hex(output, size);
+    return err;
+}
+
+int main(int argc, char** argv) {
+    size_t size = read_hex(input, sizeof(input));
+
+    shrinken_arena arena;
+    shrinken_arena_init(&arena, memory, sizeof(memory));
+    shrinken_allocator allocator = shrinken_arena_allocator(&arena);
+
+    if (argc > 1 && strcmp(argv[1], "any") == 0) {
+        conformance_handler handler = {print_sample, print_aligned, print_coded, print_bulk, NULL};
+        for (; size > 0; size = read_hex(input, sizeof(input))) {
+            check(conformance_decode_any(input, size, &allocator, &handler), "decode_any");
+        }
+        return 0;
+    }
+
+    if (argc > 1 && strcmp(argv[1], "truncated") == 0) {
+        conformance_Bulk bulk;
+        shrinken_error err = conformance_Bulk_deserialize(&bulk, input, size, &allocator);
+        printf("%s\n", err == SHRINKEN_ERROR_UNEXPECTED_END ? "unexpected end" : "other error");
+        return 0;
+    }
+
+    if (argc > 1 && strcmp(argv[1], "aligned") == 0) {
+        conformance_Aligned aligned;
+        check(conformance_Aligned_deserialize(&aligned, input, size, &allocator), "deserialize");
+        check(conformance_Aligned_serialize(&aligned, output, sizeof(output), &size), "serialize");
+        print_hex(output, size);
+        return 0;
+    }
+
+    if (argc > 1 && strcmp(argv[1], "coded") == 0) {
+        conformance_Coded coded;
+        check(conformance_Coded_deserialize(&coded, input, size, &allocator), "deserialize");
+        check(conformance_Coded_serialize(&coded, output, sizeof(output), &size), "serialize");
+        print_hex(output, size);
+        return 0;
+    }
+
+    conformance_Sample sample;
+    check(conformance_Sample_deserialize(&sample, input, size, &allocator), "deserialize");
+
+    if (argc > 1 && strcmp(argv[1], "delta") == 0) {
+        conformance_Sample baseline;
+        check(conformance_Sample_deserialize(&baseline, input, size, &allocator), "deserialize");
+        size_t delta_size = read_hex(delta, sizeof(delta));
+        check(conformance_Sample_deserialize_delta(&sample, delta, delta_size, &allocator), "deserialize_delta");
+
+        check(conformance_Sample_serialize(&sample, output, sizeof(output), &size), "serialize");
+        print_hex(output, size);
+        check(conformance_Sample_serialize_delta(&sample, &baseline, output, sizeof(output), &size), "serialize_delta");
+        print_hex(output, size);
+        return 0;
+    }
+
+    check(conformance_Sample_serialize(&sample, output, sizeof(output), &size), "serialize");
+    print_hex(output, size);
+    return 0;
+}
+`
+
+func TestConformance(t *testing.T) {
+	cc := wiretest.Tool(t, "cc", "gcc", "clang")
+
+	dir := generate(t, wiretest.Schema, true)
+	defer os.RemoveAll(dir)
+
+	err := gen.WriteFile(filepath.Join(dir, "main.c"), []byte(conformanceMain))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	program := filepath.Join(dir, "conformance")
+	wiretest.Build(t, exec.Command(cc, "-std=c99", "-Wall", "-Werror", "-o", program,
+		filepath.Join(dir, "main.c"), filepath.Join(dir, "conformance.c"), filepath.Join(dir, "shrinken.c"), "-lm"))
+	wiretest.Run(t, exec.Command(program))
+	wiretest.RunDelta(t, exec.Command(program))
+	wiretest.RunAligned(t, exec.Command(program))
+	wiretest.RunCoded(t, exec.Command(program))
+	wiretest.RunAny(t, exec.Command(program))
+	wiretest.RunTruncated(t, exec.Command(program))
+}
@@ -0,0 +1,779 @@
+package c
+
+// runtime is written to shrinken.h and shrinken.c next to generated files
+
+const runtimeHeader = `/* Code generated by shrinken. DO NOT EDIT. */
+
+#ifndef SHRINKEN_H
+#define SHRINKEN_H
+
+#include <stdbool.h>
+#include <stddef.h>
+#include <stdint.h>
+
+typedef enum shrinken_error {
+    SHRINKEN_OK = 0,
+    SHRINKEN_ERROR_BUFFER_TOO_SMALL, /* encoded data doesn't fit given buffer */
+    SHRINKEN_ERROR_UNEXPECTED_END,   /* data ended before all values were read */
+    SHRINKEN_ERROR_INVALID_VALUE,    /* decoded value can't be represented, for example unknown enumeral */
+    SHRINKEN_ERROR_NO_MEMORY,        /* allocator is missing or it couldn't allocate memory */
+    SHRINKEN_ERROR_TOO_LONG,         /* encoded string or array is longer than its maximum length */
+    SHRINKEN_ERROR_UNKNOWN_MESSAGE   /* message ID doesn't belong to any message handled by decode_any */
+} shrinken_error;
+
+/* shrinken_allocator provides memory for strings, dynamic arrays and class references while
+   decoding. Returned memory has to be suitably aligned for any type, or NULL if it can't be
+   allocated. Memory is never freed by generated code. */
+typedef struct shrinken_allocator {
+    void* (*alloc)(void* ctx, size_t size);
+    void* ctx;
+} shrinken_allocator;
+
+/* shrinken_arena allocates from caller provided buffer, so decoding doesn't need heap.
+   Whole arena is released by initializing it again. */
+typedef struct shrinken_arena {
+    uint8_t* buf;
+    size_t capacity;
+    size_t used;
+} shrinken_arena;
+
+#ifndef SHRINKEN_ARENA_ALIGN
+#define SHRINKEN_ARENA_ALIGN 8
+#endif
+
+void shrinken_arena_init(shrinken_arena* arena, void* buf, size_t capacity);
+void* shrinken_arena_alloc(void* arena, size_t size);
+shrinken_allocator shrinken_arena_allocator(shrinken_arena* arena);
+
+typedef struct shrinken_string {
+    char* data; /* UTF-8 bytes, decoded strings are also terminated by NUL, unless they're empty and data is NULL */
+    uint32_t length;
+} shrinken_string;
+
+/* shrinken_string_differs reports whether strings differ in length or bytes, used by delta encoding */
+bool shrinken_string_differs(const shrinken_string* a, const shrinken_string* b);
+
+/* shrinken_huffman is canonical Huffman code of values 0 to count-1. Code of value is written as single
+   lengths[value] wide field codes[value], first bit of code lowest. counts holds number of codes of each
+   length up to max_length and symbols values ordered by their codes, for reading codes bit by bit. */
+typedef struct shrinken_huffman {
+    const uint16_t* codes;
+    const uint8_t* lengths;
+    uint32_t count;
+    const uint16_t* counts;
+    unsigned max_length;
+    const uint8_t* symbols;
+} shrinken_huffman;
+
+/* shrinken_writer writes values as little-endian bit fields, starting from least significant
+   bit of first byte. After first error all writes are ignored. With byte_aligned set, every
+   bit field is padded with zero bits to whole bytes. */
+typedef struct shrinken_writer {
+    uint8_t* buf;
+    size_t capacity;
+    size_t bits;
+    bool byte_aligned;
+    shrinken_error error;
+} shrinken_writer;
+
+void shrinken_writer_init(shrinken_writer* w, uint8_t* buf, size_t capacity);
+void shrinken_write_bits(shrinken_writer* w, uint64_t value, unsigned bits);
+/* range functions write value clamped to [min, max] as offset from min */
+void shrinken_write_signed_range(shrinken_writer* w, int64_t value, int64_t min, int64_t max, unsigned bits);
+void shrinken_write_unsigned_range(shrinken_writer* w, uint64_t value, uint64_t min, uint64_t max, unsigned bits);
+/* symbol functions write Huffman code of value, value without code is invalid */
+void shrinken_write_symbol(shrinken_writer* w, uint64_t value, const shrinken_huffman* code);
+/* range symbol functions write value clamped to [min, max] as Huffman code of offset from min */
+void shrinken_write_signed_range_symbol(shrinken_writer* w, int64_t value, int64_t min, int64_t max, const shrinken_huffman* code);
+void shrinken_write_unsigned_range_symbol(shrinken_writer* w, uint64_t value, uint64_t min, uint64_t max, const shrinken_huffman* code);
+/* varint functions write value in groups of 7 bits, each one as 8 bit field with highest bit set if another group follows */
+void shrinken_write_varint(shrinken_writer* w, uint64_t value, unsigned bits);
+/* zigzag maps 0, -1, 1, -2... to 0, 1, 2, 3... before writing it as varint */
+void shrinken_write_zigzag(shrinken_writer* w, int64_t value);
+/* quantized functions write value clamped to [min, max] as the nearest of steps equal steps from min */
+void shrinken_write_quantized(shrinken_writer* w, float value, double min, double max, uint64_t steps, unsigned bits);
+/* quaternion functions write 2 bit index of the largest component of normalized quaternion, followed by the
+   other three components quantized to [min, max], see specification */
+void shrinken_write_quaternion(shrinken_writer* w, float i, float j, float k, float l, double min, double max, uint64_t steps, unsigned bits);
+/* normalized functions write unit vector as two octahedral coordinates quantized to [-1, 1], see specification.
+   Quaternion and normalized functions need math library (-lm). */
+void shrinken_write_normalized(shrinken_writer* w, float x, float y, float z, uint64_t steps, unsigned bits);
+void shrinken_write_bool(shrinken_writer* w, bool value);
+void shrinken_write_float(shrinken_writer* w, float value);
+void shrinken_write_double(shrinken_writer* w, double value);
+/* string functions write length as count_bits wide integer followed by characters, ASCII ones 7 bits each */
+void shrinken_write_string(shrinken_writer* w, const shrinken_string* value, uint64_t max_length, unsigned count_bits);
+void shrinken_write_ascii(shrinken_writer* w, const shrinken_string* value, uint64_t max_length, unsigned count_bits);
+void shrinken_write_ascii_char(shrinken_writer* w, uint32_t value);
+/* count functions write length of dynamic array as count_bits wide integer, larger than max_count fails */
+void shrinken_write_count(shrinken_writer* w, uint32_t count, uint64_t max_count, unsigned count_bits);
+void shrinken_writer_fail(shrinken_writer* w, shrinken_error error);
+/* number of written bytes, including last partially written byte */
+size_t shrinken_writer_size(const shrinken_writer* w);
+
+/* shrinken_reader reads values written by shrinken_writer. After first error all reads return zero.
+   With byte_aligned set, padding after every bit field is skipped. */
+typedef struct shrinken_reader {
+    const uint8_t* data;
+    size_t size;
+    size_t pos;
+    bool byte_aligned;
+    const shrinken_allocator* allocator;
+    shrinken_error error;
+} shrinken_reader;
+
+/* allocator can be NULL if decoded types don't contain strings, dynamic arrays or class references */
+void shrinken_reader_init(shrinken_reader* r, const uint8_t* data, size_t size, const shrinken_allocator* allocator);
+uint64_t shrinken_read_bits(shrinken_reader* r, unsigned bits);
+int64_t shrinken_read_signed_range(shrinken_reader* r, int64_t min, int64_t max, unsigned bits);
+uint64_t shrinken_read_unsigned_range(shrinken_reader* r, uint64_t min, uint64_t max, unsigned bits);
+/* symbol is read bit by bit, padding of byte aligned reader is skipped only after the whole code */
+uint64_t shrinken_read_symbol(shrinken_reader* r, const shrinken_huffman* code);
+int64_t shrinken_read_signed_range_symbol(shrinken_reader* r, int64_t min, const shrinken_huffman* code);
+uint64_t shrinken_read_unsigned_range_symbol(shrinken_reader* r, uint64_t min, const shrinken_huffman* code);
+/* varint which doesn't fit bits is invalid */
+uint64_t shrinken_read_varint(shrinken_reader* r, unsigned bits);
+int64_t shrinken_read_zigzag(shrinken_reader* r, unsigned bits);
+float shrinken_read_quantized(shrinken_reader* r, double min, double max, uint64_t steps, unsigned bits);
+void shrinken_read_quaternion(shrinken_reader* r, float* i, float* j, float* k, float* l, double min, double max, uint64_t steps, unsigned bits);
+void shrinken_read_normalized(shrinken_reader* r, float* x, float* y, float* z, uint64_t steps, unsigned bits);
+bool shrinken_read_bool(shrinken_reader* r);
+float shrinken_read_float(shrinken_reader* r);
+double shrinken_read_double(shrinken_reader* r);
+/* string longer than max_length is invalid */
+void shrinken_read_string(shrinken_reader* r, shrinken_string* value, uint64_t max_length, unsigned count_bits);
+void shrinken_read_ascii(shrinken_reader* r, shrinken_string* value, uint64_t max_length, unsigned count_bits);
+uint32_t shrinken_read_ascii_char(shrinken_reader* r);
+/* array longer than max_count is invalid, longer than remaining data could hold with elements of at least
+   min_bits is unexpected end */
+uint32_t shrinken_read_count(shrinken_reader* r, uint64_t max_count, unsigned count_bits, uint64_t min_bits);
+/* shrinken_read_alloc allocates memory for count elements, NULL is returned when count is zero or on error */
+void* shrinken_read_alloc(shrinken_reader* r, size_t count, size_t elem_size);
+void shrinken_reader_fail(shrinken_reader* r, shrinken_error error);
+/* number of bits left to read */
+size_t shrinken_reader_remaining(const shrinken_reader* r);
+
+/* shrinken_class describes class of hierarchy whose root struct holds pointer to it as first member
+   class_, NULL class_ stands for class of reference which object is written to */
+typedef struct shrinken_class {
+    const char* name; /* qualified name in SDDL */
+    size_t size;
+    void (*encode)(shrinken_writer* w, const void* v);
+    void (*decode)(shrinken_reader* r, void* v);
+} shrinken_class;
+
+/* class functions write index of class of v in subtypes as bits wide tag, followed by its fields */
+void shrinken_write_class(shrinken_writer* w, const void* v, const shrinken_class* const* subtypes, uint32_t count, unsigned bits);
+/* shrinken_read_class allocates object of class selected by tag, tag outside of subtypes is invalid */
+void* shrinken_read_class(shrinken_reader* r, const shrinken_class* const* subtypes, uint32_t count, unsigned bits);
+
+/* serialize_any functions write ID of message as varint of up to 16 bits, followed by the serialized
+   message, and decode_any functions dispatch it by the ID. Message ID functions store number of bytes
+   of the ID to size. */
+shrinken_error shrinken_write_message_id(uint16_t id, uint8_t* buf, size_t capacity, size_t* size);
+shrinken_error shrinken_read_message_id(uint16_t* id, const uint8_t* data, size_t size, size_t* id_size);
+
+#endif
+`
+
+const runtimeSource = `/* Code generated by shrinken. DO NOT EDIT. */
+
+#include <math.h>
+#include <string.h>
+
+#include "shrinken.h"
+
+void shrinken_arena_init(shrinken_arena* arena, void* buf, size_t capacity) {
+    arena->buf = (uint8_t*)buf;
+    arena->capacity = capacity;
+    arena->used = 0;
+}
+
+void* shrinken_arena_alloc(void* ctx, size_t size) {
+    shrinken_arena* arena = (shrinken_arena*)ctx;
+    size_t start = (arena->used + SHRINKEN_ARENA_ALIGN - 1) / SHRINKEN_ARENA_ALIGN * SHRINKEN_ARENA_ALIGN;
+    if (start > arena->capacity || size > arena->capacity - start) {
+        return NULL;
+    }
+
+    arena->used = start + size;
+    return arena->buf + start;
+}
+
+shrinken_allocator shrinken_arena_allocator(shrinken_arena* arena) {
+    shrinken_allocator allocator;
+    allocator.alloc = shrinken_arena_alloc;
+    allocator.ctx = arena;
+    return allocator;
+}
+
+bool shrinken_string_differs(const shrinken_string* a, const shrinken_string* b) {
+    return a->length != b->length || (a->length > 0 && memcmp(a->data, b->data, a->length) != 0);
+}
+
+void shrinken_writer_init(shrinken_writer* w, uint8_t* buf, size_t capacity) {
+    w->buf = buf;
+    w->capacity = capacity;
+    w->bits = 0;
+    w->byte_aligned = false;
+    w->error = SHRINKEN_OK;
+}
+
+void shrinken_write_bits(shrinken_writer* w, uint64_t value, unsigned bits) {
+    if (w->error != SHRINKEN_OK) {
+        return;
+    }
+
+    if (bits > w->capacity * 8 - w->bits) {
+        w->error = SHRINKEN_ERROR_BUFFER_TOO_SMALL;
+        return;
+    }
+
+    while (bits > 0) {
+        unsigned used = (unsigned)(w->bits & 7);
+        if (used == 0) {
+            w->buf[w->bits >> 3] = 0;
+        }
+
+        unsigned n = 8 - used;
+        if (bits < n) {
+            n = bits;
+        }
+
+        w->buf[w->bits >> 3] |= (uint8_t)((value & ((1u << n) - 1)) << used);
+        value >>= n;
+        bits -= n;
+        w->bits += n;
+    }
+
+    if (w->byte_aligned) {
+        w->bits = (w->bits + 7) & ~(size_t)7;
+    }
+}
+
+void shrinken_write_signed_range(shrinken_writer* w, int64_t value, int64_t min, int64_t max, unsigned bits) {
+    if (value < min) {
+        value = min;
+    } else if (value > max) {
+        value = max;
+    }
+    shrinken_write_bits(w, (uint64_t)value - (uint64_t)min, bits);
+}
+
+void shrinken_write_unsigned_range(shrinken_writer* w, uint64_t value, uint64_t min, uint64_t max, unsigned bits) {
+    if (value < min) {
+        value = min;
+    } else if (value > max) {
+        value = max;
+    }
+    shrinken_write_bits(w, value - min, bits);
+}
+
+void shrinken_write_symbol(shrinken_writer* w, uint64_t value, const shrinken_huffman* code) {
+    if (value >= code->count) {
+        shrinken_writer_fail(w, SHRINKEN_ERROR_INVALID_VALUE);
+        return;
+    }
+    shrinken_write_bits(w, code->codes[value], code->lengths[value]);
+}
+
+void shrinken_write_signed_range_symbol(shrinken_writer* w, int64_t value, int64_t min, int64_t max, const shrinken_huffman* code) {
+    if (value < min) {
+        value = min;
+    } else if (value > max) {
+        value = max;
+    }
+    shrinken_write_symbol(w, (uint64_t)value - (uint64_t)min, code);
+}
+
+void shrinken_write_unsigned_range_symbol(shrinken_writer* w, uint64_t value, uint64_t min, uint64_t max, const shrinken_huffman* code) {
+    if (value < min) {
+        value = min;
+    } else if (value > max) {
+        value = max;
+    }
+    shrinken_write_symbol(w, value - min, code);
+}
+
+void shrinken_write_varint(shrinken_writer* w, uint64_t value, unsigned bits) {
+    if (bits < 64) {
+        value &= (UINT64_C(1) << bits) - 1;
+    }
+    while (value >= 0x80) {
+        shrinken_write_bits(w, (value & 0x7F) | 0x80, 8);
+        value >>= 7;
+    }
+    shrinken_write_bits(w, value, 8);
+}
+
+void shrinken_write_zigzag(shrinken_writer* w, int64_t value) {
+    /* right shift of negative value is implementation defined */
+    shrinken_write_varint(w, ((uint64_t)value << 1) ^ (value < 0 ? UINT64_MAX : 0), 64);
+}
+
+void shrinken_write_quantized(shrinken_writer* w, float value, double min, double max, uint64_t steps, unsigned bits) {
+    double v = value;
+    /* NaN is written as min */
+    if (!(v >= min)) {
+        v = min;
+    } else if (v > max) {
+        v = max;
+    }
+    shrinken_write_bits(w, (uint64_t)((v - min) * (double)steps / (max - min) + 0.5), bits);
+}
+
+void shrinken_write_quaternion(shrinken_writer* w, float i, float j, float k, float l, double min, double max, uint64_t steps, unsigned bits) {
+    double q[4] = {i, j, k, l};
+    double n = sqrt(q[0] * q[0] + q[1] * q[1] + q[2] * q[2] + q[3] * q[3]);
+    unsigned c, largest = 0;
+    if (n > 0 && !isinf(n)) {
+        for (c = 0; c < 4; c++) {
+            q[c] /= n;
+        }
+    }
+    for (c = 1; c < 4; c++) {
+        if (fabs(q[c]) > fabs(q[largest])) {
+            largest = c;
+        }
+    }
+    /* q and -q are the same rotation */
+    if (q[largest] < 0) {
+        for (c = 0; c < 4; c++) {
+            q[c] = -q[c];
+        }
+    }
+
+    shrinken_write_bits(w, largest, 2);
+    for (c = 0; c < 4; c++) {
+        if (c != largest) {
+            shrinken_write_quantized(w, (float)q[c], min, max, steps, bits);
+        }
+    }
+}
+
+static double shrinken_sign_not_zero(double value) {
+    return value < 0 ? -1 : 1;
+}
+
+void shrinken_write_normalized(shrinken_writer* w, float x, float y, float z, uint64_t steps, unsigned bits) {
+    double v[3] = {x, y, z};
+    double u, t, n = fabs(v[0]) + fabs(v[1]) + fabs(v[2]);
+    unsigned c;
+    if (n > 0 && !isinf(n)) {
+        for (c = 0; c < 3; c++) {
+            v[c] /= n;
+        }
+    } else {
+        v[0] = 0;
+        v[1] = 0;
+        v[2] = 1;
+    }
+
+    u = v[0];
+    t = v[1];
+    if (v[2] < 0) {
+        u = (1 - fabs(v[1])) * shrinken_sign_not_zero(v[0]);
+        t = (1 - fabs(v[0])) * shrinken_sign_not_zero(v[1]);
+    }
+    shrinken_write_quantized(w, (float)u, -1, 1, steps, bits);
+    shrinken_write_quantized(w, (float)t, -1, 1, steps, bits);
+}
+
+void shrinken_write_bool(shrinken_writer* w, bool value) {
+    shrinken_write_bits(w, value ? 1 : 0, 1);
+}
+
+void shrinken_write_float(shrinken_writer* w, float value) {
+    uint32_t bits;
+    memcpy(&bits, &value, sizeof(bits));
+    shrinken_write_bits(w, bits, 32);
+}
+
+void shrinken_write_double(shrinken_writer* w, double value) {
+    uint64_t bits;
+    memcpy(&bits, &value, sizeof(bits));
+    shrinken_write_bits(w, bits, 64);
+}
+
+static void shrinken_write_chars(shrinken_writer* w, const shrinken_string* value, uint64_t max_length, unsigned count_bits, unsigned char_bits) {
+    uint32_t i;
+
+    if (value->length > max_length) {
+        shrinken_writer_fail(w, SHRINKEN_ERROR_TOO_LONG);
+        return;
+    }
+
+    shrinken_write_bits(w, value->length, count_bits);
+    for (i = 0; i < value->length; i++) {
+        shrinken_write_bits(w, (uint8_t)value->data[i], char_bits);
+    }
+}
+
+void shrinken_write_string(shrinken_writer* w, const shrinken_string* value, uint64_t max_length, unsigned count_bits) {
+    shrinken_write_chars(w, value, max_length, count_bits, 8);
+}
+
+void shrinken_write_ascii(shrinken_writer* w, const shrinken_string* value, uint64_t max_length, unsigned count_bits) {
+    uint32_t i;
+    for (i = 0; i < value->length; i++) {
+        if ((uint8_t)value->data[i] >= 0x80) {
+            shrinken_writer_fail(w, SHRINKEN_ERROR_INVALID_VALUE);
+            return;
+        }
+    }
+    shrinken_write_chars(w, value, max_length, count_bits, 7);
+}
+
+void shrinken_write_ascii_char(shrinken_writer* w, uint32_t value) {
+    if (value >= 0x80) {
+        shrinken_writer_fail(w, SHRINKEN_ERROR_INVALID_VALUE);
+        return;
+    }
+    shrinken_write_bits(w, value, 7);
+}
+
+void shrinken_write_count(shrinken_writer* w, uint32_t count, uint64_t max_count, unsigned count_bits) {
+    if (count > max_count) {
+        shrinken_writer_fail(w, SHRINKEN_ERROR_TOO_LONG);
+        return;
+    }
+    shrinken_write_bits(w, count, count_bits);
+}
+
+void shrinken_writer_fail(shrinken_writer* w, shrinken_error error) {
+    if (w->error == SHRINKEN_OK) {
+        w->error = error;
+    }
+}
+
+size_t shrinken_writer_size(const shrinken_writer* w) {
+    return (w->bits + 7) >> 3;
+}
+
+void shrinken_reader_init(shrinken_reader* r, const uint8_t* data, size_t size, const shrinken_allocator* allocator) {
+    r->data = data;
+    r->size = size;
+    r->pos = 0;
+    r->byte_aligned = false;
+    r->allocator = allocator;
+    r->error = SHRINKEN_OK;
+}
+
+uint64_t shrinken_read_bits(shrinken_reader* r, unsigned bits) {
+    uint64_t value = 0;
+    unsigned shift = 0;
+
+    if (r->error != SHRINKEN_OK) {
+        return 0;
+    }
+
+    if (bits > shrinken_reader_remaining(r)) {
+        r->error = SHRINKEN_ERROR_UNEXPECTED_END;
+        return 0;
+    }
+
+    while (bits > 0) {
+        unsigned used = (unsigned)(r->pos & 7);
+        unsigned n = 8 - used;
+        if (bits < n) {
+            n = bits;
+        }
+
+        value |= (uint64_t)((r->data[r->pos >> 3] >> used) & ((1u << n) - 1)) << shift;
+        shift += n;
+        bits -= n;
+        r->pos += n;
+    }
+
+    if (r->byte_aligned) {
+        r->pos = (r->pos + 7) & ~(size_t)7;
+    }
+
+    return value;
+}
+
+int64_t shrinken_read_signed_range(shrinken_reader* r, int64_t min, int64_t max, unsigned bits) {
+    uint64_t offset = shrinken_read_bits(r, bits);
+    if (offset > (uint64_t)max - (uint64_t)min) {
+        shrinken_reader_fail(r, SHRINKEN_ERROR_INVALID_VALUE);
+        return min;
+    }
+    /* conversion back to signed keeps two's complement bit pattern on all supported compilers */
+    return (int64_t)((uint64_t)min + offset);
+}
+
+uint64_t shrinken_read_unsigned_range(shrinken_reader* r, uint64_t min, uint64_t max, unsigned bits) {
+    uint64_t offset = shrinken_read_bits(r, bits);
+    if (offset > max - min) {
+        shrinken_reader_fail(r, SHRINKEN_ERROR_INVALID_VALUE);
+        return min;
+    }
+    return min + offset;
+}
+
+uint64_t shrinken_read_symbol(shrinken_reader* r, const shrinken_huffman* code) {
+    bool aligned = r->byte_aligned;
+    uint64_t value = 0;
+    unsigned c = 0, first = 0, index = 0;
+    unsigned length;
+
+    r->byte_aligned = false;
+    /* codes of each length follow all shorter ones, counting up from first */
+    for (length = 1; length <= code->max_length; length++) {
+        c |= (unsigned)shrinken_read_bits(r, 1);
+        if (r->error != SHRINKEN_OK) {
+            break;
+        }
+        if (c - first < code->counts[length]) {
+            value = code->symbols[index + c - first];
+            break;
+        }
+        index += code->counts[length];
+        first = (first + code->counts[length]) << 1;
+        c <<= 1;
+    }
+    if (length > code->max_length) {
+        shrinken_reader_fail(r, SHRINKEN_ERROR_INVALID_VALUE);
+    }
+
+    r->byte_aligned = aligned;
+    if (aligned) {
+        r->pos = (r->pos + 7) & ~(size_t)7;
+    }
+    return value;
+}
+
+int64_t shrinken_read_signed_range_symbol(shrinken_reader* r, int64_t min, const shrinken_huffman* code) {
+    return (int64_t)((uint64_t)min + shrinken_read_symbol(r, code));
+}
+
+uint64_t shrinken_read_unsigned_range_symbol(shrinken_reader* r, uint64_t min, const shrinken_huffman* code) {
+    return min + shrinken_read_symbol(r, code);
+}
+
+uint64_t shrinken_read_varint(shrinken_reader* r, unsigned bits) {
+    uint64_t value = 0;
+    unsigned shift;
+
+    for (shift = 0; shift < bits; shift += 7) {
+        uint64_t group = shrinken_read_bits(r, 8);
+        uint64_t data = group & 0x7F;
+        if (r->error != SHRINKEN_OK) {
+            return 0;
+        }
+
+        if (shift + 7 > bits && (data >> (bits - shift)) != 0) {
+            shrinken_reader_fail(r, SHRINKEN_ERROR_INVALID_VALUE);
+            return 0;
+        }
+        value |= data << shift;
+
+        if ((group & 0x80) == 0) {
+            return value;
+        }
+    }
+
+    /* more groups than needed for bits */
+    shrinken_reader_fail(r, SHRINKEN_ERROR_INVALID_VALUE);
+    return 0;
+}
+
+int64_t shrinken_read_zigzag(shrinken_reader* r, unsigned bits) {
+    uint64_t value = shrinken_read_varint(r, bits);
+    /* conversion back to signed keeps two's complement bit pattern on all supported compilers */
+    return (int64_t)((value >> 1) ^ (0 - (value & 1)));
+}
+
+float shrinken_read_quantized(shrinken_reader* r, double min, double max, uint64_t steps, unsigned bits) {
+    uint64_t q = shrinken_read_bits(r, bits);
+    if (q > steps) {
+        shrinken_reader_fail(r, SHRINKEN_ERROR_INVALID_VALUE);
+        return (float)min;
+    }
+    return (float)(min + (max - min) * (double)q / (double)steps);
+}
+
+void shrinken_read_quaternion(shrinken_reader* r, float* i, float* j, float* k, float* l, double min, double max, uint64_t steps, unsigned bits) {
+    float* q[4] = {i, j, k, l};
+    unsigned c, largest = (unsigned)shrinken_read_bits(r, 2);
+    double sum = 0;
+    for (c = 0; c < 4; c++) {
+        if (c != largest) {
+            *q[c] = shrinken_read_quantized(r, min, max, steps, bits);
+            sum += (double)*q[c] * (double)*q[c];
+        }
+    }
+    *q[largest] = (float)sqrt(1 - sum > 0 ? 1 - sum : 0);
+}
+
+void shrinken_read_normalized(shrinken_reader* r, float* x, float* y, float* z, uint64_t steps, unsigned bits) {
+    double u = shrinken_read_quantized(r, -1, 1, steps, bits);
+    double t = shrinken_read_quantized(r, -1, 1, steps, bits);
+    double l = 1 - fabs(u) - fabs(t), n;
+    if (l < 0) {
+        double folded = (1 - fabs(t)) * shrinken_sign_not_zero(u);
+        t = (1 - fabs(u)) * shrinken_sign_not_zero(t);
+        u = folded;
+    }
+    n = sqrt(u * u + t * t + l * l);
+    *x = (float)(u / n);
+    *y = (float)(t / n);
+    *z = (float)(l / n);
+}
+
+bool shrinken_read_bool(shrinken_reader* r) {
+    return shrinken_read_bits(r, 1) == 1;
+}
+
+float shrinken_read_float(shrinken_reader* r) {
+    uint32_t bits = (uint32_t)shrinken_read_bits(r, 32);
+    float value;
+    memcpy(&value, &bits, sizeof(value));
+    return value;
+}
+
+double shrinken_read_double(shrinken_reader* r) {
+    uint64_t bits = shrinken_read_bits(r, 64);
+    double value;
+    memcpy(&value, &bits, sizeof(value));
+    return value;
+}
+
+static void shrinken_read_chars(shrinken_reader* r, shrinken_string* value, uint64_t max_length, unsigned count_bits, unsigned char_bits) {
+    uint32_t i;
+    uint64_t n = shrinken_read_bits(r, count_bits);
+
+    value->data = NULL;
+    value->length = 0;
+
+    if (n > max_length) {
+        shrinken_reader_fail(r, SHRINKEN_ERROR_INVALID_VALUE);
+        return;
+    }
+
+    if (n > shrinken_reader_remaining(r) / char_bits) {
+        shrinken_reader_fail(r, SHRINKEN_ERROR_UNEXPECTED_END);
+        return;
+    }
+
+    if (n == 0) {
+        return;
+    }
+
+    value->data = (char*)shrinken_read_alloc(r, (size_t)n + 1, 1);
+    if (value->data == NULL) {
+        return;
+    }
+
+    for (i = 0; i < n; i++) {
+        value->data[i] = (char)shrinken_read_bits(r, char_bits);
+    }
+    value->data[n] = '\0';
+    value->length = (uint32_t)n;
+}
+
+void shrinken_read_string(shrinken_reader* r, shrinken_string* value, uint64_t max_length, unsigned count_bits) {
+    shrinken_read_chars(r, value, max_length, count_bits, 8);
+}
+
+void shrinken_read_ascii(shrinken_reader* r, shrinken_string* value, uint64_t max_length, unsigned count_bits) {
+    shrinken_read_chars(r, value, max_length, count_bits, 7);
+}
+
+uint32_t shrinken_read_ascii_char(shrinken_reader* r) {
+    return (uint32_t)shrinken_read_bits(r, 7);
+}
+
+uint32_t shrinken_read_count(shrinken_reader* r, uint64_t max_count, unsigned count_bits, uint64_t min_bits) {
+    uint64_t count = shrinken_read_bits(r, count_bits);
+    if (count > max_count) {
+        shrinken_reader_fail(r, SHRINKEN_ERROR_INVALID_VALUE);
+        return 0;
+    }
+    if (min_bits > 0 && count > shrinken_reader_remaining(r) / min_bits) {
+        shrinken_reader_fail(r, SHRINKEN_ERROR_UNEXPECTED_END);
+        return 0;
+    }
+    return (uint32_t)count;
+}
+
+void* shrinken_read_alloc(shrinken_reader* r, size_t count, size_t elem_size) {
+    void* memory;
+
+    if (r->error != SHRINKEN_OK || count == 0) {
+        return NULL;
+    }
+
+    if (r->allocator == NULL || (elem_size != 0 && count > (size_t)-1 / elem_size)) {
+        r->error = SHRINKEN_ERROR_NO_MEMORY;
+        return NULL;
+    }
+
+    memory = r->allocator->alloc(r->allocator->ctx, count * elem_size);
+    if (memory == NULL) {
+        r->error = SHRINKEN_ERROR_NO_MEMORY;
+    }
+    return memory;
+}
+
+void shrinken_reader_fail(shrinken_reader* r, shrinken_error error) {
+    if (r->error == SHRINKEN_OK) {
+        r->error = error;
+    }
+}
+
+size_t shrinken_reader_remaining(const shrinken_reader* r) {
+    return r->size * 8 - r->pos;
+}
+
+void shrinken_write_class(shrinken_writer* w, const void* v, const shrinken_class* const* subtypes, uint32_t count, unsigned bits) {
+    const shrinken_class* class_ = *(const shrinken_class* const*)v;
+    uint32_t tag;
+
+    if (class_ == NULL) {
+        class_ = subtypes[0];
+    }
+
+    for (tag = 0; tag < count; tag++) {
+        if (subtypes[tag] == class_) {
+            shrinken_write_bits(w, tag, bits);
+            class_->encode(w, v);
+            return;
+        }
+    }
+    shrinken_writer_fail(w, SHRINKEN_ERROR_INVALID_VALUE);
+}
+
+void* shrinken_read_class(shrinken_reader* r, const shrinken_class* const* subtypes, uint32_t count, unsigned bits) {
+    uint64_t tag = shrinken_read_bits(r, bits);
+    void* v;
+
+    if (tag >= count) {
+        shrinken_reader_fail(r, SHRINKEN_ERROR_INVALID_VALUE);
+        tag = 0;
+    }
+
+    v = shrinken_read_alloc(r, 1, subtypes[tag]->size);
+    if (v != NULL) {
+        *(const shrinken_class**)v = subtypes[tag];
+        subtypes[tag]->decode(r, v);
+    }
+    return v;
+}
+
+shrinken_error shrinken_write_message_id(uint16_t id, uint8_t* buf, size_t capacity, size_t* size) {
+    shrinken_writer w;
+    shrinken_writer_init(&w, buf, capacity);
+    shrinken_write_varint(&w, id, 16);
+    *size = shrinken_writer_size(&w);
+    return w.error;
+}
+
+shrinken_error shrinken_read_message_id(uint16_t* id, const uint8_t* data, size_t size, size_t* id_size) {
+    shrinken_reader r;
+    shrinken_reader_init(&r, data, size, NULL);
+    *id = (uint16_t)shrinken_read_varint(&r, 16);
+    *id_size = r.pos >> 3;
+    return r.error;
+}
+`
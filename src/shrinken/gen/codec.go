@@ -0,0 +1,422 @@
+package gen
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"shrinken/sddl/ast"
+	"shrinken/sddl/ast/attributes"
+	"strconv"
+	"strings"
+)
+
+// Codec describes how single value is laid out on the wire.
+// Values are written as little-endian bit fields, starting from least significant bit of first byte.
+
+type CodecKind int
+
+const (
+	BoolCodec   CodecKind = iota // single bit
+	IntCodec                     // Bits wide two's complement (Signed) or unsigned integer written with Encoding, or value - Range.Min if Range is set
+	FloatCodec                   // IEEE 754 floating point number, Bits is 32 or 64, or Bits wide fixed-point number if Quantization is set
+	CharCodec                    // unicode code point, Bits wide (7 for ASCII)
+	StringCodec                  // CountBits wide length in bytes, up to MaxLength, followed by Bits wide UTF-8 or ASCII bytes
+	EnumCodec                    // index of enumeral, Bits wide
+	StructCodec                  // fields of Struct, one after another, for class references preceded by Bits wide index into Subtypes, see below
+	ArrayCodec                   // Size elements, or CountBits wide length, up to MaxCount, followed by elements if Size is -1
+)
+
+// Quaternion struct is written as 2 bit index of its component with the largest absolute value, followed
+// by the other three components, in order, of quaternion normalized and negated if that component is negative.
+// The largest component is computed from the others on decoding.
+
+// Normalized vector struct is written as two octahedral coordinates quantized with Struct.Normalized codec,
+// see specification.
+
+// IntEncoding selects how integers without range are written
+type IntEncoding int
+
+const (
+	FixedEncoding  IntEncoding = iota // Bits wide field
+	VarintEncoding                    // Bits wide unsigned or two's complement value split to groups of 7 bits, see below
+	ZigZagEncoding                    // signed value mapped to unsigned one, 0, -1, 1, -2 to 0, 1, 2, 3, and written as varint
+)
+
+// Varint groups are written from the lowest one as 8 bit fields, which have the highest bit set when
+// another group follows. Only as many groups as needed are written, so values below 128 take 8 bits.
+
+// Enum and ranged integer fields of entropy coded messages are written as canonical Huffman codes of
+// enumeral index or offset from Range.Min, instead of Bits wide fields, when Huffman is set.
+
+type Codec struct {
+	Kind CodecKind
+	Type *ast.VariableType
+
+	Bits     int
+	Signed   bool
+	Range    *IntRange
+	Encoding IntEncoding
+
+	Quantization *Quantization
+	Huffman      *HuffmanTable
+
+	Enum     *Enum
+	Struct   *Struct
+	Subtypes []*Struct
+
+	Elem      *Codec
+	Size      int
+	CountBits int
+	MaxCount  uint64
+	MaxLength uint64
+}
+
+// IntRange holds inclusive bounds of integer values, as two's complement bit patterns extended to
+// 64 bits. Values are written as value - Min (modulo 2^64), in as few bits as can hold Max - Min.
+type IntRange struct {
+	Min uint64
+	Max uint64
+}
+
+// Span returns largest value written on the wire
+func (r *IntRange) Span() uint64 {
+	return r.Max - r.Min
+}
+
+// MinString returns Min as signed or unsigned decimal number
+func (c *Codec) MinString() string {
+	if c.Signed {
+		return strconv.FormatInt(int64(c.Range.Min), 10)
+	}
+	return strconv.FormatUint(c.Range.Min, 10)
+}
+
+// MaxString returns Max as signed or unsigned decimal number
+func (c *Codec) MaxString() string {
+	if c.Signed {
+		return strconv.FormatInt(int64(c.Range.Max), 10)
+	}
+	return strconv.FormatUint(c.Range.Max, 10)
+}
+
+// Quantization holds bounds of float values written as fixed-point numbers. Value clamped to
+// [Min, Max] is written as (value - Min) * Steps / (Max - Min) rounded to the nearest integer,
+// in as few bits as can hold Steps.
+type Quantization struct {
+	Min   float64
+	Max   float64
+	Steps uint64
+}
+
+// MinString returns Min as floating point literal
+func (q *Quantization) MinString() string {
+	return floatLiteral(q.Min)
+}
+
+// MaxString returns Max as floating point literal
+func (q *Quantization) MaxString() string {
+	return floatLiteral(q.Max)
+}
+
+func floatLiteral(v float64) string {
+	s := strconv.FormatFloat(v, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+	return s
+}
+
+// IsASCII reports whether string or char codec holds only ASCII characters, written as 7 bits each
+func (c *Codec) IsASCII() bool {
+	return (c.Kind == StringCodec || c.Kind == CharCodec) && c.Bits == 7
+}
+
+// IsPolymorphic reports whether class reference can hold more than one class, so it carries subtype tag.
+// Value is written as fields of its class, which are fields of referenced class followed by fields of
+// derived classes.
+func (c *Codec) IsPolymorphic() bool {
+	return c.Kind == StructCodec && len(c.Subtypes) > 1
+}
+
+// IsDelta reports whether changed field is written as delta against its baseline value, instead of whole.
+// Quaternions and normalized vectors are always written whole, as their components aren't written separately.
+func (c *Codec) IsDelta() bool {
+	return c.Kind == StructCodec && !c.IsPolymorphic() && c.Struct.Quaternion == nil && c.Struct.Normalized == nil
+}
+
+// IsByteAligned reports whether message codec pads every bit field to whole bytes
+func (c *Codec) IsByteAligned() bool {
+	return c.Kind == StructCodec && c.Struct.ByteAligned || c.Kind == EnumCodec && c.Enum.ByteAligned
+}
+
+// EntropySymbols returns number of values of enum or ranged integer codec, when its field can be entropy
+// coded, or 0 when it can't. Only codecs of 2 to MaxEntropySymbols values can be.
+func (c *Codec) EntropySymbols() int {
+	n := 0
+	if c.Kind == EnumCodec {
+		n = len(c.Enum.Values)
+	} else if c.Kind == IntCodec && c.Range != nil && c.Range.Span() < MaxEntropySymbols {
+		n = int(c.Range.Span()) + 1
+	}
+	if n < 2 || n > MaxEntropySymbols {
+		return 0
+	}
+	return n
+}
+
+// IsDynamic reports whether array codec carries its length on the wire
+func (c *Codec) IsDynamic() bool {
+	return c.Kind == ArrayCodec && c.Size == -1
+}
+
+// MinBits returns the smallest number of bits which value of codec takes on the wire, so that decoders
+// can reject length of dynamic array which remaining data couldn't hold before they allocate its elements
+func (c *Codec) MinBits() uint64 {
+	return c.minBits(make(map[*Struct]bool))
+}
+
+// minBits returns MinBits of codec inside of structs being visited, which count as zero bits when they
+// contain themselves
+func (c *Codec) minBits(visiting map[*Struct]bool) uint64 {
+	switch c.Kind {
+	case BoolCodec:
+		return 1
+	case IntCodec, EnumCodec:
+		if c.Huffman != nil {
+			shortest := c.Huffman.Lengths[0]
+			for _, length := range c.Huffman.Lengths {
+				if length < shortest {
+					shortest = length
+				}
+			}
+			return uint64(shortest)
+		}
+		if c.Kind == IntCodec && c.Range == nil && c.Encoding != FixedEncoding {
+			// single group of varint
+			return 8
+		}
+		return uint64(c.Bits)
+	case FloatCodec, CharCodec:
+		return uint64(c.Bits)
+	case StringCodec:
+		return uint64(c.CountBits)
+	case StructCodec:
+		// class reference holds at least fields of referenced class
+		n := uint64(c.Bits)
+		if c.Struct.Quaternion != nil {
+			return n + 2 + 3*uint64(c.Struct.Quaternion.Bits)
+		}
+		if c.Struct.Normalized != nil {
+			return n + 2*uint64(c.Struct.Normalized.Bits)
+		}
+		if visiting[c.Struct] {
+			return n
+		}
+		visiting[c.Struct] = true
+		for _, field := range c.Struct.AllFields() {
+			n += field.Codec.minBits(visiting)
+		}
+		delete(visiting, c.Struct)
+		return n
+	case ArrayCodec:
+		if c.IsDynamic() {
+			return uint64(c.CountBits)
+		}
+		return uint64(c.Size) * c.Elem.minBits(visiting)
+	}
+	return 0
+}
+
+func (schema *Schema) newCodec(t *ast.VariableType, attributesList []ast.Attribute) (*Codec, error) {
+	if t.IsArray {
+		// maxCount limits arrays at every level, other attributes don't apply to elements
+		var maxCount *attributes.MaxCountAttribute
+		for _, attb := range attributesList {
+			if m, ok := attb.(*attributes.MaxCountAttribute); ok {
+				maxCount = m
+			}
+		}
+
+		var elemAttributes []ast.Attribute
+		if maxCount != nil {
+			elemAttributes = []ast.Attribute{maxCount}
+		}
+
+		elem, err := schema.newCodec(t.ArrayChildType, elemAttributes)
+		if err != nil {
+			return nil, err
+		}
+
+		codec := &Codec{
+			Kind: ArrayCodec,
+			Type: t,
+			Elem: elem,
+			Size: t.ArraySize,
+		}
+		if codec.IsDynamic() {
+			codec.CountBits = 32
+			codec.MaxCount = math.MaxUint32
+			if maxCount != nil {
+				codec.MaxCount = uint64(maxCount.MaxCount)
+				codec.CountBits = bits.Len64(codec.MaxCount)
+			}
+		}
+		return codec, nil
+	}
+
+	if !t.IsGeneric {
+		if s := schema.StructOf(t.TypeDefinition); s != nil {
+			codec := s.Codec()
+			codec.Type = t
+			if s.IsClass {
+				codec.Subtypes = s.Subtypes()
+				codec.Bits = s.SubtypeBits()
+			}
+			return codec, nil
+		}
+
+		if e := schema.EnumOf(t.TypeDefinition); e != nil {
+			codec := e.Codec()
+			codec.Type = t
+			return codec, nil
+		}
+
+		return nil, fmt.Errorf("Type %v is not linked to its definition", t.Name)
+	}
+
+	codec := &Codec{
+		Type: t,
+	}
+
+	switch t.GenericType {
+	case ast.Bool:
+		codec.Kind = BoolCodec
+		codec.Bits = 1
+	case ast.Byte:
+		codec.Kind = IntCodec
+		codec.Bits = 8
+	case ast.Short:
+		codec.Kind = IntCodec
+		codec.Bits = 16
+		codec.Signed = true
+	case ast.UnsignedShort:
+		codec.Kind = IntCodec
+		codec.Bits = 16
+	case ast.Integer32:
+		codec.Kind = IntCodec
+		codec.Bits = 32
+		codec.Signed = true
+		codec.Encoding = ZigZagEncoding
+	case ast.UnsignedInteger32:
+		codec.Kind = IntCodec
+		codec.Bits = 32
+		codec.Encoding = VarintEncoding
+	case ast.Integer64:
+		codec.Kind = IntCodec
+		codec.Bits = 64
+		codec.Signed = true
+		codec.Encoding = ZigZagEncoding
+	case ast.UnsignedInteger64:
+		codec.Kind = IntCodec
+		codec.Bits = 64
+		codec.Encoding = VarintEncoding
+	case ast.Float:
+		codec.Kind = FloatCodec
+		codec.Bits = 32
+	case ast.Double:
+		codec.Kind = FloatCodec
+		codec.Bits = 64
+	case ast.Char:
+		codec.Kind = CharCodec
+		codec.Bits = 32
+	case ast.String:
+		codec.Kind = StringCodec
+		codec.Bits = 8
+		codec.CountBits = 32
+		codec.MaxLength = math.MaxUint32
+	default:
+		return nil, fmt.Errorf("Unsupported generic type %v", t.GenericType.String())
+	}
+
+	if codec.Kind == IntCodec {
+		for _, attb := range attributesList {
+			if e, ok := attb.(*attributes.EncodingAttribute); ok {
+				codec.Encoding = intEncodings[e.Encoding]
+			}
+			if r, ok := attb.(*attributes.RangeAttribute); ok {
+				codec.Encoding = FixedEncoding
+				min, max := r.IntegerBounds()
+				codec.Range = &IntRange{
+					Min: uint64(min.Int64()),
+					Max: uint64(max.Int64()),
+				}
+				if !codec.Signed {
+					codec.Range.Min = min.Uint64()
+					codec.Range.Max = max.Uint64()
+				}
+				codec.Bits = bits.Len64(codec.Range.Span())
+			}
+		}
+	}
+
+	if codec.Kind == StringCodec || codec.Kind == CharCodec {
+		for _, attb := range attributesList {
+			switch a := attb.(type) {
+			case *attributes.CharsetAttribute:
+				if a.Charset == "ascii" {
+					codec.Bits = 7
+				}
+			case *attributes.MaxLengthAttribute:
+				codec.MaxLength = uint64(a.MaxLength)
+				codec.CountBits = bits.Len64(codec.MaxLength)
+			}
+		}
+	}
+
+	if codec.Kind == FloatCodec {
+		var r *attributes.RangeAttribute
+		var p *attributes.PrecisionAttribute
+		for _, attb := range attributesList {
+			switch a := attb.(type) {
+			case *attributes.RangeAttribute:
+				r = a
+			case *attributes.PrecisionAttribute:
+				p = a
+			}
+		}
+
+		if r != nil && p != nil {
+			codec.Quantization = &Quantization{
+				Min:   r.Range.LowerBound,
+				Max:   r.Range.UpperBound,
+				Steps: p.Steps(r.Range),
+			}
+			codec.Bits = bits.Len64(codec.Quantization.Steps)
+		}
+	}
+
+	return codec, nil
+}
+
+var intEncodings = map[string]IntEncoding{
+	"fixed":  FixedEncoding,
+	"varint": VarintEncoding,
+	"zigzag": ZigZagEncoding,
+}
+
+// Codec returns codec of struct values
+func (s *Struct) Codec() *Codec {
+	return &Codec{
+		Kind:   StructCodec,
+		Struct: s,
+	}
+}
+
+// Codec returns codec of enum values
+func (e *Enum) Codec() *Codec {
+	return &Codec{
+		Kind: EnumCodec,
+		Enum: e,
+		Bits: bits.Len(uint(len(e.Values) - 1)),
+	}
+}
@@ -0,0 +1,904 @@
+package cpp
+
+import (
+	"fmt"
+	"math"
+	"path/filepath"
+	"shrinken/gen"
+	"shrinken/sddl"
+	"shrinken/sddl/ast"
+	"strings"
+)
+
+// C++ backend generates single header per SDDL package, with package mapped to nested namespaces.
+// Structs become plain structs, classes become polymorphic structs with virtual encode and decode
+// methods (derived classes inherit from their base class) and enums become enum classes.
+// Class references are held by std::unique_ptr, so that they can point to derived classes. Classes
+// with base or derived classes register themselves to shrinken::classes of their base classes,
+// which are used to decode references to them.
+// Messages marked with delta attribute get serialize_delta and deserialize_delta methods, which
+// encode only fields changed against baseline object. Messages are also written with their IDs by
+// serialize_any, which decode_any of their package dispatches to methods of its Handler.
+// Methods are defined inline after all types of package, so types can reference each other
+// regardless of declaration order. Generated code requires C++11.
+
+type generator struct{}
+
+func init() {
+	gen.Register("cpp", &generator{})
+}
+
+func (g *generator) Generate(parsed *sddl.SDDLTree, outputPath string) error {
+	schema, err := gen.NewSchema(parsed)
+	if err != nil {
+		return err
+	}
+
+	// headers can't include each other, so packages can't depend on each other
+	if cycle := schema.DependencyCycle(); cycle != nil {
+		names := make([]string, len(cycle))
+		for i, pkg := range cycle {
+			names[i] = pkg.Name
+		}
+		return fmt.Errorf("C++ backend doesn't support circular dependency between packages (%v)", strings.Join(names, ", "))
+	}
+
+	err = gen.WriteFile(filepath.Join(outputPath, "shrinken.hpp"), []byte(runtime))
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range schema.Packages {
+		f := &packageFile{
+			schema: schema,
+			pkg:    pkg,
+		}
+
+		content, err := f.generate()
+		if err != nil {
+			return err
+		}
+
+		err = gen.WriteFile(filepath.Join(outputPath, headerName(pkg)), content)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type packageFile struct {
+	schema  *gen.Schema
+	pkg     *gen.Package
+	huffman gen.HuffmanTables
+	err     error
+}
+
+func (f *packageFile) generate() ([]byte, error) {
+	guard := strings.ToUpper(strings.Replace(f.pkg.ExportedName, ".", "_", -1)) + "_HPP"
+
+	w := gen.NewCodeWriter("    ")
+	w.Line("// Code generated by shrinken. DO NOT EDIT.")
+	w.Line("")
+	w.Line("#ifndef %v", guard)
+	w.Line("#define %v", guard)
+	w.Line("")
+	w.Line("#include <array>")
+	w.Line("#include <cstdint>")
+	w.Line("#include <memory>")
+	w.Line("#include <string>")
+	w.Line("#include <vector>")
+	w.Line("")
+	w.Line("#include \"shrinken.hpp\"")
+	for _, dep := range f.pkg.Dependencies() {
+		w.Line("#include \"%v\"", headerName(dep))
+	}
+	w.Line("")
+
+	for _, part := range namespaceParts(f.pkg) {
+		w.Line("namespace %v {", part)
+	}
+	w.Line("")
+
+	for _, s := range f.pkg.Structs {
+		w.Line("struct %v;", typeName(s.ExportedName))
+	}
+	if len(f.pkg.Structs) > 0 {
+		w.Line("")
+	}
+
+	for _, e := range f.pkg.Enums {
+		f.writeEnum(w, e)
+	}
+
+	structs := f.pkg.DeclarationOrder()
+	for _, s := range structs {
+		f.writeStruct(w, s)
+	}
+
+	// functions are written first to collect Huffman codes they use
+	body := gen.NewCodeWriter("    ")
+	for _, s := range structs {
+		f.writeStructFunctions(body, s)
+	}
+	for i, code := range f.huffman.Tables {
+		w.Line("// huffman%v is Huffman code of %v", i, code.Name)
+		w.Line("static const uint16_t huffman%v_codes[] = {%v};", i, gen.JoinInts(code.Codes))
+		w.Line("static const uint8_t huffman%v_lengths[] = {%v};", i, gen.JoinInts(code.Lengths))
+		w.Line("static const uint16_t huffman%v_counts[] = {%v};", i, gen.JoinInts(code.Counts))
+		w.Line("static const uint8_t huffman%v_symbols[] = {%v};", i, gen.JoinInts(code.Symbols))
+		w.Line("static const shrinken::HuffmanCode huffman%v = {huffman%v_codes, huffman%v_lengths, %v, huffman%v_counts, %v, huffman%v_symbols};",
+			i, i, i, len(code.Codes), i, code.MaxLength(), i)
+		w.Line("")
+	}
+	w.Raw(string(body.Bytes()))
+
+	messages := f.pkg.Messages()
+	if len(messages) > 0 {
+		f.writeHandler(w, messages)
+	}
+
+	parts := namespaceParts(f.pkg)
+	for i := len(parts) - 1; i >= 0; i-- {
+		w.Line("} // namespace %v", parts[i])
+	}
+	w.Line("")
+	w.Line("#endif")
+
+	return w.Bytes(), f.err
+}
+
+func (f *packageFile) writeEnum(w *gen.CodeWriter, e *gen.Enum) {
+	w.Line("enum class %v : int32_t {", typeName(e.ExportedName))
+	w.Indent()
+	for _, value := range e.Values {
+		w.Line("%v,", identifier(value))
+	}
+	w.Dedent()
+	w.Line("};")
+	w.Line("")
+
+	if e.IsMessage {
+		f.writeEnumFunctions(w, e)
+	}
+}
+
+func (f *packageFile) writeEnumFunctions(w *gen.CodeWriter, e *gen.Enum) {
+	name := typeName(e.ExportedName)
+
+	w.Line("inline bool serialize(%v value, std::vector<uint8_t>& out) {", name)
+	w.Indent()
+	w.Line("shrinken::BitWriter w;")
+	if e.ByteAligned {
+		w.Line("w.set_byte_aligned(true);")
+	}
+	f.encode(w, e.Codec(), "value", 0)
+	w.Line("out = w.bytes();")
+	w.Line("return w.ok();")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("inline bool deserialize(const uint8_t* data, size_t size, %v& value) {", name)
+	w.Indent()
+	w.Line("shrinken::BitReader r(data, size);")
+	if e.ByteAligned {
+		w.Line("r.set_byte_aligned(true);")
+	}
+	f.decode(w, e.Codec(), "value", 0)
+	w.Line("return r.ok();")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("constexpr uint16_t message_id(%v) {", name)
+	w.Indent()
+	w.Line("return %v;", e.Id)
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("// serialize_any writes message_id of %v followed by value", name)
+	w.Line("inline bool serialize_any(%v value, std::vector<uint8_t>& out) {", name)
+	w.Indent()
+	writeSerializeAny(w, "serialize(value, message)", "message_id(value)")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+}
+
+// writeSerializeAny writes body of serialize_any function, which prepends message ID to serialized message
+func writeSerializeAny(w *gen.CodeWriter, serialize string, id string) {
+	w.Line("std::vector<uint8_t> message;")
+	w.Line("if (!%v) {", serialize)
+	w.Indent()
+	w.Line("return false;")
+	w.Dedent()
+	w.Line("}")
+	w.Line("shrinken::write_message_id(%v, out);", id)
+	w.Line("out.insert(out.end(), message.begin(), message.end());")
+	w.Line("return true;")
+}
+
+// writeHandler writes Handler with method for each message of package, which decode_any calls with
+// decoded message
+func (f *packageFile) writeHandler(w *gen.CodeWriter, messages []*gen.Message) {
+	w.Line("// Handler handles messages decoded by decode_any, its methods return false to stop decoding with failure")
+	w.Line("class Handler {")
+	w.Line("public:")
+	w.Indent()
+	w.Line("virtual ~Handler() = default;")
+	w.Line("")
+	for _, m := range messages {
+		w.Line("virtual bool handle_%v(%v& message) = 0;", typeName(m.ExportedName), typeName(m.ExportedName))
+	}
+	w.Dedent()
+	w.Line("};")
+	w.Line("")
+
+	w.Line("// decode_any deserializes message written by serialize_any and passes it to handler, message of another")
+	w.Line("// package fails just like invalid data")
+	w.Line("inline bool decode_any(const uint8_t* data, size_t size, Handler& handler) {")
+	w.Indent()
+	w.Line("uint16_t id;")
+	w.Line("size_t id_size;")
+	w.Line("if (!shrinken::read_message_id(data, size, id, id_size)) {")
+	w.Indent()
+	w.Line("return false;")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+	w.Line("switch (id) {")
+	for _, m := range messages {
+		name := typeName(m.ExportedName)
+		if m.Struct != nil {
+			w.Line("case %v::message_id: {", name)
+			w.Indent()
+			w.Line("%v message;", name)
+			w.Line("return message.deserialize(data + id_size, size - id_size) && handler.handle_%v(message);", name)
+		} else {
+			w.Line("case message_id(%v()): {", name)
+			w.Indent()
+			w.Line("%v message;", name)
+			w.Line("return deserialize(data + id_size, size - id_size, message) && handler.handle_%v(message);", name)
+		}
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Line("default:")
+	w.Indent()
+	w.Line("return false;")
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+}
+
+func (f *packageFile) writeStruct(w *gen.CodeWriter, s *gen.Struct) {
+	name := typeName(s.ExportedName)
+
+	if s.Base != nil {
+		w.Line("struct %v : public %v {", name, f.qualifiedName(s.Base.Package, typeName(s.Base.ExportedName)))
+	} else {
+		w.Line("struct %v {", name)
+	}
+	w.Indent()
+
+	for _, field := range s.Fields {
+		w.Line("%v %v%v;", f.cppType(field.Codec), identifier(field.ExportedName), f.initializer(field.Codec))
+	}
+	if len(s.Fields) > 0 {
+		w.Line("")
+	}
+
+	switch {
+	case s.IsClass && s.Base == nil:
+		w.Line("virtual ~%v() = default;", name)
+		w.Line("")
+		w.Line("virtual void encode(shrinken::BitWriter& w) const;")
+		w.Line("virtual void decode(shrinken::BitReader& r);")
+	case s.IsClass:
+		w.Line("void encode(shrinken::BitWriter& w) const override;")
+		w.Line("void decode(shrinken::BitReader& r) override;")
+	default:
+		w.Line("void encode(shrinken::BitWriter& w) const;")
+		w.Line("void decode(shrinken::BitReader& r);")
+	}
+
+	if s.IsMessage {
+		w.Line("")
+		w.Line("static constexpr uint16_t message_id = %v;", s.Id)
+		w.Line("")
+		w.Line("bool serialize(std::vector<uint8_t>& out) const;")
+		w.Line("bool deserialize(const uint8_t* data, size_t size);")
+		w.Line("// serialize_any writes message_id followed by this message")
+		w.Line("bool serialize_any(std::vector<uint8_t>& out) const;")
+	}
+
+	if s.HasDelta {
+		w.Line("")
+		w.Line("// changed_fields reports which fields, including inherited ones, are changed against baseline")
+		w.Line("std::array<bool, %v> changed_fields(const %v& baseline) const;", len(s.AllFields()), name)
+		w.Line("bool differs(const %v& baseline) const;", name)
+		w.Line("void encode_delta(shrinken::BitWriter& w, const %v& baseline) const;", name)
+		w.Line("void decode_delta(shrinken::BitReader& r);")
+		if s.IsDelta {
+			w.Line("bool serialize_delta(const %v& baseline, std::vector<uint8_t>& out) const;", name)
+			w.Line("// deserialize_delta applies delta to this object, which has to hold baseline the delta was written against")
+			w.Line("bool deserialize_delta(const uint8_t* data, size_t size);")
+		}
+	}
+
+	if inHierarchy(s) {
+		w.Line("")
+		if s.Base == nil {
+			w.Line("virtual const char* class_name() const;")
+		} else {
+			w.Line("const char* class_name() const override;")
+		}
+		if len(s.Derived) > 0 {
+			w.Line("// subtypes are qualified names of classes which reference to %v can hold, indexed by subtype tag", name)
+			w.Line("static const std::vector<std::string>& subtypes();")
+		}
+	}
+
+	w.Dedent()
+	w.Line("};")
+	w.Line("")
+}
+
+func (f *packageFile) writeStructFunctions(w *gen.CodeWriter, s *gen.Struct) {
+	name := typeName(s.ExportedName)
+
+	w.Line("inline void %v::encode(shrinken::BitWriter& w) const {", name)
+	w.Indent()
+	if s.Base != nil {
+		w.Line("%v::encode(w);", f.qualifiedName(s.Base.Package, typeName(s.Base.ExportedName)))
+	}
+	if c := s.Quaternion; c != nil {
+		q := c.Quantization
+		w.Line("w.write_quaternion(%v, %v, %v, UINT64_C(%v), %v);", componentFields(s), q.MinString(), q.MaxString(), q.Steps, c.Bits)
+	} else if c := s.Normalized; c != nil {
+		w.Line("w.write_normalized(%v, UINT64_C(%v), %v);", componentFields(s), c.Quantization.Steps, c.Bits)
+	} else {
+		for _, field := range s.Fields {
+			f.encode(w, field.Codec, identifier(field.ExportedName), 0)
+		}
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("inline void %v::decode(shrinken::BitReader& r) {", name)
+	w.Indent()
+	if s.Base != nil {
+		w.Line("%v::decode(r);", f.qualifiedName(s.Base.Package, typeName(s.Base.ExportedName)))
+	}
+	if c := s.Quaternion; c != nil {
+		q := c.Quantization
+		w.Line("r.read_quaternion(%v, %v, %v, UINT64_C(%v), %v);", componentFields(s), q.MinString(), q.MaxString(), q.Steps, c.Bits)
+	} else if c := s.Normalized; c != nil {
+		w.Line("r.read_normalized(%v, UINT64_C(%v), %v);", componentFields(s), c.Quantization.Steps, c.Bits)
+	} else {
+		for _, field := range s.Fields {
+			f.decode(w, field.Codec, identifier(field.ExportedName), 0)
+		}
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if s.IsMessage {
+		w.Line("inline bool %v::serialize(std::vector<uint8_t>& out) const {", name)
+		w.Indent()
+		w.Line("shrinken::BitWriter w;")
+		if s.ByteAligned {
+			w.Line("w.set_byte_aligned(true);")
+		}
+		w.Line("encode(w);")
+		w.Line("out = w.bytes();")
+		w.Line("return w.ok();")
+		w.Dedent()
+		w.Line("}")
+		w.Line("")
+
+		w.Line("inline bool %v::deserialize(const uint8_t* data, size_t size) {", name)
+		w.Indent()
+		w.Line("shrinken::BitReader r(data, size);")
+		if s.ByteAligned {
+			w.Line("r.set_byte_aligned(true);")
+		}
+		w.Line("decode(r);")
+		w.Line("return r.ok();")
+		w.Dedent()
+		w.Line("}")
+		w.Line("")
+
+		w.Line("inline bool %v::serialize_any(std::vector<uint8_t>& out) const {", name)
+		w.Indent()
+		writeSerializeAny(w, "serialize(message)", "message_id")
+		w.Dedent()
+		w.Line("}")
+		w.Line("")
+	}
+
+	if s.HasDelta {
+		f.writeDeltaFunctions(w, s)
+	}
+
+	if inHierarchy(s) {
+		f.writeClassFunctions(w, s)
+	}
+}
+
+// componentFields returns comma separated components of quaternion or normalized vector struct, accessed
+// through this, as component w would be hidden by writer parameter
+func componentFields(s *gen.Struct) string {
+	names := make([]string, len(s.Fields))
+	for i, field := range s.Fields {
+		names[i] = "this->" + identifier(field.ExportedName)
+	}
+	return strings.Join(names, ", ")
+}
+
+func (f *packageFile) writeDeltaFunctions(w *gen.CodeWriter, s *gen.Struct) {
+	name := typeName(s.ExportedName)
+	fields := s.AllFields()
+
+	// fields are accessed through this, so that they can't be shadowed by locals
+	w.Line("inline std::array<bool, %v> %v::changed_fields(const %v& baseline) const {", len(fields), name, name)
+	w.Indent()
+	w.Line("std::array<bool, %v> changed{};", len(fields))
+	if len(fields) == 0 {
+		w.Line("(void)baseline;")
+	}
+	for i, field := range fields {
+		id := identifier(field.ExportedName)
+		f.compare(w, field.Codec, "this->"+id, "baseline."+id, fmt.Sprintf("changed[%v]", i), 0)
+	}
+	w.Line("return changed;")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("inline bool %v::differs(const %v& baseline) const {", name, name)
+	w.Indent()
+	w.Line("for (bool changed : changed_fields(baseline)) {")
+	w.Indent()
+	w.Line("if (changed) {")
+	w.Indent()
+	w.Line("return true;")
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Line("return false;")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("inline void %v::encode_delta(shrinken::BitWriter& w, const %v& baseline) const {", name, name)
+	w.Indent()
+	w.Line("std::array<bool, %v> changed = changed_fields(baseline);", len(fields))
+	w.Line("for (bool c : changed) {")
+	w.Indent()
+	w.Line("w.write_bool(c);")
+	w.Dedent()
+	w.Line("}")
+	for i, field := range fields {
+		id := identifier(field.ExportedName)
+		w.Line("if (changed[%v]) {", i)
+		w.Indent()
+		if field.Codec.IsDelta() && field.Codec.Struct.IsClass {
+			w.Line("shrinken::or_default(this->%v).encode_delta(w, shrinken::or_default(baseline.%v));", id, id)
+		} else if field.Codec.IsDelta() {
+			w.Line("this->%v.encode_delta(w, baseline.%v);", id, id)
+		} else {
+			f.encode(w, field.Codec, "this->"+id, 0)
+		}
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("inline void %v::decode_delta(shrinken::BitReader& r) {", name)
+	w.Indent()
+	w.Line("std::array<bool, %v> changed{};", len(fields))
+	w.Line("for (size_t i = 0; i < changed.size(); i++) {")
+	w.Indent()
+	w.Line("changed[i] = r.read_bool();")
+	w.Dedent()
+	w.Line("}")
+	for i, field := range fields {
+		id := identifier(field.ExportedName)
+		w.Line("if (changed[%v]) {", i)
+		w.Indent()
+		if field.Codec.IsDelta() && field.Codec.Struct.IsClass {
+			w.Line("if (!this->%v) {", id)
+			w.Indent()
+			w.Line("this->%v.reset(new %v());", id, f.qualifiedName(field.Codec.Struct.Package, typeName(field.Codec.Struct.ExportedName)))
+			w.Dedent()
+			w.Line("}")
+			w.Line("this->%v->decode_delta(r);", id)
+		} else if field.Codec.IsDelta() {
+			w.Line("this->%v.decode_delta(r);", id)
+		} else {
+			f.decode(w, field.Codec, "this->"+id, 0)
+		}
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if s.IsDelta {
+		w.Line("inline bool %v::serialize_delta(const %v& baseline, std::vector<uint8_t>& out) const {", name, name)
+		w.Indent()
+		w.Line("shrinken::BitWriter w;")
+		if s.ByteAligned {
+			w.Line("w.set_byte_aligned(true);")
+		}
+		w.Line("encode_delta(w, baseline);")
+		w.Line("out = w.bytes();")
+		w.Line("return w.ok();")
+		w.Dedent()
+		w.Line("}")
+		w.Line("")
+
+		w.Line("inline bool %v::deserialize_delta(const uint8_t* data, size_t size) {", name)
+		w.Indent()
+		w.Line("shrinken::BitReader r(data, size);")
+		if s.ByteAligned {
+			w.Line("r.set_byte_aligned(true);")
+		}
+		w.Line("decode_delta(r);")
+		w.Line("return r.ok();")
+		w.Dedent()
+		w.Line("}")
+		w.Line("")
+	}
+}
+
+// compare writes statements setting target to whether value a is changed against b, as defined for delta
+// encoding. References to polymorphic classes are always changed.
+func (f *packageFile) compare(w *gen.CodeWriter, c *gen.Codec, a, b, target string, depth int) {
+	switch c.Kind {
+	case gen.StructCodec:
+		if c.IsPolymorphic() {
+			w.Line("%v = true;", target)
+		} else if c.Struct.IsClass {
+			w.Line("%v = shrinken::or_default(%v).differs(shrinken::or_default(%v));", target, a, b)
+		} else {
+			w.Line("%v = %v.differs(%v);", target, a, b)
+		}
+	case gen.ArrayCodec:
+		i := fmt.Sprintf("i%v", depth)
+		w.Line("%v = %v.size() != %v.size();", target, a, b)
+		w.Line("for (size_t %v = 0; !%v && %v < %v.size(); %v++) {", i, target, i, a, i)
+		w.Indent()
+		f.compare(w, c.Elem, fmt.Sprintf("%v[%v]", a, i), fmt.Sprintf("%v[%v]", b, i), target, depth+1)
+		w.Dedent()
+		w.Line("}")
+	default:
+		w.Line("%v = %v != %v;", target, a, b)
+	}
+}
+
+func (f *packageFile) writeClassFunctions(w *gen.CodeWriter, s *gen.Struct) {
+	name := typeName(s.ExportedName)
+
+	w.Line("inline const char* %v::class_name() const {", name)
+	w.Indent()
+	w.Line("return %q;", s.QualifiedName())
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if len(s.Derived) > 0 {
+		names := make([]string, 0)
+		for _, subtype := range s.Subtypes() {
+			names = append(names, fmt.Sprintf("%q", subtype.QualifiedName()))
+		}
+
+		w.Line("inline const std::vector<std::string>& %v::subtypes() {", name)
+		w.Indent()
+		w.Line("static const std::vector<std::string> names{%v};", strings.Join(names, ", "))
+		w.Line("return names;")
+		w.Dedent()
+		w.Line("}")
+		w.Line("")
+	}
+
+	// class can be decoded from references to itself and its base classes which have derived classes
+	w.Line("namespace {")
+	for b := s; b != nil; b = b.Base {
+		if len(b.Derived) > 0 {
+			w.Line("const shrinken::ClassRegistration<%v, %v> %v_registration%v(%q);", f.qualifiedName(b.Package, typeName(b.ExportedName)), name, name, b.Depth(), s.QualifiedName())
+		}
+	}
+	w.Line("}")
+	w.Line("")
+}
+
+func (f *packageFile) encode(w *gen.CodeWriter, c *gen.Codec, expr string, depth int) {
+	switch c.Kind {
+	case gen.BoolCodec:
+		w.Line("w.write_bool(%v);", expr)
+	case gen.EnumCodec:
+		if c.Huffman != nil {
+			w.Line("w.write_symbol(static_cast<uint64_t>(%v), huffman%v);", expr, f.huffman.Index(c.Huffman))
+		} else {
+			w.Line("w.write_enum(static_cast<int32_t>(%v), %v, %v);", expr, len(c.Enum.Values), c.Bits)
+		}
+	case gen.IntCodec, gen.CharCodec:
+		if c.IsASCII() {
+			w.Line("w.write_ascii_char(%v);", expr)
+		} else if c.Huffman != nil && c.Signed {
+			w.Line("w.write_signed_range_symbol(%v, %v, %v, huffman%v);", expr, signedLiteral(c.Range.Min), signedLiteral(c.Range.Max), f.huffman.Index(c.Huffman))
+		} else if c.Huffman != nil {
+			w.Line("w.write_unsigned_range_symbol(%v, UINT64_C(%v), UINT64_C(%v), huffman%v);", expr, c.Range.Min, c.Range.Max, f.huffman.Index(c.Huffman))
+		} else if c.Range != nil && c.Signed {
+			w.Line("w.write_signed_range(%v, %v, %v, %v);", expr, signedLiteral(c.Range.Min), signedLiteral(c.Range.Max), c.Bits)
+		} else if c.Range != nil {
+			w.Line("w.write_unsigned_range(%v, UINT64_C(%v), UINT64_C(%v), %v);", expr, c.Range.Min, c.Range.Max, c.Bits)
+		} else if c.Encoding == gen.VarintEncoding {
+			w.Line("w.write_varint(static_cast<uint64_t>(%v), %v);", expr, c.Bits)
+		} else if c.Encoding == gen.ZigZagEncoding {
+			w.Line("w.write_zigzag(%v);", expr)
+		} else {
+			w.Line("w.write_bits(static_cast<uint64_t>(%v), %v);", expr, c.Bits)
+		}
+	case gen.FloatCodec:
+		if q := c.Quantization; q != nil {
+			w.Line("w.write_quantized(%v, %v, %v, UINT64_C(%v), %v);", expr, q.MinString(), q.MaxString(), q.Steps, c.Bits)
+		} else if c.Bits == 32 {
+			w.Line("w.write_float(%v);", expr)
+		} else {
+			w.Line("w.write_double(%v);", expr)
+		}
+	case gen.StringCodec:
+		if c.IsASCII() {
+			w.Line("w.write_ascii(%v, UINT64_C(%v), %v);", expr, c.MaxLength, c.CountBits)
+		} else {
+			w.Line("w.write_string(%v, UINT64_C(%v), %v);", expr, c.MaxLength, c.CountBits)
+		}
+	case gen.StructCodec:
+		if c.IsPolymorphic() {
+			name := f.qualifiedName(c.Struct.Package, typeName(c.Struct.ExportedName))
+			w.Line("if (%v) {", expr)
+			w.Indent()
+			w.Line("w.write_class(*%v, %v::subtypes(), %v);", expr, name, c.Bits)
+			w.Dedent()
+			w.Line("} else {")
+			w.Indent()
+			w.Line("w.write_class(%v(), %v::subtypes(), %v);", name, name, c.Bits)
+			w.Dedent()
+			w.Line("}")
+		} else if c.Struct.IsClass {
+			// null references are encoded as default constructed objects
+			w.Line("if (%v) {", expr)
+			w.Indent()
+			w.Line("%v->encode(w);", expr)
+			w.Dedent()
+			w.Line("} else {")
+			w.Indent()
+			w.Line("%v().encode(w);", f.qualifiedName(c.Struct.Package, typeName(c.Struct.ExportedName)))
+			w.Dedent()
+			w.Line("}")
+		} else {
+			w.Line("%v.encode(w);", expr)
+		}
+	case gen.ArrayCodec:
+		i := fmt.Sprintf("i%v", depth)
+		if c.IsDynamic() {
+			w.Line("w.write_count(%v.size(), UINT64_C(%v), %v);", expr, c.MaxCount, c.CountBits)
+		}
+		w.Line("for (size_t %v = 0; %v < %v.size(); %v++) {", i, i, expr, i)
+		w.Indent()
+		f.encode(w, c.Elem, fmt.Sprintf("%v[%v]", expr, i), depth+1)
+		w.Dedent()
+		w.Line("}")
+	}
+}
+
+func (f *packageFile) decode(w *gen.CodeWriter, c *gen.Codec, expr string, depth int) {
+	switch c.Kind {
+	case gen.BoolCodec:
+		w.Line("%v = r.read_bool();", expr)
+	case gen.EnumCodec:
+		if c.Huffman != nil {
+			w.Line("%v = static_cast<%v>(r.read_symbol(huffman%v));", expr, f.cppType(c), f.huffman.Index(c.Huffman))
+		} else {
+			w.Line("%v = static_cast<%v>(r.read_enum(%v, %v));", expr, f.cppType(c), len(c.Enum.Values), c.Bits)
+		}
+	case gen.IntCodec, gen.CharCodec:
+		if c.IsASCII() {
+			w.Line("%v = r.read_ascii_char();", expr)
+		} else if c.Huffman != nil && c.Signed {
+			w.Line("%v = static_cast<%v>(r.read_signed_range_symbol(%v, huffman%v));", expr, f.cppType(c), signedLiteral(c.Range.Min), f.huffman.Index(c.Huffman))
+		} else if c.Huffman != nil {
+			w.Line("%v = static_cast<%v>(r.read_unsigned_range_symbol(UINT64_C(%v), huffman%v));", expr, f.cppType(c), c.Range.Min, f.huffman.Index(c.Huffman))
+		} else if c.Range != nil && c.Signed {
+			w.Line("%v = static_cast<%v>(r.read_signed_range(%v, %v, %v));", expr, f.cppType(c), signedLiteral(c.Range.Min), signedLiteral(c.Range.Max), c.Bits)
+		} else if c.Range != nil {
+			w.Line("%v = static_cast<%v>(r.read_unsigned_range(UINT64_C(%v), UINT64_C(%v), %v));", expr, f.cppType(c), c.Range.Min, c.Range.Max, c.Bits)
+		} else if c.Encoding == gen.VarintEncoding {
+			w.Line("%v = static_cast<%v>(r.read_varint(%v));", expr, f.cppType(c), c.Bits)
+		} else if c.Encoding == gen.ZigZagEncoding {
+			w.Line("%v = static_cast<%v>(r.read_zigzag(%v));", expr, f.cppType(c), c.Bits)
+		} else {
+			w.Line("%v = static_cast<%v>(r.read_bits(%v));", expr, f.cppType(c), c.Bits)
+		}
+	case gen.FloatCodec:
+		if q := c.Quantization; q != nil {
+			w.Line("%v = r.read_quantized(%v, %v, UINT64_C(%v), %v);", expr, q.MinString(), q.MaxString(), q.Steps, c.Bits)
+		} else if c.Bits == 32 {
+			w.Line("%v = r.read_float();", expr)
+		} else {
+			w.Line("%v = r.read_double();", expr)
+		}
+	case gen.StringCodec:
+		if c.IsASCII() {
+			w.Line("%v = r.read_ascii(UINT64_C(%v), %v);", expr, c.MaxLength, c.CountBits)
+		} else {
+			w.Line("%v = r.read_string(UINT64_C(%v), %v);", expr, c.MaxLength, c.CountBits)
+		}
+	case gen.StructCodec:
+		if c.IsPolymorphic() {
+			name := f.qualifiedName(c.Struct.Package, typeName(c.Struct.ExportedName))
+			w.Line("%v = r.read_class<%v>(%v::subtypes(), %v);", expr, name, name, c.Bits)
+		} else if c.Struct.IsClass {
+			w.Line("%v.reset(new %v());", expr, f.qualifiedName(c.Struct.Package, typeName(c.Struct.ExportedName)))
+			w.Line("%v->decode(r);", expr)
+		} else {
+			w.Line("%v.decode(r);", expr)
+		}
+	case gen.ArrayCodec:
+		i := fmt.Sprintf("i%v", depth)
+		if c.IsDynamic() {
+			w.Line("%v.resize(r.read_count(UINT64_C(%v), %v, UINT64_C(%v)));", expr, c.MaxCount, c.CountBits, c.Elem.MinBits())
+		}
+		w.Line("for (size_t %v = 0; %v < %v.size(); %v++) {", i, i, expr, i)
+		w.Indent()
+		f.decode(w, c.Elem, fmt.Sprintf("%v[%v]", expr, i), depth+1)
+		w.Dedent()
+		w.Line("}")
+	}
+}
+
+func (f *packageFile) cppType(c *gen.Codec) string {
+	switch c.Kind {
+	case gen.ArrayCodec:
+		if c.IsDynamic() {
+			return fmt.Sprintf("std::vector<%v>", f.cppType(c.Elem))
+		}
+		return fmt.Sprintf("std::array<%v, %v>", f.cppType(c.Elem), c.Size)
+	case gen.StructCodec:
+		name := f.qualifiedName(c.Struct.Package, typeName(c.Struct.ExportedName))
+		if c.Struct.IsClass {
+			return fmt.Sprintf("std::unique_ptr<%v>", name)
+		}
+		return name
+	case gen.EnumCodec:
+		return f.qualifiedName(c.Enum.Package, typeName(c.Enum.ExportedName))
+	}
+
+	switch c.Type.GenericType {
+	case ast.Integer32:
+		return "int32_t"
+	case ast.Integer64:
+		return "int64_t"
+	case ast.Short:
+		return "int16_t"
+	case ast.UnsignedInteger32:
+		return "uint32_t"
+	case ast.UnsignedInteger64:
+		return "uint64_t"
+	case ast.UnsignedShort:
+		return "uint16_t"
+	case ast.Byte:
+		return "uint8_t"
+	case ast.Bool:
+		return "bool"
+	case ast.String:
+		return "std::string"
+	case ast.Char:
+		return "char32_t"
+	case ast.Float:
+		return "float"
+	case ast.Double:
+		return "double"
+	}
+
+	f.err = fmt.Errorf("C++ backend doesn't support type %v", c.Type.GenericType.String())
+	return ""
+}
+
+// initializer returns default member initializer, so that plain structs start zeroed
+func (f *packageFile) initializer(c *gen.Codec) string {
+	switch c.Kind {
+	case gen.ArrayCodec:
+		if c.IsDynamic() {
+			return ""
+		}
+		return "{}"
+	case gen.StructCodec, gen.StringCodec:
+		return ""
+	case gen.EnumCodec:
+		return fmt.Sprintf(" = %v::%v", f.cppType(c), identifier(c.Enum.Values[0]))
+	case gen.BoolCodec:
+		return " = false"
+	}
+	return " = 0"
+}
+
+// qualifiedName returns name qualified by namespace if it's declared in other package
+func (f *packageFile) qualifiedName(pkg *gen.Package, name string) string {
+	if pkg == f.pkg {
+		return name
+	}
+	return "::" + strings.Join(namespaceParts(pkg), "::") + "::" + name
+}
+
+// signedLiteral returns int64_t literal of two's complement value, the smallest one can't be written as literal
+func signedLiteral(value uint64) string {
+	if int64(value) == math.MinInt64 {
+		return "INT64_MIN"
+	}
+	return fmt.Sprintf("INT64_C(%v)", int64(value))
+}
+
+// inHierarchy reports whether class has base or derived classes, so it has class_name method
+func inHierarchy(s *gen.Struct) bool {
+	return s.IsClass && len(s.Root().Derived) > 0
+}
+
+func headerName(pkg *gen.Package) string {
+	return pkg.ExportedName + ".hpp"
+}
+
+func namespaceParts(pkg *gen.Package) []string {
+	parts := strings.Split(pkg.ExportedName, ".")
+	for i, part := range parts {
+		parts[i] = identifier(part)
+	}
+	return parts
+}
+
+func typeName(name string) string {
+	return identifier(gen.UpperFirst(name))
+}
+
+// identifier appends underscore to names which are reserved in C++
+func identifier(name string) string {
+	if keywords[name] {
+		return name + "_"
+	}
+	return name
+}
+
+// keywords are reserved in C++, class_name, subtypes and names of delta methods are also reserved for methods
+var keywords = map[string]bool{
+	"alignas": true, "alignof": true, "and": true, "and_eq": true, "asm": true, "auto": true,
+	"bitand": true, "bitor": true, "bool": true, "break": true, "case": true, "catch": true,
+	"char": true, "char16_t": true, "char32_t": true, "class": true, "compl": true, "const": true,
+	"constexpr": true, "const_cast": true, "continue": true, "decltype": true, "default": true,
+	"delete": true, "do": true, "double": true, "dynamic_cast": true, "else": true, "enum": true,
+	"explicit": true, "export": true, "extern": true, "false": true, "float": true, "for": true,
+	"friend": true, "goto": true, "if": true, "inline": true, "int": true, "long": true,
+	"mutable": true, "namespace": true, "new": true, "noexcept": true, "not": true, "not_eq": true,
+	"nullptr": true, "operator": true, "or": true, "or_eq": true, "private": true, "protected": true,
+	"public": true, "register": true, "reinterpret_cast": true, "return": true, "short": true,
+	"signed": true, "sizeof": true, "static": true, "static_assert": true, "static_cast": true,
+	"struct": true, "switch": true, "template": true, "this": true, "thread_local": true,
+	"throw": true, "true": true, "try": true, "typedef": true, "typeid": true, "typename": true,
+	"union": true, "unsigned": true, "using": true, "virtual": true, "void": true, "volatile": true,
+	"wchar_t": true, "while": true, "xor": true, "xor_eq": true, "class_name": true, "subtypes": true,
+	"changed_fields": true, "differs": true, "encode_delta": true, "decode_delta": true, "serialize_delta": true,
+	"deserialize_delta": true,
+}
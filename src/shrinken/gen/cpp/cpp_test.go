@@ -0,0 +1,215 @@
+package cpp
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"shrinken/gen"
+	"shrinken/wire/wiretest"
+	"strings"
+	"testing"
+)
+
+func generate(t *testing.T, filename string, expectedToSucceed bool) string {
+	tree := wiretest.Parse(t, filename)
+
+	dir, err := ioutil.TempDir("", "shrinken")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = gen.Generate(tree, "cpp", dir)
+	if (err == nil) != expectedToSucceed {
+		os.RemoveAll(dir)
+		if expectedToSucceed {
+			t.Fatal("C++ code couldn't be generated!", err)
+		} else {
+			t.Fatal("C++ code was generated, but expected generation to fail!")
+		}
+	}
+
+	return dir
+}
+
+func TestBasic(t *testing.T) {
+	dir := generate(t, "../../sddl/test_data/single_file/basic.sddl", true)
+	defer os.RemoveAll(dir)
+
+	_, err := ioutil.ReadFile(filepath.Join(dir, "shrinken.hpp"))
+	if err != nil {
+		t.Fatal("Runtime header is missing!", err)
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, "com.github.namespace.hpp"))
+	if err != nil {
+		t.Fatal("Generated header is missing!", err)
+	}
+	code := string(b)
+
+	expected := []string{
+		"namespace namespace_ {",
+		"struct Player : public Entity {",
+		"virtual void decode(shrinken::BitReader& r);",
+		"void decode(shrinken::BitReader& r) override;",
+		"inline bool Player::deserialize(const uint8_t* data, size_t size) {",
+		"Vector3 pos;",
+		"virtual bool handle_Player(Player& message) = 0;",
+	}
+	for _, e := range expected {
+		if !strings.Contains(code, e) {
+			t.Fatalf("Generated code doesn't contain %q", e)
+		}
+	}
+
+	// Entity contains Vector3 and Quaternion by value, so they have to be declared first
+	if strings.Index(code, "struct Vector3 {") > strings.Index(code, "struct Entity {") {
+		t.Fatal("Struct Vector3 is declared after struct Entity which contains it")
+	}
+}
+
+func TestCircularPackages(t *testing.T) {
+	dir := generate(t, "../../sddl/test_data/multipkg/same_name/", false)
+	os.RemoveAll(dir)
+}
+
+const conformanceMain = `#include <cstdio>
+#include <cstdlib>
+#include <iostream>
+#include <string>
+#include <vector>
+#include "conformance.hpp"
+
+static std::vector<uint8_t> read_hex() {
+    std::string input;
+    std::cin >> input;
+
+    std::vector<uint8_t> data;
+    for (size_t i = 0; i + 1 < input.size(); i += 2) {
+        data.push_back(static_cast<uint8_t>(std::stoul(input.substr(i, 2), nullptr, 16)));
+    }
+    return data;
+}
+
+static void print_hex(const std::vector<uint8_t>& data) {
+    for (uint8_t b : data) {
+        std::printf("%02x", b);
+    }
+    std::printf("\n");
+}
+
+static void check(bool ok, const char* what) {
+    if (!ok) {
+        std::cerr << what << " failed" << std::endl;
+        std::exit(1);
+    }
+}
+
+// Printer prints every handled message serialized with its ID
+class Printer : public conformance::Handler {
+public:
+    bool handle_Sample(conformance::Sample& message) override {
+        return print(message);
+    }
+
+    bool handle_Aligned(conformance::Aligned& message) override {
+        return print(message);
+    }
+
+    bool handle_Coded(conformance::Coded& message) override {
+        return print(message);
+    }
+
+    bool handle_Bulk(conformance::Bulk& message) override {
+        return print(message);
+    }
+
+private:
+    template <typename T>
+    bool print(const T& message) {
+        std::vector<uint8_t> out;
+        if (!message.serialize_any(out)) {
+            return false;
+        }
+        print_hex(out);
+        return true;
+    }
+};
+
+int main(int argc, char** argv) {
+    std::vector<uint8_t> data = read_hex();
+
+    std::vector<uint8_t> out;
+    if (argc > 1 && std::string(argv[1]) == "any") {
+        Printer printer;
+        for (; !data.empty(); data = read_hex()) {
+            check(conformance::decode_any(data.data(), data.size(), printer), "decode_any");
+        }
+        return 0;
+    }
+
+    // runtime reports only that decoding failed
+    if (argc > 1 && std::string(argv[1]) == "truncated") {
+        conformance::Bulk bulk;
+        std::printf("%s\n", bulk.deserialize(data.data(), data.size()) ? "ok" : "unexpected end");
+        return 0;
+    }
+
+    if (argc > 1 && std::string(argv[1]) == "aligned") {
+        conformance::Aligned aligned;
+        check(aligned.deserialize(data.data(), data.size()), "deserialize");
+        check(aligned.serialize(out), "serialize");
+        print_hex(out);
+        return 0;
+    }
+
+    if (argc > 1 && std::string(argv[1]) == "coded") {
+        conformance::Coded coded;
+        check(coded.deserialize(data.data(), data.size()), "deserialize");
+        check(coded.serialize(out), "serialize");
+        print_hex(out);
+        return 0;
+    }
+
+    conformance::Sample sample;
+    check(sample.deserialize(data.data(), data.size()), "deserialize");
+
+    if (argc > 1 && std::string(argv[1]) == "delta") {
+        conformance::Sample baseline;
+        check(baseline.deserialize(data.data(), data.size()), "deserialize");
+        std::vector<uint8_t> delta = read_hex();
+        check(sample.deserialize_delta(delta.data(), delta.size()), "deserialize_delta");
+
+        check(sample.serialize(out), "serialize");
+        print_hex(out);
+        check(sample.serialize_delta(baseline, out), "serialize_delta");
+        print_hex(out);
+        return 0;
+    }
+
+    check(sample.serialize(out), "serialize");
+    print_hex(out);
+    return 0;
+}
+`
+
+func TestConformance(t *testing.T) {
+	cxx := wiretest.Tool(t, "c++", "g++", "clang++")
+
+	dir := generate(t, wiretest.Schema, true)
+	defer os.RemoveAll(dir)
+
+	err := gen.WriteFile(filepath.Join(dir, "main.cpp"), []byte(conformanceMain))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	program := filepath.Join(dir, "conformance")
+	wiretest.Build(t, exec.Command(cxx, "-std=c++11", "-Wall", "-Werror", "-o", program, filepath.Join(dir, "main.cpp")))
+	wiretest.Run(t, exec.Command(program))
+	wiretest.RunDelta(t, exec.Command(program))
+	wiretest.RunAligned(t, exec.Command(program))
+	wiretest.RunCoded(t, exec.Command(program))
+	wiretest.RunAny(t, exec.Command(program))
+	wiretest.RunTruncated(t, exec.Command(program))
+}
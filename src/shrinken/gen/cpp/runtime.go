@@ -0,0 +1,632 @@
+package cpp
+
+// runtime is written to shrinken.hpp next to generated headers
+
+const runtime = `// Code generated by shrinken. DO NOT EDIT.
+
+#ifndef SHRINKEN_HPP
+#define SHRINKEN_HPP
+
+#include <algorithm>
+#include <cmath>
+#include <cstddef>
+#include <cstdint>
+#include <cstring>
+#include <map>
+#include <memory>
+#include <string>
+#include <vector>
+
+namespace shrinken {
+
+// classes returns factories of T and classes derived from it, keyed by their qualified names. Generated
+// headers register classes they declare, so that references can be decoded as classes derived in other packages.
+template <typename T>
+std::map<std::string, std::unique_ptr<T> (*)()>& classes() {
+    static std::map<std::string, std::unique_ptr<T> (*)()> factories;
+    return factories;
+}
+
+template <typename T, typename Derived>
+std::unique_ptr<T> create() {
+    return std::unique_ptr<T>(new Derived());
+}
+
+template <typename T, typename Derived>
+struct ClassRegistration {
+    explicit ClassRegistration(const char* name) {
+        classes<T>()[name] = &create<T, Derived>;
+    }
+};
+
+// sign_not_zero returns -1 for negative values and 1 for others, including negative zero
+inline double sign_not_zero(double value) {
+    return value < 0 ? -1 : 1;
+}
+
+// or_default returns object value points to, or default constructed one for null reference, which is
+// how null references are encoded
+template <typename T>
+const T& or_default(const std::unique_ptr<T>& value) {
+    static const T fallback{};
+    return value ? *value : fallback;
+}
+
+// HuffmanCode is canonical Huffman code of values 0 to count-1. Code of value is written as single lengths[value]
+// wide field codes[value], first bit of code lowest. counts holds number of codes of each length up to max_length
+// and symbols values ordered by their codes, for reading codes bit by bit.
+struct HuffmanCode {
+    const uint16_t* codes;
+    const uint8_t* lengths;
+    uint32_t count;
+    const uint16_t* counts;
+    unsigned max_length;
+    const uint8_t* symbols;
+};
+
+// BitWriter writes values as little-endian bit fields, starting from least significant bit of first byte
+class BitWriter {
+public:
+    // write_bits writes lowest bits of value
+    void write_bits(uint64_t value, unsigned bits) {
+        while (bits > 0) {
+            unsigned used = bits_ & 7;
+            if (used == 0) {
+                buf_.push_back(0);
+            }
+
+            unsigned n = 8 - used;
+            if (bits < n) {
+                n = bits;
+            }
+
+            buf_.back() |= static_cast<uint8_t>((value & ((1u << n) - 1)) << used);
+            value >>= n;
+            bits -= n;
+            bits_ += n;
+        }
+
+        if (byte_aligned_) {
+            bits_ = (bits_ + 7) & ~static_cast<size_t>(7);
+        }
+    }
+
+    // set_byte_aligned makes writer pad every following bit field with zero bits to whole bytes
+    void set_byte_aligned(bool aligned) {
+        byte_aligned_ = aligned;
+    }
+
+    // range methods write value clamped to [min, max] as offset from min
+    void write_signed_range(int64_t value, int64_t min, int64_t max, unsigned bits) {
+        if (value < min) {
+            value = min;
+        } else if (value > max) {
+            value = max;
+        }
+        write_bits(static_cast<uint64_t>(value) - static_cast<uint64_t>(min), bits);
+    }
+
+    void write_unsigned_range(uint64_t value, uint64_t min, uint64_t max, unsigned bits) {
+        if (value < min) {
+            value = min;
+        } else if (value > max) {
+            value = max;
+        }
+        write_bits(value - min, bits);
+    }
+
+    // write_symbol writes Huffman code of value, value without code is invalid
+    void write_symbol(uint64_t value, const HuffmanCode& code) {
+        if (value >= code.count) {
+            ok_ = false;
+            return;
+        }
+        write_bits(code.codes[value], code.lengths[value]);
+    }
+
+    // range symbol methods write value clamped to [min, max] as Huffman code of offset from min
+    void write_signed_range_symbol(int64_t value, int64_t min, int64_t max, const HuffmanCode& code) {
+        if (value < min) {
+            value = min;
+        } else if (value > max) {
+            value = max;
+        }
+        write_symbol(static_cast<uint64_t>(value) - static_cast<uint64_t>(min), code);
+    }
+
+    void write_unsigned_range_symbol(uint64_t value, uint64_t min, uint64_t max, const HuffmanCode& code) {
+        if (value < min) {
+            value = min;
+        } else if (value > max) {
+            value = max;
+        }
+        write_symbol(value - min, code);
+    }
+
+    // write_varint writes lowest bits of value in groups of 7 bits, starting from the lowest one,
+    // each one as 8 bit field with highest bit set if another group follows
+    void write_varint(uint64_t value, unsigned bits) {
+        if (bits < 64) {
+            value &= (UINT64_C(1) << bits) - 1;
+        }
+        while (value >= 0x80) {
+            write_bits((value & 0x7F) | 0x80, 8);
+            value >>= 7;
+        }
+        write_bits(value, 8);
+    }
+
+    // write_zigzag maps 0, -1, 1, -2... to 0, 1, 2, 3... and writes it as varint
+    void write_zigzag(int64_t value) {
+        write_varint((static_cast<uint64_t>(value) << 1) ^ (value < 0 ? UINT64_MAX : 0), 64);
+    }
+
+    // write_quantized writes value clamped to [min, max] as the nearest of steps equal steps from min
+    void write_quantized(float value, double min, double max, uint64_t steps, unsigned bits) {
+        double v = value;
+        // NaN is written as min
+        if (!(v >= min)) {
+            v = min;
+        } else if (v > max) {
+            v = max;
+        }
+        write_bits(static_cast<uint64_t>((v - min) * static_cast<double>(steps) / (max - min) + 0.5), bits);
+    }
+
+    // write_quaternion writes 2 bit index of the largest component of normalized quaternion, followed by
+    // the other three components quantized to [min, max], see specification
+    void write_quaternion(float i, float j, float k, float l, double min, double max, uint64_t steps, unsigned bits) {
+        double q[4] = {i, j, k, l};
+        double n = std::sqrt(q[0] * q[0] + q[1] * q[1] + q[2] * q[2] + q[3] * q[3]);
+        if (n > 0 && !std::isinf(n)) {
+            for (double& c : q) {
+                c /= n;
+            }
+        }
+        unsigned largest = 0;
+        for (unsigned c = 1; c < 4; c++) {
+            if (std::fabs(q[c]) > std::fabs(q[largest])) {
+                largest = c;
+            }
+        }
+        // q and -q are the same rotation
+        if (q[largest] < 0) {
+            for (double& c : q) {
+                c = -c;
+            }
+        }
+
+        write_bits(largest, 2);
+        for (unsigned c = 0; c < 4; c++) {
+            if (c != largest) {
+                write_quantized(static_cast<float>(q[c]), min, max, steps, bits);
+            }
+        }
+    }
+
+    // write_normalized writes unit vector as two octahedral coordinates quantized to [-1, 1], see specification
+    void write_normalized(float x, float y, float z, uint64_t steps, unsigned bits) {
+        double v[3] = {x, y, z};
+        double n = std::fabs(v[0]) + std::fabs(v[1]) + std::fabs(v[2]);
+        if (n > 0 && !std::isinf(n)) {
+            for (double& c : v) {
+                c /= n;
+            }
+        } else {
+            v[0] = 0;
+            v[1] = 0;
+            v[2] = 1;
+        }
+
+        double u = v[0], t = v[1];
+        if (v[2] < 0) {
+            u = (1 - std::fabs(v[1])) * sign_not_zero(v[0]);
+            t = (1 - std::fabs(v[0])) * sign_not_zero(v[1]);
+        }
+        write_quantized(static_cast<float>(u), -1, 1, steps, bits);
+        write_quantized(static_cast<float>(t), -1, 1, steps, bits);
+    }
+
+    void write_bool(bool value) {
+        write_bits(value ? 1 : 0, 1);
+    }
+
+    void write_float(float value) {
+        uint32_t bits;
+        std::memcpy(&bits, &value, sizeof(bits));
+        write_bits(bits, 32);
+    }
+
+    void write_double(double value) {
+        uint64_t bits;
+        std::memcpy(&bits, &value, sizeof(bits));
+        write_bits(bits, 64);
+    }
+
+    // write_string writes length of value in bytes as count_bits wide integer, followed by its bytes,
+    // longer values fail encoding
+    void write_string(const std::string& value, uint64_t max_length, unsigned count_bits) {
+        write_chars(value, max_length, count_bits, 8);
+    }
+
+    // write_ascii writes length of value as count_bits wide integer, followed by its characters, 7 bits each
+    void write_ascii(const std::string& value, uint64_t max_length, unsigned count_bits) {
+        for (size_t i = 0; i < value.size(); i++) {
+            if (static_cast<uint8_t>(value[i]) >= 0x80) {
+                ok_ = false;
+                return;
+            }
+        }
+        write_chars(value, max_length, count_bits, 7);
+    }
+
+    // write_count writes length of dynamic array, larger than max_count fails encoding
+    void write_count(size_t count, uint64_t max_count, unsigned count_bits) {
+        if (count > max_count) {
+            ok_ = false;
+            return;
+        }
+        write_bits(count, count_bits);
+    }
+
+    // write_enum writes index of enumeral, index not smaller than count fails encoding
+    void write_enum(int32_t value, int32_t count, unsigned bits) {
+        if (value < 0 || value >= count) {
+            ok_ = false;
+            return;
+        }
+        write_bits(static_cast<uint64_t>(value), bits);
+    }
+
+    // write_class writes index of class of value in subtypes as bits wide tag, followed by its fields
+    template <typename T>
+    void write_class(const T& value, const std::vector<std::string>& subtypes, unsigned bits) {
+        for (size_t tag = 0; tag < subtypes.size(); tag++) {
+            if (subtypes[tag] == value.class_name()) {
+                write_bits(tag, bits);
+                value.encode(*this);
+                return;
+            }
+        }
+        ok_ = false;
+    }
+
+    void write_ascii_char(char32_t value) {
+        if (value >= 0x80) {
+            ok_ = false;
+            return;
+        }
+        write_bits(value, 7);
+    }
+
+    // fail marks encoding as failed
+    void fail() {
+        ok_ = false;
+    }
+
+    bool ok() const {
+        return ok_;
+    }
+
+    // number of written bits
+    size_t bit_length() const {
+        return bits_;
+    }
+
+    const std::vector<uint8_t>& bytes() const {
+        return buf_;
+    }
+
+    void reset() {
+        buf_.clear();
+        bits_ = 0;
+        ok_ = true;
+    }
+
+private:
+    void write_chars(const std::string& value, uint64_t max_length, unsigned count_bits, unsigned char_bits) {
+        if (value.size() > max_length) {
+            ok_ = false;
+            return;
+        }
+
+        write_bits(value.size(), count_bits);
+        for (size_t i = 0; i < value.size(); i++) {
+            write_bits(static_cast<uint8_t>(value[i]), char_bits);
+        }
+    }
+
+    std::vector<uint8_t> buf_;
+    size_t bits_ = 0;
+    bool byte_aligned_ = false;
+    bool ok_ = true;
+};
+
+// BitReader reads values written by BitWriter. After first error all reads return zero values.
+class BitReader {
+public:
+    BitReader(const uint8_t* data, size_t size) : data_(data), size_(size) {
+    }
+
+    uint64_t read_bits(unsigned bits) {
+        if (!ok_ || bits > remaining()) {
+            ok_ = false;
+            return 0;
+        }
+
+        uint64_t value = 0;
+        unsigned shift = 0;
+        while (bits > 0) {
+            unsigned used = pos_ & 7;
+
+            unsigned n = 8 - used;
+            if (bits < n) {
+                n = bits;
+            }
+
+            value |= static_cast<uint64_t>((data_[pos_ >> 3] >> used) & ((1u << n) - 1)) << shift;
+            shift += n;
+            bits -= n;
+            pos_ += n;
+        }
+
+        if (byte_aligned_) {
+            pos_ = (pos_ + 7) & ~static_cast<size_t>(7);
+        }
+        return value;
+    }
+
+    // set_byte_aligned makes reader skip padding after every following bit field, up to whole bytes
+    void set_byte_aligned(bool aligned) {
+        byte_aligned_ = aligned;
+    }
+
+    bool read_bool() {
+        return read_bits(1) == 1;
+    }
+
+    float read_float() {
+        uint32_t bits = static_cast<uint32_t>(read_bits(32));
+        float value;
+        std::memcpy(&value, &bits, sizeof(value));
+        return value;
+    }
+
+    double read_double() {
+        uint64_t bits = read_bits(64);
+        double value;
+        std::memcpy(&value, &bits, sizeof(value));
+        return value;
+    }
+
+    int64_t read_signed_range(int64_t min, int64_t max, unsigned bits) {
+        uint64_t offset = read_bits(bits);
+        if (offset > static_cast<uint64_t>(max) - static_cast<uint64_t>(min)) {
+            ok_ = false;
+            return min;
+        }
+        return static_cast<int64_t>(static_cast<uint64_t>(min) + offset);
+    }
+
+    uint64_t read_unsigned_range(uint64_t min, uint64_t max, unsigned bits) {
+        uint64_t offset = read_bits(bits);
+        if (offset > max - min) {
+            ok_ = false;
+            return min;
+        }
+        return min + offset;
+    }
+
+    // read_symbol reads Huffman code bit by bit, padding of byte aligned reader is skipped only after the whole code
+    uint64_t read_symbol(const HuffmanCode& code) {
+        bool aligned = byte_aligned_;
+        byte_aligned_ = false;
+        uint64_t value = read_code(code);
+        byte_aligned_ = aligned;
+        if (aligned && ok_) {
+            pos_ = (pos_ + 7) & ~static_cast<size_t>(7);
+        }
+        return value;
+    }
+
+    int64_t read_signed_range_symbol(int64_t min, const HuffmanCode& code) {
+        return static_cast<int64_t>(static_cast<uint64_t>(min) + read_symbol(code));
+    }
+
+    uint64_t read_unsigned_range_symbol(uint64_t min, const HuffmanCode& code) {
+        return min + read_symbol(code);
+    }
+
+    // read_varint fails when value doesn't fit bits
+    uint64_t read_varint(unsigned bits) {
+        uint64_t value = 0;
+        for (unsigned shift = 0; shift < bits; shift += 7) {
+            uint64_t group = read_bits(8);
+            uint64_t data = group & 0x7F;
+            if (!ok_) {
+                return 0;
+            }
+
+            if (shift + 7 > bits && (data >> (bits - shift)) != 0) {
+                ok_ = false;
+                return 0;
+            }
+            value |= data << shift;
+
+            if ((group & 0x80) == 0) {
+                return value;
+            }
+        }
+
+        // more groups than needed for bits
+        ok_ = false;
+        return 0;
+    }
+
+    int64_t read_zigzag(unsigned bits) {
+        uint64_t value = read_varint(bits);
+        return static_cast<int64_t>((value >> 1) ^ (0 - (value & 1)));
+    }
+
+    float read_quantized(double min, double max, uint64_t steps, unsigned bits) {
+        uint64_t q = read_bits(bits);
+        if (q > steps) {
+            ok_ = false;
+            return static_cast<float>(min);
+        }
+        return static_cast<float>(min + (max - min) * static_cast<double>(q) / static_cast<double>(steps));
+    }
+
+    void read_quaternion(float& i, float& j, float& k, float& l, double min, double max, uint64_t steps, unsigned bits) {
+        float* q[4] = {&i, &j, &k, &l};
+        unsigned largest = static_cast<unsigned>(read_bits(2));
+        double sum = 0;
+        for (unsigned c = 0; c < 4; c++) {
+            if (c != largest) {
+                *q[c] = read_quantized(min, max, steps, bits);
+                sum += static_cast<double>(*q[c]) * static_cast<double>(*q[c]);
+            }
+        }
+        *q[largest] = static_cast<float>(std::sqrt(std::max(0.0, 1 - sum)));
+    }
+
+    void read_normalized(float& x, float& y, float& z, uint64_t steps, unsigned bits) {
+        double u = read_quantized(-1, 1, steps, bits);
+        double t = read_quantized(-1, 1, steps, bits);
+        double l = 1 - std::fabs(u) - std::fabs(t);
+        if (l < 0) {
+            double folded = (1 - std::fabs(t)) * sign_not_zero(u);
+            t = (1 - std::fabs(u)) * sign_not_zero(t);
+            u = folded;
+        }
+        double n = std::sqrt(u * u + t * t + l * l);
+        x = static_cast<float>(u / n);
+        y = static_cast<float>(t / n);
+        z = static_cast<float>(l / n);
+    }
+
+    // read_string fails when length is over max_length
+    std::string read_string(uint64_t max_length, unsigned count_bits) {
+        return read_chars(max_length, count_bits, 8);
+    }
+
+    std::string read_ascii(uint64_t max_length, unsigned count_bits) {
+        return read_chars(max_length, count_bits, 7);
+    }
+
+    // read_count fails when length of dynamic array is over max_count, or over what remaining data could
+    // hold with elements of at least min_bits
+    size_t read_count(uint64_t max_count, unsigned count_bits, uint64_t min_bits) {
+        uint64_t count = read_bits(count_bits);
+        if (count > max_count || (min_bits > 0 && count > remaining() / min_bits)) {
+            ok_ = false;
+            return 0;
+        }
+        return static_cast<size_t>(count);
+    }
+
+    // read_enum fails when index of enumeral is not smaller than count
+    int32_t read_enum(int32_t count, unsigned bits) {
+        uint64_t value = read_bits(bits);
+        if (value >= static_cast<uint64_t>(count)) {
+            ok_ = false;
+            return 0;
+        }
+        return static_cast<int32_t>(value);
+    }
+
+    // read_class creates object of class selected by tag and decodes it, tag outside of subtypes fails decoding
+    template <typename T>
+    std::unique_ptr<T> read_class(const std::vector<std::string>& subtypes, unsigned bits) {
+        uint64_t tag = read_bits(bits);
+        auto& factories = classes<T>();
+        auto factory = tag < subtypes.size() ? factories.find(subtypes[tag]) : factories.end();
+        if (factory == factories.end()) {
+            ok_ = false;
+            factory = factories.find(subtypes[0]);
+        }
+
+        std::unique_ptr<T> value = factory->second();
+        value->decode(*this);
+        return value;
+    }
+
+    char32_t read_ascii_char() {
+        return static_cast<char32_t>(read_bits(7));
+    }
+
+    // fail marks decoding as failed, for example when decoded value is not valid
+    void fail() {
+        ok_ = false;
+    }
+
+    bool ok() const {
+        return ok_;
+    }
+
+    // number of bits left to read
+    size_t remaining() const {
+        return size_ * 8 - pos_;
+    }
+
+private:
+    // read_code fails on incomplete code, codes of each length follow all shorter ones, counting up from first
+    uint64_t read_code(const HuffmanCode& code) {
+        unsigned c = 0, first = 0, index = 0;
+        for (unsigned length = 1; length <= code.max_length; length++) {
+            c |= static_cast<unsigned>(read_bits(1));
+            if (!ok_) {
+                return 0;
+            }
+            if (c - first < code.counts[length]) {
+                return code.symbols[index + c - first];
+            }
+            index += code.counts[length];
+            first = (first + code.counts[length]) << 1;
+            c <<= 1;
+        }
+        ok_ = false;
+        return 0;
+    }
+
+    std::string read_chars(uint64_t max_length, unsigned count_bits, unsigned char_bits) {
+        uint64_t n = read_bits(count_bits);
+        if (n > max_length || n > remaining() / char_bits) {
+            ok_ = false;
+            return std::string();
+        }
+
+        std::string value(static_cast<size_t>(n), '\0');
+        for (size_t i = 0; i < value.size(); i++) {
+            value[i] = static_cast<char>(read_bits(char_bits));
+        }
+        return value;
+    }
+
+    const uint8_t* data_;
+    size_t size_;
+    size_t pos_ = 0;
+    bool byte_aligned_ = false;
+    bool ok_ = true;
+};
+
+// serialize_any functions write ID of message as varint of up to 16 bits, followed by the serialized message,
+// and decode_any functions dispatch it by the ID. write_message_id replaces content of out with the ID.
+inline void write_message_id(uint16_t id, std::vector<uint8_t>& out) {
+    BitWriter w;
+    w.write_varint(id, 16);
+    out = w.bytes();
+}
+
+// read_message_id reads ID written by write_message_id and stores number of its bytes to id_size
+inline bool read_message_id(const uint8_t* data, size_t size, uint16_t& id, size_t& id_size) {
+    BitReader r(data, size);
+    id = static_cast<uint16_t>(r.read_varint(16));
+    id_size = size - r.remaining() / 8;
+    return r.ok();
+}
+
+} // namespace shrinken
+
+#endif
+`
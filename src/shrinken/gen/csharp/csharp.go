@@ -0,0 +1,923 @@
+package csharp
+
+import (
+	"fmt"
+	"path/filepath"
+	"shrinken/gen"
+	"shrinken/sddl"
+	"shrinken/sddl/ast"
+	"strings"
+)
+
+// C# backend generates one file per SDDL package, with package mapped to namespace.
+// Classes become C# classes (derived classes extend their base class), structs become C# structs
+// and enums become C# enums. C# structs can't inherit, so fields of base struct are copied
+// into derived struct instead. Classes with derived classes get EncodeSubtype and DecodeSubtype
+// methods, which write subtype tag of class references.
+// Generated Encode/Decode methods reuse existing instances and work on reusable BitWriter and
+// BitReader from Shrinken.cs, so they don't allocate when used in game loop. Messages marked
+// with delta attribute also get SerializeDelta and DeserializeDelta, which encode only fields
+// changed against baseline instance. Messages are also written with their IDs by SerializeAny, which
+// Messages.DecodeAny of their package dispatches to methods of IMessageHandler.
+
+type generator struct{}
+
+func init() {
+	gen.Register("csharp", &generator{})
+}
+
+func (g *generator) Generate(parsed *sddl.SDDLTree, outputPath string) error {
+	schema, err := gen.NewSchema(parsed)
+	if err != nil {
+		return err
+	}
+
+	err = gen.WriteFile(filepath.Join(outputPath, "Shrinken.cs"), []byte(runtime))
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range schema.Packages {
+		f := &packageFile{
+			schema: schema,
+			pkg:    pkg,
+		}
+
+		content, err := f.generate()
+		if err != nil {
+			return err
+		}
+
+		err = gen.WriteFile(filepath.Join(outputPath, namespace(pkg)+".cs"), content)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type packageFile struct {
+	schema  *gen.Schema
+	pkg     *gen.Package
+	temps   int
+	huffman gen.HuffmanTables
+	err     error
+}
+
+func (f *packageFile) generate() ([]byte, error) {
+	w := gen.NewCodeWriter("    ")
+	w.Line("// <auto-generated>")
+	w.Line("// Code generated by shrinken. DO NOT EDIT.")
+	w.Line("// </auto-generated>")
+	w.Line("")
+	w.Line("using Shrinken;")
+	w.Line("")
+	w.Line("namespace %v", namespace(f.pkg))
+	w.Line("{")
+	w.Indent()
+
+	for i, e := range f.pkg.Enums {
+		if i > 0 {
+			w.Line("")
+		}
+		f.writeEnum(w, e)
+	}
+
+	for i, s := range f.pkg.Structs {
+		if i > 0 || len(f.pkg.Enums) > 0 {
+			w.Line("")
+		}
+		f.writeStruct(w, s)
+	}
+
+	if messages := f.pkg.Messages(); len(messages) > 0 {
+		w.Line("")
+		f.writeDispatcher(w, messages)
+	}
+
+	if len(f.huffman.Tables) > 0 {
+		w.Line("")
+		w.Line("internal static class HuffmanCodes")
+		w.Line("{")
+		w.Indent()
+		for i, code := range f.huffman.Tables {
+			if i > 0 {
+				w.Line("")
+			}
+			w.Line("// Huffman%v is Huffman code of %v", i, code.Name)
+			w.Line("public static readonly HuffmanCode Huffman%v = new HuffmanCode(", i)
+			w.Indent()
+			w.Line("new ushort[] { %v },", gen.JoinInts(code.Codes))
+			w.Line("new byte[] { %v },", gen.JoinInts(code.Lengths))
+			w.Line("new ushort[] { %v },", gen.JoinInts(code.Counts))
+			w.Line("new byte[] { %v });", gen.JoinInts(code.Symbols))
+			w.Dedent()
+		}
+		w.Dedent()
+		w.Line("}")
+	}
+
+	w.Dedent()
+	w.Line("}")
+
+	return w.Bytes(), f.err
+}
+
+func (f *packageFile) writeEnum(w *gen.CodeWriter, e *gen.Enum) {
+	name := gen.UpperFirst(e.ExportedName)
+
+	w.Line("public enum %v", name)
+	w.Line("{")
+	w.Indent()
+	for _, value := range e.Values {
+		w.Line("%v,", value)
+	}
+	w.Dedent()
+	w.Line("}")
+
+	if !e.IsMessage {
+		return
+	}
+
+	// C# enums can't have methods, so serializers of message enums live in static class
+
+	w.Line("")
+	w.Line("public static class %vSerializer", name)
+	w.Line("{")
+	w.Indent()
+	w.Line("public const ushort MessageId = %v;", e.Id)
+	w.Line("")
+	w.Line("public static int Serialize(this %v value, byte[] buffer)", name)
+	w.Line("{")
+	w.Indent()
+	w.Line("BitWriter w = BitWriter.Shared;")
+	w.Line("w.Reset(buffer);")
+	if e.ByteAligned {
+		w.Line("w.ByteAligned = true;")
+	}
+	f.encode(w, e.Codec(), "value", 0)
+	w.Line("return w.ByteLength;")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+	w.Line("public static %v Deserialize(byte[] data, int length)", name)
+	w.Line("{")
+	w.Indent()
+	w.Line("BitReader r = BitReader.Shared;")
+	w.Line("r.Reset(data, length);")
+	if e.ByteAligned {
+		w.Line("r.ByteAligned = true;")
+	}
+	w.Line("%v value = default(%v);", name, name)
+	f.decode(w, e.Codec(), "value", 0)
+	w.Line("return value;")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+	w.Line("// SerializeAny writes MessageId followed by value to buffer and returns number of written bytes")
+	w.Line("public static int SerializeAny(this %v value, byte[] buffer)", name)
+	w.Line("{")
+	w.Indent()
+	w.Line("BitWriter w = BitWriter.Shared;")
+	w.Line("w.Reset(buffer);")
+	w.Line("w.WriteVarint(MessageId, 16);")
+	if e.ByteAligned {
+		w.Line("w.ByteAligned = true;")
+	}
+	f.encode(w, e.Codec(), "value", 0)
+	w.Line("return w.ByteLength;")
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+}
+
+// writeDispatcher writes IMessageHandler with method for each message of package and Messages.DecodeAny,
+// which calls it with decoded message
+func (f *packageFile) writeDispatcher(w *gen.CodeWriter, messages []*gen.Message) {
+	w.Line("public interface IMessageHandler")
+	w.Line("{")
+	w.Indent()
+	for _, m := range messages {
+		name := gen.UpperFirst(m.ExportedName)
+		w.Line("void Handle%v(%v message);", name, name)
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("public static class Messages")
+	w.Line("{")
+	w.Indent()
+	w.Line("// DecodeAny deserializes message written by SerializeAny and passes it to handler, ID of message")
+	w.Line("// of another package throws ShrinkenException")
+	w.Line("public static void DecodeAny(byte[] data, int length, IMessageHandler handler)")
+	w.Line("{")
+	w.Indent()
+	w.Line("BitReader r = BitReader.Shared;")
+	w.Line("r.Reset(data, length);")
+	w.Line("ushort id = (ushort)r.ReadVarint(16);")
+	w.Line("switch (id)")
+	w.Line("{")
+	w.Indent()
+	for _, m := range messages {
+		name := gen.UpperFirst(m.ExportedName)
+		w.Line("case %v:", m.Id)
+		w.Line("{")
+		w.Indent()
+		if m.Struct != nil {
+			if m.Struct.ByteAligned {
+				w.Line("r.ByteAligned = true;")
+			}
+			w.Line("%v message = new %v();", name, name)
+			w.Line("message.Decode(r);")
+		} else {
+			if m.Enum.ByteAligned {
+				w.Line("r.ByteAligned = true;")
+			}
+			w.Line("%v message = default(%v);", name, name)
+			f.decode(w, m.Enum.Codec(), "message", 0)
+		}
+		w.Line("handler.Handle%v(message);", name)
+		w.Line("return;")
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Line("default:")
+	w.Indent()
+	w.Line("throw new ShrinkenException(\"shrinken: unknown message \" + id);")
+	w.Dedent()
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+}
+
+func (f *packageFile) writeStruct(w *gen.CodeWriter, s *gen.Struct) {
+	name := gen.UpperFirst(s.ExportedName)
+
+	// fields of base structs are copied, since C# structs can't inherit
+	fields := s.Fields
+	if !s.IsClass {
+		fields = s.AllFields()
+	}
+
+	if !s.IsClass {
+		w.Line("public struct %v", name)
+	} else if s.Base != nil {
+		w.Line("public class %v : %v", name, f.typeName(s.Base.Package, gen.UpperFirst(s.Base.ExportedName)))
+	} else {
+		w.Line("public class %v", name)
+	}
+	w.Line("{")
+	w.Indent()
+
+	for _, field := range fields {
+		init := f.initializer(field.Codec)
+		if init != "" && s.IsClass {
+			w.Line("public %v %v = %v;", f.csType(field.Codec), gen.UpperFirst(field.ExportedName), init)
+		} else {
+			w.Line("public %v %v;", f.csType(field.Codec), gen.UpperFirst(field.ExportedName))
+		}
+	}
+	if len(fields) > 0 {
+		w.Line("")
+	}
+
+	modifier := ""
+	if s.IsClass {
+		if s.Base != nil {
+			modifier = "override "
+		} else {
+			modifier = "virtual "
+		}
+	}
+
+	w.Line("public %vvoid Encode(BitWriter w)", modifier)
+	w.Line("{")
+	w.Indent()
+	if s.IsClass && s.Base != nil {
+		w.Line("base.Encode(w);")
+	}
+	if c := s.Quaternion; c != nil {
+		q := c.Quantization
+		w.Line("w.WriteQuaternion(%v, %v, %v, %vUL, %v);", componentFields(s, ""), q.MinString(), q.MaxString(), q.Steps, c.Bits)
+	} else if c := s.Normalized; c != nil {
+		w.Line("w.WriteNormalized(%v, %vUL, %v);", componentFields(s, ""), c.Quantization.Steps, c.Bits)
+	} else {
+		for _, field := range fields {
+			f.encode(w, field.Codec, gen.UpperFirst(field.ExportedName), 0)
+		}
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("public %vvoid Decode(BitReader r)", modifier)
+	w.Line("{")
+	w.Indent()
+	if s.IsClass && s.Base != nil {
+		w.Line("base.Decode(r);")
+	}
+	if c := s.Quaternion; c != nil {
+		q := c.Quantization
+		w.Line("r.ReadQuaternion(%v, %v, %v, %vUL, %v);", componentFields(s, "out "), q.MinString(), q.MaxString(), q.Steps, c.Bits)
+	} else if c := s.Normalized; c != nil {
+		w.Line("r.ReadNormalized(%v, %vUL, %v);", componentFields(s, "out "), c.Quantization.Steps, c.Bits)
+	} else {
+		for _, field := range fields {
+			f.decode(w, field.Codec, gen.UpperFirst(field.ExportedName), 0)
+		}
+	}
+	w.Dedent()
+	w.Line("}")
+
+	if s.IsMessage {
+		w.Line("")
+		switch {
+		case hasMessageBase(s):
+			w.Line("public override ushort MessageId { get { return %v; } }", s.Id)
+		case s.IsClass:
+			w.Line("public virtual ushort MessageId { get { return %v; } }", s.Id)
+		default:
+			w.Line("public ushort MessageId { get { return %v; } }", s.Id)
+		}
+	}
+
+	// Serialize and Deserialize of derived class would only hide methods of base message class
+	if s.IsMessage && !hasMessageBase(s) {
+		w.Line("")
+		w.Line("// Serialize writes %v to buffer and returns number of written bytes", name)
+		w.Line("public int Serialize(byte[] buffer)")
+		w.Line("{")
+		w.Indent()
+		w.Line("BitWriter w = BitWriter.Shared;")
+		w.Line("w.Reset(buffer);")
+		if s.ByteAligned {
+			w.Line("w.ByteAligned = true;")
+		}
+		w.Line("Encode(w);")
+		w.Line("return w.ByteLength;")
+		w.Dedent()
+		w.Line("}")
+		w.Line("")
+		w.Line("public void Deserialize(byte[] data, int length)")
+		w.Line("{")
+		w.Indent()
+		w.Line("BitReader r = BitReader.Shared;")
+		w.Line("r.Reset(data, length);")
+		if s.ByteAligned {
+			w.Line("r.ByteAligned = true;")
+		}
+		w.Line("Decode(r);")
+		w.Dedent()
+		w.Line("}")
+		w.Line("")
+		w.Line("// SerializeAny writes MessageId followed by %v to buffer and returns number of written bytes", name)
+		w.Line("public int SerializeAny(byte[] buffer)")
+		w.Line("{")
+		w.Indent()
+		w.Line("BitWriter w = BitWriter.Shared;")
+		w.Line("w.Reset(buffer);")
+		w.Line("w.WriteVarint(MessageId, 16);")
+		if s.ByteAligned {
+			w.Line("w.ByteAligned = true;")
+		}
+		w.Line("Encode(w);")
+		w.Line("return w.ByteLength;")
+		w.Dedent()
+		w.Line("}")
+	}
+
+	if s.HasDelta {
+		f.writeDeltaMethods(w, s)
+	}
+
+	if s.IsClass {
+		w.Line("")
+		w.Line("// used when encoding null references")
+		if s.Base != nil {
+			w.Line("internal static new readonly %v Default = new %v();", name, name)
+		} else {
+			w.Line("internal static readonly %v Default = new %v();", name, name)
+		}
+	}
+
+	if s.IsClass && len(s.Derived) > 0 {
+		f.writeSubtypeMethods(w, s)
+	}
+
+	w.Dedent()
+	w.Line("}")
+}
+
+// componentFields returns comma separated components of quaternion or normalized vector struct, each
+// prefixed by prefix
+func componentFields(s *gen.Struct, prefix string) string {
+	names := make([]string, len(s.Fields))
+	for i, field := range s.Fields {
+		names[i] = prefix + gen.UpperFirst(field.ExportedName)
+	}
+	return strings.Join(names, ", ")
+}
+
+// writeDeltaMethods writes methods comparing struct with baseline and encoding it as delta against it.
+// Changed fields are held in locals, so that nothing is allocated.
+
+func (f *packageFile) writeDeltaMethods(w *gen.CodeWriter, s *gen.Struct) {
+	name := gen.UpperFirst(s.ExportedName)
+	fields := s.AllFields()
+
+	// methods of derived class hide ones of base class with the same signature
+	hide := ""
+	if hasDeltaBase(s, false) {
+		hide = "new "
+	}
+
+	w.Line("")
+	w.Line("// Differs reports whether any field is changed against baseline")
+	w.Line("public bool Differs(%v baseline)", name)
+	w.Line("{")
+	w.Indent()
+	if len(fields) > 0 {
+		w.Line("bool changed;")
+	}
+	for _, field := range fields {
+		fieldName := gen.UpperFirst(field.ExportedName)
+		f.compare(w, field.Codec, fieldName, "baseline."+fieldName, "changed", 0)
+		w.Line("if (changed)")
+		w.Line("{")
+		w.Indent()
+		w.Line("return true;")
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Line("return false;")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("// EncodeDelta writes mask of fields changed against baseline, followed by changed fields")
+	w.Line("public void EncodeDelta(BitWriter w, %v baseline)", name)
+	w.Line("{")
+	w.Indent()
+	for i, field := range fields {
+		fieldName := gen.UpperFirst(field.ExportedName)
+		w.Line("bool changed%v;", i)
+		f.compare(w, field.Codec, fieldName, "baseline."+fieldName, fmt.Sprintf("changed%v", i), 0)
+	}
+	for i := range fields {
+		w.Line("w.WriteBool(changed%v);", i)
+	}
+	for i, field := range fields {
+		fieldName := gen.UpperFirst(field.ExportedName)
+		w.Line("if (changed%v)", i)
+		w.Line("{")
+		w.Indent()
+		if field.Codec.IsDelta() && field.Codec.Struct.IsClass {
+			typ := f.csType(field.Codec)
+			w.Line("(%v ?? %v.Default).EncodeDelta(w, baseline.%v ?? %v.Default);", fieldName, typ, fieldName, typ)
+		} else if field.Codec.IsDelta() {
+			w.Line("%v.EncodeDelta(w, baseline.%v);", fieldName, fieldName)
+		} else {
+			f.encode(w, field.Codec, fieldName, 0)
+		}
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("// DecodeDelta reads delta written by EncodeDelta and replaces changed fields, this instance holds baseline")
+	w.Line("public %vvoid DecodeDelta(BitReader r)", hide)
+	w.Line("{")
+	w.Indent()
+	for i := range fields {
+		w.Line("bool changed%v = r.ReadBool();", i)
+	}
+	for i, field := range fields {
+		fieldName := gen.UpperFirst(field.ExportedName)
+		w.Line("if (changed%v)", i)
+		w.Line("{")
+		w.Indent()
+		if field.Codec.IsDelta() && field.Codec.Struct.IsClass {
+			w.Line("if (%v == null)", fieldName)
+			w.Line("{")
+			w.Indent()
+			w.Line("%v = new %v();", fieldName, f.csType(field.Codec))
+			w.Dedent()
+			w.Line("}")
+			w.Line("%v.DecodeDelta(r);", fieldName)
+		} else if field.Codec.IsDelta() {
+			w.Line("%v.DecodeDelta(r);", fieldName)
+		} else {
+			f.decode(w, field.Codec, fieldName, 0)
+		}
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Dedent()
+	w.Line("}")
+
+	if s.IsDelta {
+		hide = ""
+		if hasDeltaBase(s, true) {
+			hide = "new "
+		}
+
+		w.Line("")
+		w.Line("// SerializeDelta writes fields changed against baseline to buffer and returns number of written bytes")
+		w.Line("public int SerializeDelta(%v baseline, byte[] buffer)", name)
+		w.Line("{")
+		w.Indent()
+		w.Line("BitWriter w = BitWriter.Shared;")
+		w.Line("w.Reset(buffer);")
+		if s.ByteAligned {
+			w.Line("w.ByteAligned = true;")
+		}
+		w.Line("EncodeDelta(w, baseline);")
+		w.Line("return w.ByteLength;")
+		w.Dedent()
+		w.Line("}")
+		w.Line("")
+		w.Line("// DeserializeDelta applies delta to this instance, which has to hold baseline the delta was written against")
+		w.Line("public %vvoid DeserializeDelta(byte[] data, int length)", hide)
+		w.Line("{")
+		w.Indent()
+		w.Line("BitReader r = BitReader.Shared;")
+		w.Line("r.Reset(data, length);")
+		if s.ByteAligned {
+			w.Line("r.ByteAligned = true;")
+		}
+		w.Line("DecodeDelta(r);")
+		w.Dedent()
+		w.Line("}")
+	}
+}
+
+// compare writes statements setting target to whether value a is changed against b, as defined for delta
+// encoding. References to polymorphic classes are always changed, null strings and arrays are empty.
+func (f *packageFile) compare(w *gen.CodeWriter, c *gen.Codec, a, b, target string, depth int) {
+	switch c.Kind {
+	case gen.StructCodec:
+		if c.IsPolymorphic() {
+			w.Line("%v = true;", target)
+		} else if c.Struct.IsClass {
+			typ := f.csType(c)
+			w.Line("%v = (%v ?? %v.Default).Differs(%v ?? %v.Default);", target, a, typ, b, typ)
+		} else {
+			w.Line("%v = %v.Differs(%v);", target, a, b)
+		}
+	case gen.StringCodec:
+		w.Line("%v = (%v ?? \"\") != (%v ?? \"\");", target, a, b)
+	case gen.ArrayCodec:
+		i := fmt.Sprintf("i%v", depth)
+		if c.IsDynamic() {
+			n := f.temp("n")
+			w.Line("int %v = %v != null ? %v.Length : 0;", n, a, a)
+			w.Line("%v = %v != (%v != null ? %v.Length : 0);", target, n, b, b)
+			w.Line("for (int %v = 0; !%v && %v < %v; %v++)", i, target, i, n, i)
+		} else {
+			w.Line("%v = false;", target)
+			w.Line("for (int %v = 0; !%v && %v < %v; %v++)", i, target, i, c.Size, i)
+		}
+		w.Line("{")
+		w.Indent()
+		f.compare(w, c.Elem, fmt.Sprintf("%v[%v]", a, i), fmt.Sprintf("%v[%v]", b, i), target, depth+1)
+		w.Dedent()
+		w.Line("}")
+	default:
+		w.Line("%v = %v != %v;", target, a, b)
+	}
+}
+
+// writeSubtypeMethods writes methods encoding and decoding references to class, which start with subtype tag
+func (f *packageFile) writeSubtypeMethods(w *gen.CodeWriter, s *gen.Struct) {
+	name := gen.UpperFirst(s.ExportedName)
+	subtypes := s.Subtypes()
+	bits := s.SubtypeBits()
+
+	w.Line("")
+	w.Line("// EncodeSubtype writes tag of the most derived class of value, followed by its fields")
+	w.Line("internal static void EncodeSubtype(BitWriter w, %v value)", name)
+	w.Line("{")
+	w.Indent()
+	w.Line("ulong tag = 0;")
+	keyword := "if"
+	for _, tag := range gen.DerivedFirst(subtypes) {
+		if tag == 0 {
+			continue
+		}
+		w.Line("%v (value is %v)", keyword, f.typeName(subtypes[tag].Package, gen.UpperFirst(subtypes[tag].ExportedName)))
+		keyword = "else if"
+		w.Line("{")
+		w.Indent()
+		w.Line("tag = %v;", tag)
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Line("w.WriteBits(tag, %v);", bits)
+	w.Line("(value ?? Default).Encode(w);")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("// DecodeSubtype reads value written by EncodeSubtype, value is reused if it has the same class")
+	w.Line("internal static %v DecodeSubtype(BitReader r, %v value)", name, name)
+	w.Line("{")
+	w.Indent()
+	w.Line("switch (r.ReadBits(%v))", bits)
+	w.Line("{")
+	w.Indent()
+	for tag, subtype := range subtypes {
+		typ := f.typeName(subtype.Package, gen.UpperFirst(subtype.ExportedName))
+		w.Line("case %v:", tag)
+		w.Indent()
+		w.Line("if (value == null || value.GetType() != typeof(%v))", typ)
+		w.Line("{")
+		w.Indent()
+		w.Line("value = new %v();", typ)
+		w.Dedent()
+		w.Line("}")
+		w.Line("break;")
+		w.Dedent()
+	}
+	w.Line("default:")
+	w.Indent()
+	w.Line("throw new ShrinkenException(\"shrinken: unknown subtype of %v\");", s.QualifiedName())
+	w.Dedent()
+	w.Dedent()
+	w.Line("}")
+	w.Line("value.Decode(r);")
+	w.Line("return value;")
+	w.Dedent()
+	w.Line("}")
+}
+
+// temp returns name of temporary variable, unique within generated file
+func (f *packageFile) temp(prefix string) string {
+	f.temps++
+	return fmt.Sprintf("%v%v", prefix, f.temps)
+}
+
+func hasMessageBase(s *gen.Struct) bool {
+	for base := s.Base; base != nil; base = base.Base {
+		if base.IsMessage {
+			return true
+		}
+	}
+	return false
+}
+
+// hasDeltaBase reports whether base class of s has delta methods, or delta message methods if message is set
+func hasDeltaBase(s *gen.Struct, message bool) bool {
+	for base := s.Base; base != nil; base = base.Base {
+		if base.HasDelta && (!message || base.IsDelta) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *packageFile) encode(w *gen.CodeWriter, c *gen.Codec, expr string, depth int) {
+	switch c.Kind {
+	case gen.BoolCodec:
+		w.Line("w.WriteBool(%v);", expr)
+	case gen.EnumCodec:
+		if c.Huffman != nil {
+			w.Line("w.WriteSymbol((ulong)%v, HuffmanCodes.Huffman%v);", expr, f.huffman.Index(c.Huffman))
+		} else {
+			w.Line("w.WriteEnum((int)%v, %v, %v);", expr, len(c.Enum.Values), c.Bits)
+		}
+	case gen.IntCodec, gen.CharCodec:
+		if c.IsASCII() {
+			w.Line("w.WriteASCIIChar(%v);", expr)
+		} else if c.Huffman != nil && c.Signed {
+			w.Line("w.WriteSignedRangeSymbol(%v, %vL, %vL, HuffmanCodes.Huffman%v);", expr, c.MinString(), c.MaxString(), f.huffman.Index(c.Huffman))
+		} else if c.Huffman != nil {
+			w.Line("w.WriteUnsignedRangeSymbol(%v, %vUL, %vUL, HuffmanCodes.Huffman%v);", expr, c.MinString(), c.MaxString(), f.huffman.Index(c.Huffman))
+		} else if c.Range != nil && c.Signed {
+			w.Line("w.WriteSignedRange(%v, %vL, %vL, %v);", expr, c.MinString(), c.MaxString(), c.Bits)
+		} else if c.Range != nil {
+			w.Line("w.WriteUnsignedRange(%v, %vUL, %vUL, %v);", expr, c.MinString(), c.MaxString(), c.Bits)
+		} else if c.Encoding == gen.VarintEncoding {
+			w.Line("w.WriteVarint((ulong)%v, %v);", expr, c.Bits)
+		} else if c.Encoding == gen.ZigZagEncoding {
+			w.Line("w.WriteZigZag(%v);", expr)
+		} else {
+			w.Line("w.WriteBits((ulong)%v, %v);", expr, c.Bits)
+		}
+	case gen.FloatCodec:
+		if q := c.Quantization; q != nil {
+			w.Line("w.WriteQuantized(%v, %v, %v, %vUL, %v);", expr, q.MinString(), q.MaxString(), q.Steps, c.Bits)
+		} else if c.Bits == 32 {
+			w.Line("w.WriteFloat(%v);", expr)
+		} else {
+			w.Line("w.WriteDouble(%v);", expr)
+		}
+	case gen.StringCodec:
+		if c.IsASCII() {
+			w.Line("w.WriteASCII(%v, %vUL, %v);", expr, c.MaxLength, c.CountBits)
+		} else {
+			w.Line("w.WriteString(%v, %vUL, %v);", expr, c.MaxLength, c.CountBits)
+		}
+	case gen.StructCodec:
+		if c.IsPolymorphic() {
+			w.Line("%v.EncodeSubtype(w, %v);", f.csType(c), expr)
+		} else if c.Struct.IsClass {
+			w.Line("(%v ?? %v.Default).Encode(w);", expr, f.csType(c))
+		} else {
+			w.Line("%v.Encode(w);", expr)
+		}
+	case gen.ArrayCodec:
+		i := fmt.Sprintf("i%v", depth)
+		if c.IsDynamic() {
+			// null arrays are written as empty arrays
+			n := f.temp("n")
+			w.Line("int %v = %v != null ? %v.Length : 0;", n, expr, expr)
+			w.Line("w.WriteCount(%v, %vUL, %v);", n, c.MaxCount, c.CountBits)
+			w.Line("for (int %v = 0; %v < %v; %v++)", i, i, n, i)
+		} else {
+			w.Line("for (int %v = 0; %v < %v; %v++)", i, i, c.Size, i)
+		}
+		w.Line("{")
+		w.Indent()
+		f.encode(w, c.Elem, fmt.Sprintf("%v[%v]", expr, i), depth+1)
+		w.Dedent()
+		w.Line("}")
+	}
+}
+
+func (f *packageFile) decode(w *gen.CodeWriter, c *gen.Codec, expr string, depth int) {
+	switch c.Kind {
+	case gen.BoolCodec:
+		w.Line("%v = r.ReadBool();", expr)
+	case gen.EnumCodec:
+		if c.Huffman != nil {
+			w.Line("%v = (%v)r.ReadSymbol(HuffmanCodes.Huffman%v);", expr, f.csType(c), f.huffman.Index(c.Huffman))
+		} else {
+			w.Line("%v = (%v)r.ReadEnum(%v, %v);", expr, f.csType(c), len(c.Enum.Values), c.Bits)
+		}
+	case gen.IntCodec, gen.CharCodec:
+		if c.IsASCII() {
+			w.Line("%v = r.ReadASCIIChar();", expr)
+		} else if c.Huffman != nil && c.Signed {
+			w.Line("%v = (%v)r.ReadSignedRangeSymbol(%vL, HuffmanCodes.Huffman%v);", expr, f.csType(c), c.MinString(), f.huffman.Index(c.Huffman))
+		} else if c.Huffman != nil {
+			w.Line("%v = (%v)r.ReadUnsignedRangeSymbol(%vUL, HuffmanCodes.Huffman%v);", expr, f.csType(c), c.MinString(), f.huffman.Index(c.Huffman))
+		} else if c.Range != nil && c.Signed {
+			w.Line("%v = (%v)r.ReadSignedRange(%vL, %vL, %v);", expr, f.csType(c), c.MinString(), c.MaxString(), c.Bits)
+		} else if c.Range != nil {
+			w.Line("%v = (%v)r.ReadUnsignedRange(%vUL, %vUL, %v);", expr, f.csType(c), c.MinString(), c.MaxString(), c.Bits)
+		} else if c.Encoding == gen.VarintEncoding {
+			w.Line("%v = (%v)r.ReadVarint(%v);", expr, f.csType(c), c.Bits)
+		} else if c.Encoding == gen.ZigZagEncoding {
+			w.Line("%v = (%v)r.ReadZigZag(%v);", expr, f.csType(c), c.Bits)
+		} else {
+			w.Line("%v = (%v)r.ReadBits(%v);", expr, f.csType(c), c.Bits)
+		}
+	case gen.FloatCodec:
+		if q := c.Quantization; q != nil {
+			w.Line("%v = r.ReadQuantized(%v, %v, %vUL, %v);", expr, q.MinString(), q.MaxString(), q.Steps, c.Bits)
+		} else if c.Bits == 32 {
+			w.Line("%v = r.ReadFloat();", expr)
+		} else {
+			w.Line("%v = r.ReadDouble();", expr)
+		}
+	case gen.StringCodec:
+		if c.IsASCII() {
+			w.Line("%v = r.ReadASCII(%vUL, %v);", expr, c.MaxLength, c.CountBits)
+		} else {
+			w.Line("%v = r.ReadString(%vUL, %v);", expr, c.MaxLength, c.CountBits)
+		}
+	case gen.StructCodec:
+		if c.IsPolymorphic() {
+			w.Line("%v = %v.DecodeSubtype(r, %v);", expr, f.csType(c), expr)
+			return
+		}
+		if c.Struct.IsClass {
+			w.Line("if (%v == null)", expr)
+			w.Line("{")
+			w.Indent()
+			w.Line("%v = new %v();", expr, f.csType(c))
+			w.Dedent()
+			w.Line("}")
+		}
+		w.Line("%v.Decode(r);", expr)
+	case gen.ArrayCodec:
+		i := fmt.Sprintf("i%v", depth)
+		if c.IsDynamic() {
+			n := f.temp("n")
+			w.Line("int %v = r.ReadCount(%vUL, %v, %vUL);", n, c.MaxCount, c.CountBits, c.Elem.MinBits())
+			w.Line("if (%v == null || %v.Length != %v)", expr, expr, n)
+			w.Line("{")
+			w.Indent()
+			w.Line("%v = %v;", expr, f.newArray(c, n))
+			w.Dedent()
+			w.Line("}")
+		} else {
+			w.Line("if (%v == null)", expr)
+			w.Line("{")
+			w.Indent()
+			w.Line("%v = %v;", expr, f.initializer(c))
+			w.Dedent()
+			w.Line("}")
+		}
+		if c.IsDynamic() {
+			w.Line("for (int %v = 0; %v < %v.Length; %v++)", i, i, expr, i)
+		} else {
+			w.Line("for (int %v = 0; %v < %v; %v++)", i, i, c.Size, i)
+		}
+		w.Line("{")
+		w.Indent()
+		f.decode(w, c.Elem, fmt.Sprintf("%v[%v]", expr, i), depth+1)
+		w.Dedent()
+		w.Line("}")
+	}
+}
+
+// initializer returns expression which creates fixed size array, or empty string for other types
+func (f *packageFile) initializer(c *gen.Codec) string {
+	if c.Kind != gen.ArrayCodec || c.IsDynamic() {
+		return ""
+	}
+	return f.newArray(c, fmt.Sprint(c.Size))
+}
+
+// newArray returns expression which creates array of given length, size has to be placed
+// into first brackets of jagged array type (int[][] -> new int[n][])
+func (f *packageFile) newArray(c *gen.Codec, length string) string {
+	elemType := f.csType(c.Elem)
+	bracket := strings.Index(elemType, "[")
+	if bracket == -1 {
+		return fmt.Sprintf("new %v[%v]", elemType, length)
+	}
+	return fmt.Sprintf("new %v[%v]%v", elemType[:bracket], length, elemType[bracket:])
+}
+
+func (f *packageFile) csType(c *gen.Codec) string {
+	switch c.Kind {
+	case gen.ArrayCodec:
+		return f.csType(c.Elem) + "[]"
+	case gen.StructCodec:
+		return f.typeName(c.Struct.Package, gen.UpperFirst(c.Struct.ExportedName))
+	case gen.EnumCodec:
+		return f.typeName(c.Enum.Package, gen.UpperFirst(c.Enum.ExportedName))
+	}
+
+	switch c.Type.GenericType {
+	case ast.Integer32:
+		return "int"
+	case ast.Integer64:
+		return "long"
+	case ast.Short:
+		return "short"
+	case ast.UnsignedInteger32:
+		return "uint"
+	case ast.UnsignedInteger64:
+		return "ulong"
+	case ast.UnsignedShort:
+		return "ushort"
+	case ast.Byte:
+		return "byte"
+	case ast.Bool:
+		return "bool"
+	case ast.String:
+		return "string"
+	case ast.Char:
+		return "char"
+	case ast.Float:
+		return "float"
+	case ast.Double:
+		return "double"
+	}
+
+	f.err = fmt.Errorf("C# backend doesn't support type %v", c.Type.GenericType.String())
+	return ""
+}
+
+// typeName returns name of type, fully qualified if type is declared in other namespace
+func (f *packageFile) typeName(pkg *gen.Package, name string) string {
+	if pkg == f.pkg {
+		return name
+	}
+	return "global::" + namespace(pkg) + "." + name
+}
+
+// namespace returns C# namespace of package. Parts of SDDL package name are capitalized,
+// while name set by exportAs attribute is used as is.
+func namespace(pkg *gen.Package) string {
+	if pkg.ExportedName != pkg.Name {
+		return pkg.ExportedName
+	}
+
+	parts := strings.Split(pkg.Name, ".")
+	for i, part := range parts {
+		parts[i] = gen.UpperFirst(part)
+	}
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,217 @@
+package csharp
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"shrinken/gen"
+	"shrinken/wire/wiretest"
+	"strings"
+	"testing"
+)
+
+func generate(t *testing.T, filename string) string {
+	tree := wiretest.Parse(t, filename)
+
+	dir, err := ioutil.TempDir("", "shrinken")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = gen.Generate(tree, "csharp", dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal("C# code couldn't be generated!", err)
+	}
+
+	return dir
+}
+
+func readGenerated(t *testing.T, filename string) string {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal("Generated file is missing!", err)
+	}
+	return string(b)
+}
+
+func TestBasic(t *testing.T) {
+	dir := generate(t, "../../sddl/test_data/single_file/basic.sddl")
+	defer os.RemoveAll(dir)
+
+	readGenerated(t, filepath.Join(dir, "Shrinken.cs"))
+	code := readGenerated(t, filepath.Join(dir, "Com.Github.Namespace.cs"))
+
+	expected := []string{
+		"namespace Com.Github.Namespace",
+		"public class Player : Entity",
+		"public struct Vector3",
+		"public override void Encode(BitWriter w)",
+		"public int Serialize(byte[] buffer)",
+		"public Vector3 Pos;",
+		"void HandlePlayer(Player message);",
+	}
+	for _, e := range expected {
+		if !strings.Contains(code, e) {
+			t.Fatalf("Generated code doesn't contain %q", e)
+		}
+	}
+}
+
+func TestNamespaces(t *testing.T) {
+	dir := generate(t, "../../sddl/test_data/multipkg/same_name/")
+	defer os.RemoveAll(dir)
+
+	code := readGenerated(t, filepath.Join(dir, "Test.Pkg1.cs"))
+	if !strings.Contains(code, "public class Class2 : global::Test.Pkg2.Class1") {
+		t.Fatal("Class2 doesn't extend class from other namespace")
+	}
+}
+
+const conformanceProject = `<Project Sdk="Microsoft.NET.Sdk">
+  <PropertyGroup>
+    <OutputType>Exe</OutputType>
+    <TargetFramework>net8.0</TargetFramework>
+    <TreatWarningsAsErrors>true</TreatWarningsAsErrors>
+  </PropertyGroup>
+</Project>
+`
+
+const conformanceMain = `using System;
+
+// Printer prints every handled message serialized with its ID
+public class Printer : Conformance.IMessageHandler
+{
+    private readonly byte[] buffer = new byte[4096];
+
+    public void HandleSample(Conformance.Sample message)
+    {
+        Print(message.SerializeAny(buffer));
+    }
+
+    public void HandleAligned(Conformance.Aligned message)
+    {
+        Print(message.SerializeAny(buffer));
+    }
+
+    public void HandleCoded(Conformance.Coded message)
+    {
+        Print(message.SerializeAny(buffer));
+    }
+
+    public void HandleBulk(Conformance.Bulk message)
+    {
+        Print(message.SerializeAny(buffer));
+    }
+
+    private void Print(int size)
+    {
+        Console.WriteLine(Convert.ToHexString(buffer, 0, size).ToLowerInvariant());
+    }
+}
+
+public static class Program
+{
+    public static int Main(string[] args)
+    {
+        byte[] data = Convert.FromHexString(Console.ReadLine().Trim());
+
+        byte[] buffer = new byte[4096];
+        if (args.Length > 0 && args[0] == "any")
+        {
+            var printer = new Printer();
+            Conformance.Messages.DecodeAny(data, data.Length, printer);
+            for (string line = Console.ReadLine(); line != null && line.Trim().Length > 0; line = Console.ReadLine())
+            {
+                data = Convert.FromHexString(line.Trim());
+                Conformance.Messages.DecodeAny(data, data.Length, printer);
+            }
+            return 0;
+        }
+
+        if (args.Length > 0 && args[0] == "truncated")
+        {
+            try
+            {
+                new Conformance.Bulk().Deserialize(data, data.Length);
+                Console.WriteLine("ok");
+            }
+            catch (Shrinken.ShrinkenException e)
+            {
+                Console.WriteLine(e.Message);
+            }
+            return 0;
+        }
+
+        if (args.Length > 0 && args[0] == "aligned")
+        {
+            var aligned = new Conformance.Aligned();
+            aligned.Deserialize(data, data.Length);
+
+            int size = aligned.Serialize(buffer);
+            Console.WriteLine(Convert.ToHexString(buffer, 0, size).ToLowerInvariant());
+            return 0;
+        }
+
+        if (args.Length > 0 && args[0] == "coded")
+        {
+            var coded = new Conformance.Coded();
+            coded.Deserialize(data, data.Length);
+
+            int size = coded.Serialize(buffer);
+            Console.WriteLine(Convert.ToHexString(buffer, 0, size).ToLowerInvariant());
+            return 0;
+        }
+
+        var sample = new Conformance.Sample();
+        sample.Deserialize(data, data.Length);
+
+        if (args.Length > 0 && args[0] == "delta")
+        {
+            var baseline = new Conformance.Sample();
+            baseline.Deserialize(data, data.Length);
+            byte[] delta = Convert.FromHexString(Console.ReadLine().Trim());
+            sample.DeserializeDelta(delta, delta.Length);
+
+            int size = sample.Serialize(buffer);
+            Console.WriteLine(Convert.ToHexString(buffer, 0, size).ToLowerInvariant());
+            size = sample.SerializeDelta(baseline, buffer);
+            Console.WriteLine(Convert.ToHexString(buffer, 0, size).ToLowerInvariant());
+            return 0;
+        }
+
+        int n = sample.Serialize(buffer);
+        Console.WriteLine(Convert.ToHexString(buffer, 0, n).ToLowerInvariant());
+        return 0;
+    }
+}
+`
+
+func TestConformance(t *testing.T) {
+	dotnet := wiretest.Tool(t, "dotnet")
+
+	dir := generate(t, wiretest.Schema)
+	defer os.RemoveAll(dir)
+
+	err := gen.WriteFile(filepath.Join(dir, "Conformance.csproj"), []byte(conformanceProject))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = gen.WriteFile(filepath.Join(dir, "Program.cs"), []byte(conformanceMain))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := filepath.Join(dir, "out")
+	build := exec.Command(dotnet, "build", "-o", out, dir)
+	build.Env = append(os.Environ(), "DOTNET_CLI_TELEMETRY_OPTOUT=1", "DOTNET_NOLOGO=1")
+	wiretest.Build(t, build)
+	wiretest.Run(t, exec.Command(dotnet, filepath.Join(out, "Conformance.dll")))
+	wiretest.RunDelta(t, exec.Command(dotnet, filepath.Join(out, "Conformance.dll")))
+	wiretest.RunAligned(t, exec.Command(dotnet, filepath.Join(out, "Conformance.dll")))
+	wiretest.RunCoded(t, exec.Command(dotnet, filepath.Join(out, "Conformance.dll")))
+	wiretest.RunAny(t, exec.Command(dotnet, filepath.Join(out, "Conformance.dll")))
+	wiretest.RunTruncated(t, exec.Command(dotnet, filepath.Join(out, "Conformance.dll")))
+}
@@ -0,0 +1,766 @@
+package csharp
+
+// runtime is written to Shrinken.cs next to generated files. Writer and reader work over
+// caller provided buffers and can be reset, so encoding and decoding doesn't allocate.
+
+const runtime = `// <auto-generated>
+// Code generated by shrinken. DO NOT EDIT.
+// </auto-generated>
+
+using System;
+using System.Runtime.InteropServices;
+using System.Text;
+
+namespace Shrinken
+{
+    public class ShrinkenException : Exception
+    {
+        public ShrinkenException(string message) : base(message)
+        {
+        }
+    }
+
+    [StructLayout(LayoutKind.Explicit)]
+    internal struct FloatBits
+    {
+        [FieldOffset(0)] public float Float;
+        [FieldOffset(0)] public uint Bits;
+    }
+
+    // HuffmanCode is canonical Huffman code of values 0 to Codes.Length-1. Code of value is written as single
+    // Lengths[value] wide field Codes[value], first bit of code lowest. Counts holds number of codes of each length
+    // up to the longest one and Symbols values ordered by their codes, for reading codes bit by bit.
+    public sealed class HuffmanCode
+    {
+        public readonly ushort[] Codes;
+        public readonly byte[] Lengths;
+        public readonly ushort[] Counts;
+        public readonly byte[] Symbols;
+
+        public HuffmanCode(ushort[] codes, byte[] lengths, ushort[] counts, byte[] symbols)
+        {
+            this.Codes = codes;
+            this.Lengths = lengths;
+            this.Counts = counts;
+            this.Symbols = symbols;
+        }
+    }
+
+    // BitWriter writes values as little-endian bit fields, starting from least significant bit of first byte
+    public sealed class BitWriter
+    {
+        [ThreadStatic] private static BitWriter shared;
+
+        private byte[] buffer;
+        private int position;
+
+        // Shared returns writer owned by current thread, used by generated Serialize methods
+        public static BitWriter Shared
+        {
+            get
+            {
+                if (shared == null)
+                {
+                    shared = new BitWriter(new byte[0]);
+                }
+                return shared;
+            }
+        }
+
+        public BitWriter(byte[] buffer)
+        {
+            Reset(buffer);
+        }
+
+        public void Reset(byte[] buffer)
+        {
+            this.buffer = buffer;
+            this.position = 0;
+            this.ByteAligned = false;
+        }
+
+        // ByteAligned pads every following bit field with zero bits to whole bytes, Reset clears it
+        public bool ByteAligned { get; set; }
+
+        // number of written bits
+        public int BitLength
+        {
+            get { return position; }
+        }
+
+        // number of bytes containing written bits
+        public int ByteLength
+        {
+            get { return (position + 7) >> 3; }
+        }
+
+        public byte[] Buffer
+        {
+            get { return buffer; }
+        }
+
+        public void WriteBits(ulong value, int bits)
+        {
+            if (position + bits > buffer.Length * 8)
+            {
+                throw new ShrinkenException("shrinken: buffer is too small");
+            }
+
+            while (bits > 0)
+            {
+                int used = position & 7;
+                if (used == 0)
+                {
+                    buffer[position >> 3] = 0;
+                }
+
+                int n = 8 - used;
+                if (bits < n)
+                {
+                    n = bits;
+                }
+
+                buffer[position >> 3] |= (byte)((value & ((1UL << n) - 1)) << used);
+                value >>= n;
+                bits -= n;
+                position += n;
+            }
+
+            if (ByteAligned)
+            {
+                position = (position + 7) & ~7;
+            }
+        }
+
+        // WriteSignedRange writes value clamped to [min, max] as offset from min
+        public void WriteSignedRange(long value, long min, long max, int bits)
+        {
+            if (value < min)
+            {
+                value = min;
+            }
+            else if (value > max)
+            {
+                value = max;
+            }
+            WriteBits(unchecked((ulong)value - (ulong)min), bits);
+        }
+
+        // WriteUnsignedRange writes value clamped to [min, max] as offset from min
+        public void WriteUnsignedRange(ulong value, ulong min, ulong max, int bits)
+        {
+            if (value < min)
+            {
+                value = min;
+            }
+            else if (value > max)
+            {
+                value = max;
+            }
+            WriteBits(value - min, bits);
+        }
+
+        // WriteSymbol writes Huffman code of value, value without code is invalid
+        public void WriteSymbol(ulong value, HuffmanCode code)
+        {
+            if (value >= (ulong)code.Codes.Length)
+            {
+                throw new ShrinkenException("shrinken: " + value + " has no Huffman code");
+            }
+            WriteBits(code.Codes[value], code.Lengths[value]);
+        }
+
+        // WriteSignedRangeSymbol writes value clamped to [min, max] as Huffman code of offset from min
+        public void WriteSignedRangeSymbol(long value, long min, long max, HuffmanCode code)
+        {
+            if (value < min)
+            {
+                value = min;
+            }
+            else if (value > max)
+            {
+                value = max;
+            }
+            WriteSymbol(unchecked((ulong)value - (ulong)min), code);
+        }
+
+        // WriteUnsignedRangeSymbol writes value clamped to [min, max] as Huffman code of offset from min
+        public void WriteUnsignedRangeSymbol(ulong value, ulong min, ulong max, HuffmanCode code)
+        {
+            if (value < min)
+            {
+                value = min;
+            }
+            else if (value > max)
+            {
+                value = max;
+            }
+            WriteSymbol(value - min, code);
+        }
+
+        // WriteVarint writes lowest bits of value in groups of 7 bits, starting from the lowest one,
+        // each one as 8 bit field with highest bit set if another group follows
+        public void WriteVarint(ulong value, int bits)
+        {
+            if (bits < 64)
+            {
+                value &= (1UL << bits) - 1;
+            }
+            while (value >= 0x80)
+            {
+                WriteBits((value & 0x7F) | 0x80, 8);
+                value >>= 7;
+            }
+            WriteBits(value, 8);
+        }
+
+        // WriteZigZag maps 0, -1, 1, -2... to 0, 1, 2, 3... and writes it as varint
+        public void WriteZigZag(long value)
+        {
+            WriteVarint(unchecked((ulong)((value << 1) ^ (value >> 63))), 64);
+        }
+
+        // WriteQuantized writes value clamped to [min, max] as the nearest of steps equal steps from min
+        public void WriteQuantized(float value, double min, double max, ulong steps, int bits)
+        {
+            double v = value;
+            // NaN is written as min
+            if (!(v >= min))
+            {
+                v = min;
+            }
+            else if (v > max)
+            {
+                v = max;
+            }
+            WriteBits((ulong)((v - min) * steps / (max - min) + 0.5), bits);
+        }
+
+        // WriteQuaternion writes 2 bit index of the largest component of normalized quaternion, followed by
+        // the other three components quantized to [min, max], see specification
+        public void WriteQuaternion(float i, float j, float k, float l, double min, double max, ulong steps, int bits)
+        {
+            // components are locals, so that writing quaternion doesn't allocate
+            double qi = i, qj = j, qk = k, ql = l;
+            double n = Math.Sqrt(qi * qi + qj * qj + qk * qk + ql * ql);
+            if (n > 0 && !double.IsInfinity(n))
+            {
+                qi /= n;
+                qj /= n;
+                qk /= n;
+                ql /= n;
+            }
+            int largest = 0;
+            double largestValue = qi;
+            if (Math.Abs(qj) > Math.Abs(largestValue))
+            {
+                largest = 1;
+                largestValue = qj;
+            }
+            if (Math.Abs(qk) > Math.Abs(largestValue))
+            {
+                largest = 2;
+                largestValue = qk;
+            }
+            if (Math.Abs(ql) > Math.Abs(largestValue))
+            {
+                largest = 3;
+                largestValue = ql;
+            }
+            // q and -q are the same rotation
+            if (largestValue < 0)
+            {
+                qi = -qi;
+                qj = -qj;
+                qk = -qk;
+                ql = -ql;
+            }
+
+            WriteBits((ulong)largest, 2);
+            if (largest != 0)
+            {
+                WriteQuantized((float)qi, min, max, steps, bits);
+            }
+            if (largest != 1)
+            {
+                WriteQuantized((float)qj, min, max, steps, bits);
+            }
+            if (largest != 2)
+            {
+                WriteQuantized((float)qk, min, max, steps, bits);
+            }
+            if (largest != 3)
+            {
+                WriteQuantized((float)ql, min, max, steps, bits);
+            }
+        }
+
+        // WriteNormalized writes unit vector as two octahedral coordinates quantized to [-1, 1], see specification
+        public void WriteNormalized(float x, float y, float z, ulong steps, int bits)
+        {
+            // components are locals, so that writing vector doesn't allocate
+            double vx = x, vy = y, vz = z;
+            double n = Math.Abs(vx) + Math.Abs(vy) + Math.Abs(vz);
+            if (n > 0 && !double.IsInfinity(n))
+            {
+                vx /= n;
+                vy /= n;
+                vz /= n;
+            }
+            else
+            {
+                vx = 0;
+                vy = 0;
+                vz = 1;
+            }
+
+            double u = vx, t = vy;
+            if (vz < 0)
+            {
+                u = (1 - Math.Abs(vy)) * SignNotZero(vx);
+                t = (1 - Math.Abs(vx)) * SignNotZero(vy);
+            }
+            WriteQuantized((float)u, -1, 1, steps, bits);
+            WriteQuantized((float)t, -1, 1, steps, bits);
+        }
+
+        internal static double SignNotZero(double value)
+        {
+            return value < 0 ? -1 : 1;
+        }
+
+        public void WriteBool(bool value)
+        {
+            WriteBits(value ? 1UL : 0UL, 1);
+        }
+
+        public void WriteFloat(float value)
+        {
+            FloatBits f = new FloatBits();
+            f.Float = value;
+            WriteBits(f.Bits, 32);
+        }
+
+        public void WriteDouble(double value)
+        {
+            WriteBits((ulong)BitConverter.DoubleToInt64Bits(value), 64);
+        }
+
+        // WriteString writes UTF-8 length of value as countBits wide integer, followed by its bytes
+        public void WriteString(string value, ulong maxLength, int countBits)
+        {
+            if (value == null)
+            {
+                value = "";
+            }
+
+            int n = Encoding.UTF8.GetByteCount(value);
+            if ((ulong)n > maxLength)
+            {
+                throw new ShrinkenException("shrinken: value is longer than its limit");
+            }
+
+            WriteBits((ulong)n, countBits);
+            for (int i = 0; i < value.Length; i++)
+            {
+                int c = value[i];
+                if (char.IsHighSurrogate(value, i) && i + 1 < value.Length)
+                {
+                    c = char.ConvertToUtf32(value[i], value[i + 1]);
+                    i++;
+                }
+                WriteUtf8(c);
+            }
+        }
+
+        // WriteASCII writes length of value as countBits wide integer, followed by its characters, 7 bits each
+        public void WriteASCII(string value, ulong maxLength, int countBits)
+        {
+            if (value == null)
+            {
+                value = "";
+            }
+
+            if ((ulong)value.Length > maxLength)
+            {
+                throw new ShrinkenException("shrinken: value is longer than its limit");
+            }
+
+            WriteBits((ulong)value.Length, countBits);
+            for (int i = 0; i < value.Length; i++)
+            {
+                WriteASCIIChar(value[i]);
+            }
+        }
+
+        // WriteCount writes length of dynamic array as countBits wide integer
+        public void WriteCount(int count, ulong maxCount, int countBits)
+        {
+            if ((ulong)count > maxCount)
+            {
+                throw new ShrinkenException("shrinken: array of " + count + " elements is longer than " + maxCount);
+            }
+            WriteBits((ulong)count, countBits);
+        }
+
+        // WriteEnum writes index of enumeral, index not smaller than count is invalid
+        public void WriteEnum(int value, int count, int bits)
+        {
+            if (value < 0 || value >= count)
+            {
+                throw new ShrinkenException("shrinken: " + value + " is not index of enumeral");
+            }
+            WriteBits((ulong)value, bits);
+        }
+
+        public void WriteASCIIChar(char value)
+        {
+            if (value >= 0x80)
+            {
+                throw new ShrinkenException("shrinken: character is not ASCII");
+            }
+            WriteBits(value, 7);
+        }
+
+        private void WriteUtf8(int c)
+        {
+            if (c < 0x80)
+            {
+                WriteBits((ulong)c, 8);
+            }
+            else if (c < 0x800)
+            {
+                WriteBits((ulong)(0xC0 | (c >> 6)), 8);
+                WriteBits((ulong)(0x80 | (c & 0x3F)), 8);
+            }
+            else if (c < 0x10000)
+            {
+                WriteBits((ulong)(0xE0 | (c >> 12)), 8);
+                WriteBits((ulong)(0x80 | ((c >> 6) & 0x3F)), 8);
+                WriteBits((ulong)(0x80 | (c & 0x3F)), 8);
+            }
+            else
+            {
+                WriteBits((ulong)(0xF0 | (c >> 18)), 8);
+                WriteBits((ulong)(0x80 | ((c >> 12) & 0x3F)), 8);
+                WriteBits((ulong)(0x80 | ((c >> 6) & 0x3F)), 8);
+                WriteBits((ulong)(0x80 | (c & 0x3F)), 8);
+            }
+        }
+    }
+
+    // BitReader reads values written by BitWriter
+    public sealed class BitReader
+    {
+        [ThreadStatic] private static BitReader shared;
+        [ThreadStatic] private static byte[] scratch;
+
+        private byte[] buffer;
+        private int length;
+        private int position;
+
+        // Shared returns reader owned by current thread, used by generated Deserialize methods
+        public static BitReader Shared
+        {
+            get
+            {
+                if (shared == null)
+                {
+                    shared = new BitReader(new byte[0], 0);
+                }
+                return shared;
+            }
+        }
+
+        public BitReader(byte[] buffer, int length)
+        {
+            Reset(buffer, length);
+        }
+
+        public void Reset(byte[] buffer, int length)
+        {
+            this.buffer = buffer;
+            this.length = length;
+            this.position = 0;
+            this.ByteAligned = false;
+        }
+
+        // ByteAligned skips padding after every following bit field, up to whole bytes, Reset clears it
+        public bool ByteAligned { get; set; }
+
+        // number of bits left to read
+        public int Remaining
+        {
+            get { return length * 8 - position; }
+        }
+
+        public ulong ReadBits(int bits)
+        {
+            if (bits > Remaining)
+            {
+                throw new ShrinkenException("shrinken: unexpected end of data");
+            }
+
+            ulong value = 0;
+            int shift = 0;
+            while (bits > 0)
+            {
+                int used = position & 7;
+
+                int n = 8 - used;
+                if (bits < n)
+                {
+                    n = bits;
+                }
+
+                value |= (ulong)((buffer[position >> 3] >> used) & ((1 << n) - 1)) << shift;
+                shift += n;
+                bits -= n;
+                position += n;
+            }
+
+            if (ByteAligned)
+            {
+                position = (position + 7) & ~7;
+            }
+            return value;
+        }
+
+        public long ReadSignedRange(long min, long max, int bits)
+        {
+            ulong offset = ReadBits(bits);
+            if (offset > unchecked((ulong)max - (ulong)min))
+            {
+                throw new ShrinkenException("shrinken: value is out of range");
+            }
+            return unchecked((long)((ulong)min + offset));
+        }
+
+        public ulong ReadUnsignedRange(ulong min, ulong max, int bits)
+        {
+            ulong offset = ReadBits(bits);
+            if (offset > max - min)
+            {
+                throw new ShrinkenException("shrinken: value is out of range");
+            }
+            return min + offset;
+        }
+
+        // ReadSymbol reads Huffman code bit by bit, padding of byte aligned reader is skipped only after the whole code
+        public ulong ReadSymbol(HuffmanCode code)
+        {
+            bool aligned = ByteAligned;
+            ByteAligned = false;
+            try
+            {
+                // codes of each length follow all shorter ones, counting up from first
+                int c = 0, first = 0, index = 0;
+                for (int length = 1; length < code.Counts.Length; length++)
+                {
+                    c |= (int)ReadBits(1);
+                    if (c - first < code.Counts[length])
+                    {
+                        return code.Symbols[index + c - first];
+                    }
+                    index += code.Counts[length];
+                    first = (first + code.Counts[length]) << 1;
+                    c <<= 1;
+                }
+                throw new ShrinkenException("shrinken: incomplete Huffman code");
+            }
+            finally
+            {
+                ByteAligned = aligned;
+                if (aligned)
+                {
+                    position = (position + 7) & ~7;
+                }
+            }
+        }
+
+        public long ReadSignedRangeSymbol(long min, HuffmanCode code)
+        {
+            return unchecked((long)((ulong)min + ReadSymbol(code)));
+        }
+
+        public ulong ReadUnsignedRangeSymbol(ulong min, HuffmanCode code)
+        {
+            return min + ReadSymbol(code);
+        }
+
+        public ulong ReadVarint(int bits)
+        {
+            ulong value = 0;
+            for (int shift = 0; shift < bits; shift += 7)
+            {
+                ulong group = ReadBits(8);
+                ulong data = group & 0x7F;
+                if (shift + 7 > bits && (data >> (bits - shift)) != 0)
+                {
+                    throw new ShrinkenException("shrinken: varint doesn't fit its type");
+                }
+                value |= data << shift;
+
+                if ((group & 0x80) == 0)
+                {
+                    return value;
+                }
+            }
+            throw new ShrinkenException("shrinken: varint doesn't fit its type");
+        }
+
+        public long ReadZigZag(int bits)
+        {
+            ulong value = ReadVarint(bits);
+            return unchecked((long)(value >> 1) ^ -(long)(value & 1));
+        }
+
+        public float ReadQuantized(double min, double max, ulong steps, int bits)
+        {
+            ulong q = ReadBits(bits);
+            if (q > steps)
+            {
+                throw new ShrinkenException("shrinken: value is out of range");
+            }
+            return (float)(min + (max - min) * q / steps);
+        }
+
+        public void ReadQuaternion(out float i, out float j, out float k, out float l, double min, double max, ulong steps, int bits)
+        {
+            int largest = (int)ReadBits(2);
+            i = largest != 0 ? ReadQuantized(min, max, steps, bits) : 0;
+            j = largest != 1 ? ReadQuantized(min, max, steps, bits) : 0;
+            k = largest != 2 ? ReadQuantized(min, max, steps, bits) : 0;
+            l = largest != 3 ? ReadQuantized(min, max, steps, bits) : 0;
+
+            // largest component is zero in the sum
+            double sum = (double)i * i + (double)j * j + (double)k * k + (double)l * l;
+            float computed = (float)Math.Sqrt(Math.Max(0, 1 - sum));
+            switch (largest)
+            {
+                case 0:
+                    i = computed;
+                    break;
+                case 1:
+                    j = computed;
+                    break;
+                case 2:
+                    k = computed;
+                    break;
+                default:
+                    l = computed;
+                    break;
+            }
+        }
+
+        public void ReadNormalized(out float x, out float y, out float z, ulong steps, int bits)
+        {
+            double u = ReadQuantized(-1, 1, steps, bits);
+            double t = ReadQuantized(-1, 1, steps, bits);
+            double l = 1 - Math.Abs(u) - Math.Abs(t);
+            if (l < 0)
+            {
+                double folded = (1 - Math.Abs(t)) * BitWriter.SignNotZero(u);
+                t = (1 - Math.Abs(u)) * BitWriter.SignNotZero(t);
+                u = folded;
+            }
+            double n = Math.Sqrt(u * u + t * t + l * l);
+            x = (float)(u / n);
+            y = (float)(t / n);
+            z = (float)(l / n);
+        }
+
+        public bool ReadBool()
+        {
+            return ReadBits(1) == 1;
+        }
+
+        public float ReadFloat()
+        {
+            FloatBits f = new FloatBits();
+            f.Bits = (uint)ReadBits(32);
+            return f.Float;
+        }
+
+        public double ReadDouble()
+        {
+            return BitConverter.Int64BitsToDouble((long)ReadBits(64));
+        }
+
+        // ReadString reads string written by WriteString, longer strings are invalid
+        public string ReadString(ulong maxLength, int countBits)
+        {
+            int n = ReadLength(maxLength, countBits, 8);
+            for (int i = 0; i < n; i++)
+            {
+                scratch[i] = (byte)ReadBits(8);
+            }
+
+            return Encoding.UTF8.GetString(scratch, 0, n);
+        }
+
+        // ReadASCII reads string written by WriteASCII, longer strings are invalid
+        public string ReadASCII(ulong maxLength, int countBits)
+        {
+            int n = ReadLength(maxLength, countBits, 7);
+            for (int i = 0; i < n; i++)
+            {
+                scratch[i] = (byte)ReadBits(7);
+            }
+
+            return Encoding.ASCII.GetString(scratch, 0, n);
+        }
+
+        // ReadCount reads length of dynamic array, larger than maxCount is invalid. Length larger than remaining
+        // data could hold, with elements of at least minBits, is unexpected end.
+        public int ReadCount(ulong maxCount, int countBits, ulong minBits)
+        {
+            ulong count = ReadBits(countBits);
+            if (count > maxCount || count > int.MaxValue)
+            {
+                throw new ShrinkenException("shrinken: array of " + count + " elements is longer than " + maxCount);
+            }
+            if (minBits > 0 && count > (ulong)Remaining / minBits)
+            {
+                throw new ShrinkenException("shrinken: unexpected end of data");
+            }
+            return (int)count;
+        }
+
+        // ReadEnum reads index of enumeral, index not smaller than count is invalid
+        public int ReadEnum(int count, int bits)
+        {
+            ulong value = ReadBits(bits);
+            if (value >= (ulong)count)
+            {
+                throw new ShrinkenException("shrinken: " + value + " is not index of enumeral");
+            }
+            return (int)value;
+        }
+
+        public char ReadASCIIChar()
+        {
+            return (char)ReadBits(7);
+        }
+
+        private int ReadLength(ulong maxLength, int countBits, int charBits)
+        {
+            ulong n = ReadBits(countBits);
+            if (n > maxLength)
+            {
+                throw new ShrinkenException("shrinken: value is longer than its limit");
+            }
+            if (n > (ulong)(Remaining / charBits))
+            {
+                throw new ShrinkenException("shrinken: unexpected end of data");
+            }
+
+            if (scratch == null || scratch.Length < (int)n)
+            {
+                scratch = new byte[Math.Max((int)n, 64)];
+            }
+            return (int)n;
+        }
+    }
+}
+`
@@ -0,0 +1,210 @@
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"shrinken/sddl"
+	"shrinken/sddl/ast"
+	"shrinken/sddl/ast/attributes"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	MaxEntropySymbols = 256 // values of largest entropy coded field
+	MaxCodeLength     = 16  // bits of longest Huffman code
+)
+
+// EntropyTables holds lengths of Huffman codes of values of entropy coded fields, keyed by qualified name of
+// message (game.Move) and name of field. Tables are trained from sample messages and stored as JSON object
+// of the same shape.
+type EntropyTables map[string]map[string][]int
+
+// ReadEntropyTables reads tables stored as JSON
+func ReadEntropyTables(filename string) (EntropyTables, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make(EntropyTables)
+	err = json.Unmarshal(b, &tables)
+	if err != nil {
+		return nil, fmt.Errorf("Entropy tables %v couldn't be read: %v", filename, err)
+	}
+	return tables, nil
+}
+
+// Write writes tables as JSON, messages and fields are ordered by name
+func (tables EntropyTables) Write(w io.Writer) error {
+	b, err := json.MarshalIndent(tables, "", "\t")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
+
+// LoadEntropyTables reads tables from file and applies them to messages of analyzed tree
+func LoadEntropyTables(parsed *sddl.SDDLTree, filename string) error {
+	tables, err := ReadEntropyTables(filename)
+	if err != nil {
+		return err
+	}
+	return tables.Apply(parsed)
+}
+
+// Apply sets code lengths of entropy attributes of messages, so that schema of tree lays their fields
+// out as Huffman codes. Every message in tables has to exist and have entropy attribute, its fields are
+// checked by NewSchema.
+func (tables EntropyTables) Apply(parsed *sddl.SDDLTree) error {
+	applied := make(map[string]bool)
+	for _, pkg := range parsed.Packages {
+		for _, elem := range pkg.Body.Elements {
+			def, ok := elem.(*ast.StructDef)
+			if !ok {
+				continue
+			}
+
+			name := pkg.Name + "." + def.Name
+			lengths, ok := tables[name]
+			if !ok {
+				continue
+			}
+			for _, attb := range def.AttributesList {
+				if e, ok := attb.(*attributes.EntropyAttribute); ok {
+					e.CodeLengths = lengths
+					applied[name] = true
+				}
+			}
+		}
+	}
+
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		if !applied[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if len(names) > 0 {
+		return fmt.Errorf("Entropy tables of %v don't belong to any entropy coded message", names[0])
+	}
+	return nil
+}
+
+// HuffmanTable is canonical Huffman code of values 0 to n-1 given by lengths of their codes. Codes are
+// assigned in order of their lengths, and of values with the same length, counting up from all zero bits.
+// Value is written as single bit field of its code, code is read bit by bit until it matches some value.
+type HuffmanTable struct {
+	Name    string // qualified name of field (game.Move.kind)
+	Lengths []int  // length of code of each value
+	Codes   []int  // code of each value, its first bit lowest, as it is written
+	Counts  []int  // number of codes of each length, from 0 to the longest one
+	Symbols []int  // values ordered by their codes
+}
+
+func NewHuffmanTable(name string, lengths []int) *HuffmanTable {
+	h := &HuffmanTable{
+		Name:    name,
+		Lengths: lengths,
+		Codes:   make([]int, len(lengths)),
+		Symbols: make([]int, len(lengths)),
+	}
+
+	maxLength := 0
+	for i, length := range lengths {
+		h.Symbols[i] = i
+		if length > maxLength {
+			maxLength = length
+		}
+	}
+	h.Counts = make([]int, maxLength+1)
+	for _, length := range lengths {
+		h.Counts[length]++
+	}
+	sort.SliceStable(h.Symbols, func(i, j int) bool {
+		return lengths[h.Symbols[i]] < lengths[h.Symbols[j]]
+	})
+
+	code := 0
+	prev := 0
+	for _, symbol := range h.Symbols {
+		length := lengths[symbol]
+		code <<= uint(length - prev)
+		prev = length
+
+		// code is counted with its first bit highest
+		for i := 0; i < length; i++ {
+			h.Codes[symbol] |= code >> uint(length-1-i) & 1 << uint(i)
+		}
+		code++
+	}
+	return h
+}
+
+// MaxLength returns length of the longest code
+func (h *HuffmanTable) MaxLength() int {
+	return len(h.Counts) - 1
+}
+
+// HuffmanTables collects Huffman tables used by generated file, so that each of them is declared once in it
+type HuffmanTables struct {
+	Tables  []*HuffmanTable
+	indices map[*HuffmanTable]int
+}
+
+// Index returns index of table in Tables, table is added to them when it is used for the first time
+func (t *HuffmanTables) Index(h *HuffmanTable) int {
+	if t.indices == nil {
+		t.indices = make(map[*HuffmanTable]int)
+	}
+	if i, ok := t.indices[h]; ok {
+		return i
+	}
+	t.indices[h] = len(t.Tables)
+	t.Tables = append(t.Tables, h)
+	return len(t.Tables) - 1
+}
+
+// JoinInts returns values as comma separated decimal numbers, for array literals in generated code
+func JoinInts(values []int) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = strconv.Itoa(v)
+	}
+	return strings.Join(s, ", ")
+}
+
+// entropyAttribute returns entropy attribute of struct, nil if there is none
+func entropyAttribute(s *Struct) *attributes.EntropyAttribute {
+	for _, attb := range s.Def.AttributesList {
+		if e, ok := attb.(*attributes.EntropyAttribute); ok {
+			return e
+		}
+	}
+	return nil
+}
+
+// checkCodeLengths checks that lengths of codes of n values form complete prefix code, in which every
+// sequence of bits starts with code of some value
+func checkCodeLengths(lengths []int, n int) error {
+	if len(lengths) != n {
+		return fmt.Errorf("Entropy table has %v code lengths, expected %v", len(lengths), n)
+	}
+
+	var kraft uint64
+	for _, length := range lengths {
+		if length < 1 || length > MaxCodeLength {
+			return fmt.Errorf("Entropy table has code length %v outside of [1, %v]", length, MaxCodeLength)
+		}
+		kraft += 1 << uint(MaxCodeLength-length)
+	}
+	if kraft != 1<<MaxCodeLength {
+		return fmt.Errorf("Entropy table code lengths don't form complete prefix code")
+	}
+	return nil
+}
@@ -1,9 +1,52 @@
 package gen
 
 import (
+	"fmt"
 	"shrinken/sddl"
+	"sort"
+	"strings"
 )
 
-func Generate(parsed *sddl.SDDLTree, targetLang string, outputPath string) {
+// Generator produces serializers for one target language from analyzed SDDL tree
+type Generator interface {
+	Generate(parsed *sddl.SDDLTree, outputPath string) error
+}
+
+var generators = make(map[string]Generator)
+
+// Register makes generator available under given target language name.
+// Backends are expected to call it from their init function.
+func Register(lang string, generator Generator) {
+	if generator == nil {
+		panic("gen: Register generator is nil")
+	}
+
+	if _, exists := generators[lang]; exists {
+		panic("gen: Register called twice for language " + lang)
+	}
+
+	generators[lang] = generator
+}
+
+// Languages returns sorted list of all registered target languages
+func Languages() []string {
+	langs := make([]string, 0, len(generators))
+	for lang := range generators {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+func Generate(parsed *sddl.SDDLTree, targetLang string, outputPath string) error {
+	generator, exists := generators[targetLang]
+	if !exists {
+		langs := Languages()
+		if len(langs) == 0 {
+			return fmt.Errorf("Unknown target language %v (no languages are registered)", targetLang)
+		}
+		return fmt.Errorf("Unknown target language %v (registered languages: %v)", targetLang, strings.Join(langs, ", "))
+	}
 
+	return generator.Generate(parsed, outputPath)
 }
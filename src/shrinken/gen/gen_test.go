@@ -0,0 +1,45 @@
+package gen
+
+import (
+	"shrinken/sddl"
+	"strings"
+	"testing"
+)
+
+type dummyGenerator struct {
+	called bool
+}
+
+func (g *dummyGenerator) Generate(parsed *sddl.SDDLTree, outputPath string) error {
+	g.called = true
+	return nil
+}
+
+func TestRegisteredLanguage(t *testing.T) {
+	g := &dummyGenerator{}
+	Register("dummy", g)
+	defer delete(generators, "dummy")
+
+	err := Generate(&sddl.SDDLTree{}, "dummy", "")
+	if err != nil {
+		t.Fatal("Generate failed for registered language!", err)
+	}
+
+	if !g.called {
+		t.Fatal("Registered generator was not called!")
+	}
+}
+
+func TestUnknownLanguage(t *testing.T) {
+	Register("dummy", &dummyGenerator{})
+	defer delete(generators, "dummy")
+
+	err := Generate(&sddl.SDDLTree{}, "cobol", "")
+	if err == nil {
+		t.Fatal("Generate succeeded for unknown language, but expected it to fail!")
+	}
+
+	if !strings.Contains(err.Error(), "dummy") {
+		t.Fatalf("Error doesn't list registered languages: %v", err)
+	}
+}
@@ -0,0 +1,852 @@
+package golang
+
+import (
+	"fmt"
+	"go/build"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"shrinken/gen"
+	"shrinken/sddl"
+	"shrinken/sddl/ast"
+	"strings"
+)
+
+// Go backend generates one Go package per SDDL package. Structs and classes become Go structs
+// (derived types embed their base type), enums become typed integer constants and types marked
+// with message attribute get Serialize and Deserialize methods. References to classes which have
+// derived classes are held as <Class>Class interfaces, implemented by the class and its descendants.
+// Messages marked with delta attribute also get SerializeDelta and DeserializeDelta methods. Messages
+// register themselves by their IDs in the runtime, and each package with messages gets Handler interface
+// with method for each of them, to which DecodeAny dispatches decoded messages.
+// Generated packages import shrinken runtime package, which is written next to them. Its FrameWriter
+// and FrameReader carry messages of any package over streams, PacketBuilder and PacketReader in packets.
+
+type generator struct{}
+
+func init() {
+	gen.Register("go", &generator{})
+}
+
+func (g *generator) Generate(parsed *sddl.SDDLTree, outputPath string) error {
+	schema, err := gen.NewSchema(parsed)
+	if err != nil {
+		return err
+	}
+
+	runtimeDir := filepath.Join(outputPath, "shrinken")
+	runtimeImport, err := importPath(runtimeDir)
+	if err != nil {
+		return err
+	}
+
+	err = gen.WriteFile(filepath.Join(runtimeDir, "shrinken.go"), []byte(runtime))
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range schema.Packages {
+		f := &packageFile{
+			schema:        schema,
+			pkg:           pkg,
+			outputPath:    outputPath,
+			runtimeImport: runtimeImport,
+			imports:       make(map[*gen.Package]string),
+		}
+
+		content, err := f.generate()
+		if err != nil {
+			return err
+		}
+
+		formatted, err := format.Source(content)
+		if err != nil {
+			return fmt.Errorf("Generated Go code for package %v is not valid: %v", pkg.Name, err)
+		}
+
+		filename := filepath.Join(outputPath, packageDir(pkg), packageName(pkg)+".go")
+		err = gen.WriteFile(filename, formatted)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type packageFile struct {
+	schema        *gen.Schema
+	pkg           *gen.Package
+	outputPath    string
+	runtimeImport string
+
+	imports map[*gen.Package]string // package -> alias
+	huffman gen.HuffmanTables
+	err     error
+}
+
+func (f *packageFile) generate() ([]byte, error) {
+	body := gen.NewCodeWriter("\t")
+
+	for _, e := range f.pkg.Enums {
+		f.writeEnum(body, e)
+	}
+
+	for _, s := range f.pkg.Structs {
+		f.writeStruct(body, s)
+	}
+
+	messages := f.pkg.Messages()
+	if len(messages) > 0 {
+		writeDispatcher(body, messages)
+	}
+
+	for i, h := range f.huffman.Tables {
+		body.Line("// huffman%v is Huffman code of %v", i, h.Name)
+		body.Line("var huffman%v = &shrinken.HuffmanCode{", i)
+		body.Indent()
+		body.Line("Codes: []uint16{%v},", gen.JoinInts(h.Codes))
+		body.Line("Lengths: []uint8{%v},", gen.JoinInts(h.Lengths))
+		body.Line("Counts: []uint16{%v},", gen.JoinInts(h.Counts))
+		body.Line("Symbols: []uint8{%v},", gen.JoinInts(h.Symbols))
+		body.Dedent()
+		body.Line("}")
+		body.Line("")
+	}
+
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	w := gen.NewCodeWriter("\t")
+	w.Line("// Code generated by shrinken. DO NOT EDIT.")
+	w.Line("")
+	w.Line("package %v", packageName(f.pkg))
+	w.Line("")
+	w.Line("import (")
+	w.Indent()
+	if len(f.pkg.Enums) > 0 {
+		w.Line("\"strconv\"")
+	}
+	w.Line("%q", f.runtimeImport)
+	for pkg, alias := range f.imports {
+		importPath, err := importPath(filepath.Join(f.outputPath, packageDir(pkg)))
+		if err != nil {
+			return nil, err
+		}
+		w.Line("%v %q", alias, importPath)
+	}
+	w.Dedent()
+	w.Line(")")
+	w.Line("")
+	w.Raw(string(body.Bytes()))
+
+	return w.Bytes(), nil
+}
+
+func (f *packageFile) writeEnum(w *gen.CodeWriter, e *gen.Enum) {
+	name := exportName(e.ExportedName)
+
+	w.Line("type %v int32", name)
+	w.Line("")
+	w.Line("const (")
+	w.Indent()
+	for i, value := range e.Values {
+		if i == 0 {
+			w.Line("%v%v %v = iota", name, exportName(value), name)
+		} else {
+			w.Line("%v%v", name, exportName(value))
+		}
+	}
+	w.Dedent()
+	w.Line(")")
+	w.Line("")
+
+	w.Line("func (e %v) String() string {", name)
+	w.Indent()
+	w.Line("switch e {")
+	for _, value := range e.Values {
+		w.Line("case %v%v:", name, exportName(value))
+		w.Indent()
+		w.Line("return %q", value)
+		w.Dedent()
+	}
+	w.Line("}")
+	w.Line("return \"%v(\" + strconv.Itoa(int(e)) + \")\"", name)
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("func (e %v) Encode(w *shrinken.BitWriter) {", name)
+	w.Indent()
+	w.Line("w.WriteEnum(int32(e), %v, %v)", len(e.Values), e.Codec().Bits)
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("func (e *%v) Decode(r *shrinken.BitReader) {", name)
+	w.Indent()
+	w.Line("*e = %v(r.ReadEnum(%v, %v))", name, len(e.Values), e.Codec().Bits)
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if e.IsMessage {
+		writeMessageMethods(w, "e", name, e.Id, false, e.ByteAligned)
+	}
+}
+
+func (f *packageFile) writeStruct(w *gen.CodeWriter, s *gen.Struct) {
+	name := exportName(s.ExportedName)
+
+	w.Line("type %v struct {", name)
+	w.Indent()
+	if s.Base != nil {
+		w.Line("%v", f.typeName(s.Base.Package, exportName(s.Base.ExportedName)))
+		if len(s.Fields) > 0 {
+			w.Line("")
+		}
+	}
+	for _, field := range s.Fields {
+		w.Line("%v %v", exportName(field.ExportedName), f.goType(field.Codec))
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if s.Base != nil {
+		w.Line("// Encode writes all fields of %v, including inherited ones", name)
+	} else {
+		w.Line("// Encode writes all fields of %v", name)
+	}
+	w.Line("func (s *%v) Encode(w *shrinken.BitWriter) {", name)
+	w.Indent()
+	if s.IsClass {
+		w.Line("if s == nil {")
+		w.Indent()
+		w.Line("s = &%v{}", name)
+		w.Dedent()
+		w.Line("}")
+	}
+	if s.Base != nil {
+		w.Line("s.%v.Encode(w)", exportName(s.Base.ExportedName))
+	}
+	if c := s.Quaternion; c != nil {
+		q := c.Quantization
+		w.Line("w.WriteQuaternion(%v, %v, %v, %v, %v)", componentFields(s), q.MinString(), q.MaxString(), q.Steps, c.Bits)
+	} else if c := s.Normalized; c != nil {
+		w.Line("w.WriteNormalized(%v, %v, %v)", componentFields(s), c.Quantization.Steps, c.Bits)
+	} else {
+		for _, field := range s.Fields {
+			f.encode(w, field.Codec, "s."+exportName(field.ExportedName), 0)
+		}
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if s.Base != nil {
+		w.Line("// Decode reads all fields of %v, including inherited ones", name)
+	} else {
+		w.Line("// Decode reads all fields of %v", name)
+	}
+	w.Line("func (s *%v) Decode(r *shrinken.BitReader) {", name)
+	w.Indent()
+	if s.Base != nil {
+		w.Line("s.%v.Decode(r)", exportName(s.Base.ExportedName))
+	}
+	if c := s.Quaternion; c != nil {
+		q := c.Quantization
+		w.Line("%v = r.ReadQuaternion(%v, %v, %v, %v)", componentFields(s), q.MinString(), q.MaxString(), q.Steps, c.Bits)
+	} else if c := s.Normalized; c != nil {
+		w.Line("%v = r.ReadNormalized(%v, %v)", componentFields(s), c.Quantization.Steps, c.Bits)
+	} else {
+		for _, field := range s.Fields {
+			f.decode(w, field.Codec, "s."+exportName(field.ExportedName), 0)
+		}
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if s.IsMessage {
+		writeMessageMethods(w, "s", name, s.Id, true, s.ByteAligned)
+	}
+
+	if s.HasDelta {
+		f.writeDeltaMethods(w, s)
+	}
+
+	if s.IsClass && (s.Base != nil || len(s.Derived) > 0) {
+		f.writeClassMethods(w, s)
+	}
+}
+
+// componentFields returns comma separated components of quaternion or normalized vector struct
+func componentFields(s *gen.Struct) string {
+	names := make([]string, len(s.Fields))
+	for i, field := range s.Fields {
+		names[i] = "s." + exportName(field.ExportedName)
+	}
+	return strings.Join(names, ", ")
+}
+
+// writeClassMethods registers class, so that it can be decoded from references to its base classes
+func (f *packageFile) writeClassMethods(w *gen.CodeWriter, s *gen.Struct) {
+	name := exportName(s.ExportedName)
+
+	w.Line("func (s *%v) ClassName() string {", name)
+	w.Indent()
+	w.Line("return %q", s.QualifiedName())
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("func init() {")
+	w.Indent()
+	w.Line("shrinken.RegisterClass(%q, func() shrinken.Class { return &%v{} })", s.QualifiedName(), name)
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if len(s.Derived) == 0 {
+		return
+	}
+
+	w.Line("// %vClass is implemented by %v and all classes derived from it", name, name)
+	w.Line("type %vClass interface {", name)
+	w.Indent()
+	w.Line("shrinken.Class")
+	w.Line("As%v() *%v", name, name)
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("func (s *%v) As%v() *%v {", name, name, name)
+	w.Indent()
+	w.Line("return s")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("// %vSubtypes are qualified names of classes which %vClass can hold, indexed by subtype tag", name, name)
+	w.Line("var %vSubtypes = []string{", name)
+	w.Indent()
+	for _, subtype := range s.Subtypes() {
+		w.Line("%q,", subtype.QualifiedName())
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+}
+
+// writeDeltaMethods writes methods comparing struct with baseline and encoding it as delta against it
+func (f *packageFile) writeDeltaMethods(w *gen.CodeWriter, s *gen.Struct) {
+	name := exportName(s.ExportedName)
+	fields := s.AllFields()
+
+	w.Line("// changedFields reports which fields of s are changed against baseline")
+	w.Line("func (s *%v) changedFields(baseline *%v) (changed [%v]bool) {", name, name, len(fields))
+	w.Indent()
+	writeDefaults(w, s, "s", "baseline")
+	for i, field := range fields {
+		fieldName := exportName(field.ExportedName)
+		f.compare(w, field.Codec, "s."+fieldName, "baseline."+fieldName, fmt.Sprintf("changed[%v]", i), 0)
+	}
+	w.Line("return")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("// Differs reports whether any field of s is changed against baseline")
+	w.Line("func (s *%v) Differs(baseline *%v) bool {", name, name)
+	w.Indent()
+	w.Line("return s.changedFields(baseline) != [%v]bool{}", len(fields))
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("// EncodeDelta writes mask of fields of s changed against baseline, followed by changed fields")
+	w.Line("func (s *%v) EncodeDelta(w *shrinken.BitWriter, baseline *%v) {", name, name)
+	w.Indent()
+	writeDefaults(w, s, "s", "baseline")
+	w.Line("changed := s.changedFields(baseline)")
+	w.Line("for _, c := range changed {")
+	w.Indent()
+	w.Line("w.WriteBool(c)")
+	w.Dedent()
+	w.Line("}")
+	for i, field := range fields {
+		fieldName := exportName(field.ExportedName)
+		w.Line("if changed[%v] {", i)
+		w.Indent()
+		if field.Codec.IsDelta() && field.Codec.Struct.IsClass {
+			w.Line("s.%v.EncodeDelta(w, baseline.%v)", fieldName, fieldName)
+		} else if field.Codec.IsDelta() {
+			w.Line("s.%v.EncodeDelta(w, &baseline.%v)", fieldName, fieldName)
+		} else {
+			f.encode(w, field.Codec, "s."+fieldName, 0)
+		}
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("// DecodeDelta reads delta written by EncodeDelta and replaces changed fields of s, which holds baseline")
+	w.Line("func (s *%v) DecodeDelta(r *shrinken.BitReader) {", name)
+	w.Indent()
+	w.Line("var changed [%v]bool", len(fields))
+	w.Line("for i := range changed {")
+	w.Indent()
+	w.Line("changed[i] = r.ReadBool()")
+	w.Dedent()
+	w.Line("}")
+	for i, field := range fields {
+		fieldName := exportName(field.ExportedName)
+		w.Line("if changed[%v] {", i)
+		w.Indent()
+		if field.Codec.IsDelta() && field.Codec.Struct.IsClass {
+			w.Line("if s.%v == nil {", fieldName)
+			w.Indent()
+			w.Line("s.%v = &%v{}", fieldName, f.typeName(field.Codec.Struct.Package, exportName(field.Codec.Struct.ExportedName)))
+			w.Dedent()
+			w.Line("}")
+			w.Line("s.%v.DecodeDelta(r)", fieldName)
+		} else if field.Codec.IsDelta() {
+			w.Line("s.%v.DecodeDelta(r)", fieldName)
+		} else {
+			f.decode(w, field.Codec, "s."+fieldName, 0)
+		}
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if s.IsDelta {
+		w.Line("// SerializeDelta returns s encoded as delta against baseline")
+		w.Line("func (s *%v) SerializeDelta(baseline *%v) ([]byte, error) {", name, name)
+		w.Indent()
+		w.Line("w := shrinken.NewBitWriter()")
+		if s.ByteAligned {
+			w.Line("w.SetByteAligned(true)")
+		}
+		w.Line("s.EncodeDelta(w, baseline)")
+		w.Line("return w.Bytes(), w.Err()")
+		w.Dedent()
+		w.Line("}")
+		w.Line("")
+
+		w.Line("// DeserializeDelta applies delta to s, which has to hold deep copy of baseline the delta was encoded against")
+		w.Line("func (s *%v) DeserializeDelta(data []byte) error {", name)
+		w.Indent()
+		w.Line("r := shrinken.NewBitReader(data)")
+		if s.ByteAligned {
+			w.Line("r.SetByteAligned(true)")
+		}
+		w.Line("s.DecodeDelta(r)")
+		w.Line("return r.Err()")
+		w.Dedent()
+		w.Line("}")
+		w.Line("")
+	}
+}
+
+// writeDefaults replaces nil class receivers by default instances
+func writeDefaults(w *gen.CodeWriter, s *gen.Struct, names ...string) {
+	if !s.IsClass {
+		return
+	}
+	for _, name := range names {
+		w.Line("if %v == nil {", name)
+		w.Indent()
+		w.Line("%v = &%v{}", name, exportName(s.ExportedName))
+		w.Dedent()
+		w.Line("}")
+	}
+}
+
+func writeMessageMethods(w *gen.CodeWriter, receiver string, name string, id uint16, pointerReceiver bool, byteAligned bool) {
+	w.Line("func (%v %v) MessageID() uint16 {", receiver, name)
+	w.Indent()
+	w.Line("return %v", id)
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("func (%v %v) ByteAligned() bool {", receiver, name)
+	w.Indent()
+	w.Line("return %v", byteAligned)
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("func init() {")
+	w.Indent()
+	w.Line("shrinken.RegisterMessage(%v, func() shrinken.Message { return new(%v) })", id, name)
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if pointerReceiver {
+		w.Line("func (%v *%v) Serialize() ([]byte, error) {", receiver, name)
+	} else {
+		w.Line("func (%v %v) Serialize() ([]byte, error) {", receiver, name)
+	}
+	w.Indent()
+	w.Line("w := shrinken.NewBitWriter()")
+	if byteAligned {
+		w.Line("w.SetByteAligned(true)")
+	}
+	w.Line("%v.Encode(w)", receiver)
+	w.Line("return w.Bytes(), w.Err()")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("func (%v *%v) Deserialize(data []byte) error {", receiver, name)
+	w.Indent()
+	w.Line("r := shrinken.NewBitReader(data)")
+	if byteAligned {
+		w.Line("r.SetByteAligned(true)")
+	}
+	w.Line("%v.Decode(r)", receiver)
+	w.Line("return r.Err()")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+}
+
+// writeDispatcher writes Handler interface with method for each message of package, which Dispatch calls
+// with messages decoded by DecodeAny
+func writeDispatcher(w *gen.CodeWriter, messages []*gen.Message) {
+	w.Line("// Handler handles messages of package decoded by DecodeAny")
+	w.Line("type Handler interface {")
+	w.Indent()
+	for _, m := range messages {
+		name := exportName(m.ExportedName)
+		w.Line("Handle%v(m *%v) error", name, name)
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("// Dispatch passes message to its method of handler, message of another package is shrinken.ErrUnknownMessage")
+	w.Line("func Dispatch(m shrinken.Message, h Handler) error {")
+	w.Indent()
+	w.Line("switch m := m.(type) {")
+	for _, m := range messages {
+		name := exportName(m.ExportedName)
+		w.Line("case *%v:", name)
+		w.Indent()
+		w.Line("return h.Handle%v(m)", name)
+		w.Dedent()
+	}
+	w.Line("}")
+	w.Line("return shrinken.ErrUnknownMessage")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("// DecodeAny deserializes message written by shrinken.SerializeAny and dispatches it to handler")
+	w.Line("func DecodeAny(data []byte, h Handler) error {")
+	w.Indent()
+	w.Line("m, err := shrinken.DecodeAny(data)")
+	w.Line("if err != nil {")
+	w.Indent()
+	w.Line("return err")
+	w.Dedent()
+	w.Line("}")
+	w.Line("return Dispatch(m, h)")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+}
+
+func (f *packageFile) encode(w *gen.CodeWriter, c *gen.Codec, expr string, depth int) {
+	switch c.Kind {
+	case gen.BoolCodec:
+		w.Line("w.WriteBool(%v)", expr)
+	case gen.IntCodec, gen.CharCodec:
+		if c.IsASCII() {
+			w.Line("w.WriteASCIIChar(%v)", expr)
+		} else if c.Huffman != nil && c.Signed {
+			w.Line("w.WriteSignedRangeSymbol(int64(%v), %v, %v, huffman%v)", expr, c.MinString(), c.MaxString(), f.huffman.Index(c.Huffman))
+		} else if c.Huffman != nil {
+			w.Line("w.WriteUnsignedRangeSymbol(uint64(%v), %v, %v, huffman%v)", expr, c.MinString(), c.MaxString(), f.huffman.Index(c.Huffman))
+		} else if c.Range != nil && c.Signed {
+			w.Line("w.WriteSignedRange(int64(%v), %v, %v, %v)", expr, c.MinString(), c.MaxString(), c.Bits)
+		} else if c.Range != nil {
+			w.Line("w.WriteUnsignedRange(uint64(%v), %v, %v, %v)", expr, c.MinString(), c.MaxString(), c.Bits)
+		} else if c.Encoding == gen.VarintEncoding {
+			w.Line("w.WriteVarint(uint64(%v), %v)", expr, c.Bits)
+		} else if c.Encoding == gen.ZigZagEncoding {
+			w.Line("w.WriteZigZag(int64(%v))", expr)
+		} else {
+			w.Line("w.WriteBits(uint64(%v), %v)", expr, c.Bits)
+		}
+	case gen.FloatCodec:
+		if q := c.Quantization; q != nil {
+			w.Line("w.WriteQuantized(%v, %v, %v, %v, %v)", expr, q.MinString(), q.MaxString(), q.Steps, c.Bits)
+		} else {
+			w.Line("w.WriteFloat%v(%v)", c.Bits, expr)
+		}
+	case gen.StringCodec:
+		if c.IsASCII() {
+			w.Line("w.WriteASCII(%v, %v, %v)", expr, c.MaxLength, c.CountBits)
+		} else {
+			w.Line("w.WriteString(%v, %v, %v)", expr, c.MaxLength, c.CountBits)
+		}
+	case gen.EnumCodec, gen.StructCodec:
+		if c.Huffman != nil {
+			w.Line("w.WriteSymbol(uint64(%v), huffman%v)", expr, f.huffman.Index(c.Huffman))
+		} else if c.IsPolymorphic() {
+			w.Line("w.WriteClass(%v, %v, %v)", expr, f.subtypesName(c.Struct), c.Bits)
+		} else {
+			w.Line("%v.Encode(w)", expr)
+		}
+	case gen.ArrayCodec:
+		if c.IsDynamic() {
+			w.Line("w.WriteCount(len(%v), %v, %v)", expr, c.MaxCount, c.CountBits)
+		}
+		i := fmt.Sprintf("i%v", depth)
+		w.Line("for %v := range %v {", i, expr)
+		w.Indent()
+		f.encode(w, c.Elem, fmt.Sprintf("%v[%v]", expr, i), depth+1)
+		w.Dedent()
+		w.Line("}")
+	}
+}
+
+// compare writes statements setting target to whether value a is changed against b, as defined for delta
+// encoding. References to polymorphic classes are always changed.
+func (f *packageFile) compare(w *gen.CodeWriter, c *gen.Codec, a, b, target string, depth int) {
+	switch c.Kind {
+	case gen.StructCodec:
+		if c.IsPolymorphic() {
+			w.Line("%v = true", target)
+		} else if c.Struct.IsClass {
+			w.Line("%v = %v.Differs(%v)", target, a, b)
+		} else {
+			w.Line("%v = %v.Differs(&%v)", target, a, b)
+		}
+	case gen.ArrayCodec:
+		i := fmt.Sprintf("i%v", depth)
+		w.Line("%v = len(%v) != len(%v)", target, a, b)
+		w.Line("for %v := 0; !%v && %v < len(%v); %v++ {", i, target, i, a, i)
+		w.Indent()
+		f.compare(w, c.Elem, fmt.Sprintf("%v[%v]", a, i), fmt.Sprintf("%v[%v]", b, i), target, depth+1)
+		w.Dedent()
+		w.Line("}")
+	default:
+		w.Line("%v = %v != %v", target, a, b)
+	}
+}
+
+func (f *packageFile) decode(w *gen.CodeWriter, c *gen.Codec, expr string, depth int) {
+	switch c.Kind {
+	case gen.BoolCodec:
+		w.Line("%v = r.ReadBool()", expr)
+	case gen.IntCodec, gen.CharCodec:
+		if c.IsASCII() {
+			w.Line("%v = r.ReadASCIIChar()", expr)
+		} else if c.Huffman != nil && c.Signed {
+			w.Line("%v = %v(r.ReadSignedRangeSymbol(%v, huffman%v))", expr, f.goType(c), c.MinString(), f.huffman.Index(c.Huffman))
+		} else if c.Huffman != nil {
+			w.Line("%v = %v(r.ReadUnsignedRangeSymbol(%v, huffman%v))", expr, f.goType(c), c.MinString(), f.huffman.Index(c.Huffman))
+		} else if c.Range != nil && c.Signed {
+			w.Line("%v = %v(r.ReadSignedRange(%v, %v, %v))", expr, f.goType(c), c.MinString(), c.MaxString(), c.Bits)
+		} else if c.Range != nil {
+			w.Line("%v = %v(r.ReadUnsignedRange(%v, %v, %v))", expr, f.goType(c), c.MinString(), c.MaxString(), c.Bits)
+		} else if c.Encoding == gen.VarintEncoding {
+			w.Line("%v = %v(r.ReadVarint(%v))", expr, f.goType(c), c.Bits)
+		} else if c.Encoding == gen.ZigZagEncoding {
+			w.Line("%v = %v(r.ReadZigZag(%v))", expr, f.goType(c), c.Bits)
+		} else {
+			w.Line("%v = %v(r.ReadBits(%v))", expr, f.goType(c), c.Bits)
+		}
+	case gen.FloatCodec:
+		if q := c.Quantization; q != nil {
+			w.Line("%v = r.ReadQuantized(%v, %v, %v, %v)", expr, q.MinString(), q.MaxString(), q.Steps, c.Bits)
+		} else {
+			w.Line("%v = r.ReadFloat%v()", expr, c.Bits)
+		}
+	case gen.StringCodec:
+		if c.IsASCII() {
+			w.Line("%v = r.ReadASCII(%v, %v)", expr, c.MaxLength, c.CountBits)
+		} else {
+			w.Line("%v = r.ReadString(%v, %v)", expr, c.MaxLength, c.CountBits)
+		}
+	case gen.EnumCodec:
+		if c.Huffman != nil {
+			w.Line("%v = %v(r.ReadSymbol(huffman%v))", expr, f.goType(c), f.huffman.Index(c.Huffman))
+		} else {
+			w.Line("%v.Decode(r)", expr)
+		}
+	case gen.StructCodec:
+		if c.IsPolymorphic() {
+			w.Line("%v = r.ReadClass(%v, %v).(%v)", expr, f.subtypesName(c.Struct), c.Bits, f.goType(c))
+			return
+		}
+		if c.Struct.IsClass {
+			w.Line("%v = &%v{}", expr, f.typeName(c.Struct.Package, exportName(c.Struct.ExportedName)))
+		}
+		w.Line("%v.Decode(r)", expr)
+	case gen.ArrayCodec:
+		if c.IsDynamic() {
+			w.Line("%v = make(%v, r.ReadCount(%v, %v, %v))", expr, f.goType(c), c.MaxCount, c.CountBits, c.Elem.MinBits())
+		}
+		i := fmt.Sprintf("i%v", depth)
+		w.Line("for %v := range %v {", i, expr)
+		w.Indent()
+		f.decode(w, c.Elem, fmt.Sprintf("%v[%v]", expr, i), depth+1)
+		w.Dedent()
+		w.Line("}")
+	}
+}
+
+func (f *packageFile) goType(c *gen.Codec) string {
+	switch c.Kind {
+	case gen.ArrayCodec:
+		if c.IsDynamic() {
+			return "[]" + f.goType(c.Elem)
+		}
+		return fmt.Sprintf("[%v]%v", c.Size, f.goType(c.Elem))
+	case gen.StructCodec:
+		name := f.typeName(c.Struct.Package, exportName(c.Struct.ExportedName))
+		if c.IsPolymorphic() {
+			return name + "Class"
+		}
+		if c.Struct.IsClass {
+			return "*" + name
+		}
+		return name
+	case gen.EnumCodec:
+		return f.typeName(c.Enum.Package, exportName(c.Enum.ExportedName))
+	}
+
+	switch c.Type.GenericType {
+	case ast.Integer32:
+		return "int32"
+	case ast.Integer64:
+		return "int64"
+	case ast.Short:
+		return "int16"
+	case ast.UnsignedInteger32:
+		return "uint32"
+	case ast.UnsignedInteger64:
+		return "uint64"
+	case ast.UnsignedShort:
+		return "uint16"
+	case ast.Byte:
+		return "byte"
+	case ast.Bool:
+		return "bool"
+	case ast.String:
+		return "string"
+	case ast.Char:
+		return "rune"
+	case ast.Float:
+		return "float32"
+	case ast.Double:
+		return "float64"
+	}
+
+	f.err = fmt.Errorf("Go backend doesn't support type %v", c.Type.GenericType.String())
+	return ""
+}
+
+func (f *packageFile) subtypesName(s *gen.Struct) string {
+	return f.typeName(s.Package, exportName(s.ExportedName)+"Subtypes")
+}
+
+// typeName returns name of type qualified by package alias if type is declared in other package
+func (f *packageFile) typeName(pkg *gen.Package, name string) string {
+	if pkg == f.pkg {
+		return name
+	}
+
+	alias, exists := f.imports[pkg]
+	if !exists {
+		alias = packageName(pkg)
+		for other, otherAlias := range f.imports {
+			if otherAlias == alias && other != pkg || alias == packageName(f.pkg) || alias == "shrinken" || alias == "strconv" {
+				alias = strings.Replace(strings.ToLower(pkg.ExportedName), ".", "_", -1)
+				break
+			}
+		}
+		f.imports[pkg] = alias
+	}
+
+	return alias + "." + name
+}
+
+// packageDir returns directory of package relative to output path, one directory per package name part
+func packageDir(pkg *gen.Package) string {
+	return filepath.Join(strings.Split(strings.ToLower(pkg.ExportedName), ".")...)
+}
+
+func packageName(pkg *gen.Package) string {
+	parts := strings.Split(strings.ToLower(pkg.ExportedName), ".")
+	return parts[len(parts)-1]
+}
+
+// exportName makes sure that name is exported in Go
+func exportName(name string) string {
+	return gen.UpperFirst(name)
+}
+
+// importPath finds Go import path of directory, either from enclosing Go module or from GOPATH
+func importPath(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for d := abs; ; d = filepath.Dir(d) {
+		content, err := ioutil.ReadFile(filepath.Join(d, "go.mod"))
+		if err == nil {
+			module := modulePath(string(content))
+			if module != "" {
+				rel, err := filepath.Rel(d, abs)
+				if err != nil {
+					return "", err
+				}
+				return path.Join(module, filepath.ToSlash(rel)), nil
+			}
+		}
+
+		if filepath.Dir(d) == d {
+			break
+		}
+	}
+
+	gopath := os.Getenv("GOPATH")
+	if gopath == "" {
+		gopath = build.Default.GOPATH
+	}
+
+	for _, p := range filepath.SplitList(gopath) {
+		rel, err := filepath.Rel(filepath.Join(p, "src"), abs)
+		if err == nil && rel != "." && !strings.HasPrefix(rel, "..") {
+			return filepath.ToSlash(rel), nil
+		}
+	}
+
+	return "", fmt.Errorf("Cannot determine Go import path of %v; output path must be inside Go module or GOPATH", abs)
+}
+
+func modulePath(goMod string) string {
+	for _, line := range strings.Split(goMod, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], "\"")
+		}
+	}
+	return ""
+}
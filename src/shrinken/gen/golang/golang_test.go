@@ -0,0 +1,382 @@
+package golang
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"shrinken/gen"
+	"shrinken/wire/wiretest"
+	"strings"
+	"testing"
+)
+
+func generateToTempModule(t *testing.T, filename string) string {
+	tree := wiretest.Parse(t, filename)
+
+	dir, err := ioutil.TempDir("", "shrinken")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/generated\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = gen.Generate(tree, "go", dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal("Go code couldn't be generated!", err)
+	}
+
+	return dir
+}
+
+func parseGenerated(t *testing.T, filename string) string {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal("Generated file is missing!", err)
+	}
+
+	_, err = parser.ParseFile(token.NewFileSet(), filename, b, 0)
+	if err != nil {
+		t.Fatal("Generated file is not valid Go code!", err)
+	}
+
+	return string(b)
+}
+
+func TestBasic(t *testing.T) {
+	dir := generateToTempModule(t, "../../sddl/test_data/single_file/basic.sddl")
+	defer os.RemoveAll(dir)
+
+	parseGenerated(t, filepath.Join(dir, "shrinken", "shrinken.go"))
+	code := parseGenerated(t, filepath.Join(dir, "com", "github", "namespace", "namespace.go"))
+
+	if !strings.Contains(code, `"example.com/generated/shrinken"`) {
+		t.Fatal("Generated package doesn't import runtime from module path")
+	}
+
+	if !strings.Contains(code, "func (s *Player) Serialize() ([]byte, error)") {
+		t.Fatal("Message class Player is missing Serialize method")
+	}
+
+	if !strings.Contains(code, "HandlePlayer(m *Player) error") {
+		t.Fatal("Handler is missing method of message class Player")
+	}
+
+	if strings.Contains(code, "func (s *Vector3) Serialize()") {
+		t.Fatal("Struct Vector3 is not a message, but got Serialize method")
+	}
+
+	if !strings.Contains(code, "Pos Vector3") || !strings.Contains(code, "Rot Quaternion") {
+		t.Fatal("ExportAs attribute is not honoured on variables")
+	}
+}
+
+func TestMultiplePackages(t *testing.T) {
+	dir := generateToTempModule(t, "../../sddl/test_data/multipkg/same_name/")
+	defer os.RemoveAll(dir)
+
+	code := parseGenerated(t, filepath.Join(dir, "test", "pkg1", "pkg1.go"))
+	if !strings.Contains(code, `pkg2 "example.com/generated/test/pkg2"`) {
+		t.Fatal("Package pkg1 doesn't import package pkg2 it depends on")
+	}
+
+	if !strings.Contains(code, "pkg2.Class1") {
+		t.Fatal("Class2 doesn't embed class from other package")
+	}
+}
+
+const conformanceMain = `package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"example.com/generated/conformance"
+	"example.com/generated/shrinken"
+)
+
+// printer prints every handled message serialized with its ID
+type printer struct{}
+
+func (p printer) print(m shrinken.Message) error {
+	out, err := shrinken.SerializeAny(m)
+	if err != nil {
+		return err
+	}
+	fmt.Println(hex.EncodeToString(out))
+	return nil
+}
+
+func (p printer) HandleSample(m *conformance.Sample) error   { return p.print(m) }
+func (p printer) HandleAligned(m *conformance.Aligned) error { return p.print(m) }
+func (p printer) HandleCoded(m *conformance.Coded) error     { return p.print(m) }
+func (p printer) HandleBulk(m *conformance.Bulk) error       { return p.print(m) }
+
+func main() {
+	input, _ := ioutil.ReadAll(os.Stdin)
+	lines := strings.Fields(string(input))
+	data, err := hex.DecodeString(lines[0])
+	check(err)
+
+	if len(os.Args) > 1 && os.Args[1] == "any" {
+		for _, line := range lines {
+			data, err := hex.DecodeString(line)
+			check(err)
+			check(conformance.DecodeAny(data, printer{}))
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "frames" {
+		r := shrinken.NewFrameReader(bytes.NewReader(data), true)
+		for {
+			m, err := r.Read()
+			if err == io.EOF {
+				return
+			}
+			if err == shrinken.ErrCorruptFrame {
+				fmt.Println("corrupt")
+				continue
+			}
+			check(err)
+
+			var out bytes.Buffer
+			check(shrinken.NewFrameWriter(&out, true).Write(m))
+			fmt.Println(hex.EncodeToString(out.Bytes()))
+		}
+	}
+
+	if len(os.Args) > 2 && os.Args[1] == "packet" {
+		budget, err := strconv.Atoi(os.Args[2])
+		check(err)
+
+		var messages []shrinken.Message
+		r := shrinken.NewPacketReader(data)
+		for {
+			m, err := r.Read()
+			if err == io.EOF {
+				break
+			}
+			check(err)
+			messages = append(messages, m)
+		}
+
+		b := shrinken.NewPacketBuilder(budget)
+		for len(messages) > 0 {
+			messages, err = b.Add(messages...)
+			check(err)
+			fmt.Println(hex.EncodeToString(b.Bytes()))
+			b.Reset()
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "truncated" {
+		if err := (&conformance.Bulk{}).Deserialize(data); err != nil {
+			fmt.Println(err)
+		} else {
+			fmt.Println("ok")
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "aligned" {
+		a := &conformance.Aligned{}
+		check(a.Deserialize(data))
+
+		out, err := a.Serialize()
+		check(err)
+		fmt.Println(hex.EncodeToString(out))
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "coded" {
+		c := &conformance.Coded{}
+		check(c.Deserialize(data))
+
+		out, err := c.Serialize()
+		check(err)
+		fmt.Println(hex.EncodeToString(out))
+		return
+	}
+
+	s := &conformance.Sample{}
+	check(s.Deserialize(data))
+
+	if len(os.Args) > 1 && os.Args[1] == "delta" {
+		delta, err := hex.DecodeString(lines[1])
+		check(err)
+
+		baseline := &conformance.Sample{}
+		check(baseline.Deserialize(data))
+		check(s.DeserializeDelta(delta))
+
+		out, err := s.Serialize()
+		check(err)
+		fmt.Println(hex.EncodeToString(out))
+
+		out, err = s.SerializeDelta(baseline)
+		check(err)
+		fmt.Println(hex.EncodeToString(out))
+		return
+	}
+
+	out, err := s.Serialize()
+	check(err)
+	fmt.Println(hex.EncodeToString(out))
+}
+
+func check(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+`
+
+func TestConformance(t *testing.T) {
+	goTool := wiretest.Tool(t, "go")
+
+	dir := generateToTempModule(t, wiretest.Schema)
+	defer os.RemoveAll(dir)
+
+	err := gen.WriteFile(filepath.Join(dir, "cmd", "conformance", "main.go"), []byte(conformanceMain))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// generated package lives in directory conformance
+	program := filepath.Join(dir, "conformance.bin")
+	cmd := exec.Command(goTool, "build", "-o", program, "./cmd/conformance")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GO111MODULE=on", "GOFLAGS=-mod=mod", "GOWORK=off", "GOTOOLCHAIN=local")
+	wiretest.Build(t, cmd)
+
+	wiretest.Run(t, exec.Command(program))
+	wiretest.RunDelta(t, exec.Command(program))
+	wiretest.RunAligned(t, exec.Command(program))
+	wiretest.RunCoded(t, exec.Command(program))
+	wiretest.RunAny(t, exec.Command(program))
+	wiretest.RunTruncated(t, exec.Command(program))
+	wiretest.RunFrames(t, exec.Command(program))
+	wiretest.RunPacket(t, exec.Command(program))
+}
+
+const liveFramesSchema = `package tiny
+
+@message
+@id: 5
+struct Tiny {
+	byte b
+}
+`
+
+// liveFramesTest reads frames from pipe which stays open after them, so that reader which waits for data
+// beyond a frame blocks
+const liveFramesTest = `package tiny_test
+
+import (
+	"hash/crc32"
+	"io"
+	"testing"
+	"time"
+
+	"example.com/generated/shrinken"
+	"example.com/generated/tiny"
+)
+
+type result struct {
+	m   shrinken.Message
+	err error
+}
+
+func read(t *testing.T, data []byte, checksum bool, n int) []result {
+	pr, pw := io.Pipe()
+	go pw.Write(data)
+
+	r := shrinken.NewFrameReader(pr, checksum)
+	results := make(chan result)
+	go func() {
+		for i := 0; i < n; i++ {
+			m, err := r.Read()
+			results <- result{m, err}
+		}
+	}()
+
+	var all []result
+	for i := 0; i < n; i++ {
+		select {
+		case res := <-results:
+			all = append(all, res)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Read %v of frames %x waits for data beyond them", i+1, data)
+		}
+	}
+	return all
+}
+
+func checkTiny(t *testing.T, res result) {
+	if tiny, ok := res.m.(*tiny.Tiny); !ok || tiny.B != 1 || res.err != nil {
+		t.Fatalf("Frame was read as %v, %v, expected Tiny with b 1", res.m, res.err)
+	}
+}
+
+func TestShortFrame(t *testing.T) {
+	// frame is shorter than the longest length varint
+	checkTiny(t, read(t, []byte{2, 5, 1}, false, 1)[0])
+}
+
+func TestResyncShortFrame(t *testing.T) {
+	frame := []byte{2, 5, 1}
+	sum := crc32.ChecksumIEEE(frame)
+	frame = append(frame, byte(sum), byte(sum>>8), byte(sum>>16), byte(sum>>24))
+
+	// empty frame with wrong checksum is followed by frame shorter than length and ID varints with checksum
+	results := read(t, append([]byte{0}, frame...), true, 2)
+	if results[0].err != shrinken.ErrCorruptFrame {
+		t.Fatalf("Garbage was read as %v, %v, expected corrupt frame", results[0].m, results[0].err)
+	}
+	checkTiny(t, results[1])
+}
+`
+
+func TestLiveFrames(t *testing.T) {
+	goTool := wiretest.Tool(t, "go")
+
+	dir, err := ioutil.TempDir("", "shrinken")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	schema := filepath.Join(dir, "tiny.sddl")
+	err = ioutil.WriteFile(schema, []byte(liveFramesSchema), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := generateToTempModule(t, schema)
+	defer os.RemoveAll(out)
+
+	err = gen.WriteFile(filepath.Join(out, "tiny", "frames_test.go"), []byte(liveFramesTest))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command(goTool, "test", "./tiny")
+	cmd.Dir = out
+	cmd.Env = append(os.Environ(), "GO111MODULE=on", "GOFLAGS=-mod=mod", "GOWORK=off", "GOTOOLCHAIN=local")
+	wiretest.Build(t, cmd)
+}
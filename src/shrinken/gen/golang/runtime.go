@@ -0,0 +1,979 @@
+package golang
+
+// runtime is written to shrinken package next to generated packages, all of them import it
+
+const runtime = `// Code generated by shrinken. DO NOT EDIT.
+
+// Package shrinken is runtime used by serializers generated by shrinken
+package shrinken
+
+import (
+	"errors"
+	"hash/crc32"
+	"io"
+	"math"
+)
+
+var (
+	ErrUnexpectedEnd  = errors.New("shrinken: unexpected end of data")
+	ErrInvalidValue   = errors.New("shrinken: invalid value")
+	ErrTooLong        = errors.New("shrinken: value is longer than its limit")
+	ErrUnknownMessage = errors.New("shrinken: unknown message")
+	ErrCorruptFrame   = errors.New("shrinken: corrupt frame")
+)
+
+// Class is instance of SDDL class. Generated classes register themselves by their qualified names,
+// so that class references can be decoded as classes derived in other packages.
+type Class interface {
+	ClassName() string
+	Encode(w *BitWriter)
+	Decode(r *BitReader)
+}
+
+var classes = make(map[string]func() Class)
+
+// RegisterClass makes class available to ReadClass
+func RegisterClass(name string, new func() Class) {
+	classes[name] = new
+}
+
+// Message is instance of SDDL message. Generated messages register themselves by their IDs, which are
+// unique among messages of all packages, so that DecodeAny can decode message of any package.
+type Message interface {
+	MessageID() uint16
+	ByteAligned() bool
+	Encode(w *BitWriter)
+	Decode(r *BitReader)
+	Serialize() ([]byte, error)
+	Deserialize(data []byte) error
+}
+
+var messages = make(map[uint16]func() Message)
+
+// RegisterMessage makes message available to DecodeAny
+func RegisterMessage(id uint16, new func() Message) {
+	messages[id] = new
+}
+
+// SerializeAny writes ID of message as varint, followed by the serialized message
+func SerializeAny(m Message) ([]byte, error) {
+	data, err := m.Serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	w := NewBitWriter()
+	w.WriteVarint(uint64(m.MessageID()), 16)
+	return append(w.Bytes(), data...), nil
+}
+
+// ReadMessageID reads ID written by SerializeAny and returns it with data of the message
+func ReadMessageID(data []byte) (uint16, []byte, error) {
+	r := NewBitReader(data)
+	id := r.ReadVarint(16)
+	if r.Err() != nil {
+		return 0, nil, r.Err()
+	}
+	return uint16(id), data[r.pos>>3:], nil
+}
+
+// DecodeAny deserializes message written by SerializeAny, ID which belongs to no registered message
+// is ErrUnknownMessage. Messages are returned as pointers, so that type switch on them can use the
+// same types for structs and enums.
+func DecodeAny(data []byte) (Message, error) {
+	id, data, err := ReadMessageID(data)
+	if err != nil {
+		return nil, err
+	}
+
+	new, ok := messages[id]
+	if !ok {
+		return nil, ErrUnknownMessage
+	}
+
+	m := new()
+	err = m.Deserialize(data)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DefaultMaxFrameSize is default limit of size of message with its ID in a frame
+const DefaultMaxFrameSize = 1 << 16
+
+// FrameWriter writes messages to stream as frames. Frame is length of message with its ID as 32 bit
+// varint, the message written by SerializeAny and, if checksums are enabled, CRC32 of all of that.
+type FrameWriter struct {
+	w        io.Writer
+	checksum bool
+	MaxSize  int
+}
+
+func NewFrameWriter(w io.Writer, checksum bool) *FrameWriter {
+	return &FrameWriter{
+		w:        w,
+		checksum: checksum,
+		MaxSize:  DefaultMaxFrameSize,
+	}
+}
+
+// Write writes message as single frame, message with its ID larger than MaxSize is ErrTooLong
+func (fw *FrameWriter) Write(m Message) error {
+	data, err := SerializeAny(m)
+	if err != nil {
+		return err
+	}
+	if len(data) > fw.MaxSize {
+		return ErrTooLong
+	}
+
+	w := NewBitWriter()
+	w.WriteVarint(uint64(len(data)), 32)
+	frame := append(w.Bytes(), data...)
+	if fw.checksum {
+		sum := crc32.ChecksumIEEE(frame)
+		frame = append(frame, byte(sum), byte(sum>>8), byte(sum>>16), byte(sum>>24))
+	}
+
+	_, err = fw.w.Write(frame)
+	return err
+}
+
+// FrameReader reads messages written by FrameWriter with the same checksum setting. Frame with length
+// larger than MaxSize or with wrong checksum is ErrCorruptFrame, after which FrameReader skips data up to
+// the next frame of registered message with valid checksum, at most MaxSize bytes at once. Without
+// checksums only lengths are checked, so FrameReader can't find the next frame and skips just one byte.
+type FrameReader struct {
+	r        io.Reader
+	checksum bool
+	buf      []byte
+	chunk    []byte
+	err      error
+	MaxSize  int
+}
+
+func NewFrameReader(r io.Reader, checksum bool) *FrameReader {
+	return &FrameReader{
+		r:        r,
+		checksum: checksum,
+		chunk:    make([]byte, 4096),
+		MaxSize:  DefaultMaxFrameSize,
+	}
+}
+
+// Read reads next frame and decodes its message by DecodeAny. Frame of message which can't be decoded is
+// skipped, so that next Read continues with the following frame. End of stream between frames is
+// io.EOF, inside of a frame io.ErrUnexpectedEOF.
+func (fr *FrameReader) Read() (Message, error) {
+	header, size, err := fr.frame(0)
+	if err == ErrCorruptFrame {
+		fr.resync()
+		return nil, err
+	}
+	if err != nil {
+		fr.buf = fr.buf[:0]
+		return nil, err
+	}
+
+	data := fr.buf[header:size]
+	if fr.checksum {
+		data = data[:len(data)-4]
+	}
+	fr.buf = fr.buf[size:]
+	return DecodeAny(data)
+}
+
+// frame checks frame at offset of buffered data and returns size of its length and size of whole frame
+func (fr *FrameReader) frame(offset int) (int, int, error) {
+	length, header, err := fr.varint(offset, 32)
+	if err == ErrUnexpectedEnd {
+		return 0, 0, fr.end(offset)
+	}
+	if err != nil || length > uint64(fr.MaxSize) {
+		return 0, 0, ErrCorruptFrame
+	}
+
+	size := header + int(length)
+	if fr.checksum {
+		size += 4
+	}
+	if !fr.fill(offset + size) {
+		return 0, 0, fr.end(offset)
+	}
+
+	if fr.checksum && !validChecksum(fr.buf[offset:offset+size]) {
+		return 0, 0, ErrCorruptFrame
+	}
+	return header, size, nil
+}
+
+// validChecksum checks CRC32 at the end of frame
+func validChecksum(frame []byte) bool {
+	size := len(frame)
+	sum := crc32.ChecksumIEEE(frame[:size-4])
+	return frame[size-4] == byte(sum) && frame[size-3] == byte(sum>>8) && frame[size-2] == byte(sum>>16) && frame[size-1] == byte(sum>>24)
+}
+
+// resync skips buffered data up to the next frame with valid checksum, or all of it when there is none.
+// Checksum is computed only for plausible frame, whose length fits MaxSize and whose ID belongs to a
+// registered message, so that garbage doesn't cost reading and checksumming a whole frame at every byte.
+func (fr *FrameReader) resync() {
+	offset := 1
+	for ; fr.checksum && offset < fr.MaxSize && offset < len(fr.buf); offset++ {
+		size, ok := fr.plausible(offset)
+		if ok && fr.fill(offset+size) && validChecksum(fr.buf[offset:offset+size]) {
+			break
+		}
+	}
+	if offset > len(fr.buf) {
+		offset = len(fr.buf)
+	}
+	fr.buf = fr.buf[offset:]
+}
+
+// plausible checks length and ID of checksummed frame at offset of buffered data and returns its size
+func (fr *FrameReader) plausible(offset int) (int, bool) {
+	length, header, err := fr.varint(offset, 32)
+	if err != nil || length == 0 || length > uint64(fr.MaxSize) {
+		return 0, false
+	}
+
+	// ID is inside of the frame, so reading it doesn't wait for data beyond the frame
+	id, n, err := fr.varint(offset+header, 16)
+	if err != nil || n > int(length) {
+		return 0, false
+	}
+
+	_, ok := messages[uint16(id)]
+	return header + int(length) + 4, ok
+}
+
+// varint reads varint of bits at offset of buffered data and returns it with its size in bytes. Stream is
+// read only up to the last group, so that short frame doesn't wait for data which may never come.
+func (fr *FrameReader) varint(offset int, bits uint) (uint64, int, error) {
+	for n := offset + 1; ; n = len(fr.buf) + 1 {
+		filled := fr.fill(n)
+		r := NewBitReader(fr.buf[offset:])
+		value := r.ReadVarint(bits)
+		if r.Err() != ErrUnexpectedEnd || !filled {
+			return value, int(r.pos >> 3), r.Err()
+		}
+	}
+}
+
+// fill reads from stream until at least n bytes are buffered, it returns false when stream fails first
+func (fr *FrameReader) fill(n int) bool {
+	for len(fr.buf) < n && fr.err == nil {
+		read, err := fr.r.Read(fr.chunk)
+		fr.buf = append(fr.buf, fr.chunk[:read]...)
+		fr.err = err
+	}
+	return len(fr.buf) >= n
+}
+
+// end returns error of frame at offset which stream failed to fill
+func (fr *FrameReader) end(offset int) error {
+	if fr.err != io.EOF {
+		return fr.err
+	}
+	if len(fr.buf) == offset {
+		return io.EOF
+	}
+	return io.ErrUnexpectedEOF
+}
+
+// PacketBuilder packs messages into a packet of limited size, like UDP datagram. Each message is written
+// right after the previous one, as its ID followed by the message, only byte aligned message starts at
+// the next byte boundary.
+type PacketBuilder struct {
+	w      *BitWriter
+	budget int
+}
+
+// NewPacketBuilder returns builder of packets which take at most budget bytes
+func NewPacketBuilder(budget int) *PacketBuilder {
+	return &PacketBuilder{
+		w:      NewBitWriter(),
+		budget: budget,
+	}
+}
+
+// Add appends messages to packet in order, until the first one which doesn't fit its budget. It returns
+// messages which didn't fit, so that they can be added to the next packet. Message which doesn't fit
+// even empty packet is ErrTooLong.
+func (b *PacketBuilder) Add(messages ...Message) ([]Message, error) {
+	w := b.w
+	for i, m := range messages {
+		size, bits := len(w.buf), w.bits
+
+		w.WriteVarint(uint64(m.MessageID()), 16)
+		if m.ByteAligned() {
+			w.bits = (w.bits + 7) &^ 7
+			w.SetByteAligned(true)
+		}
+		m.Encode(w)
+		w.SetByteAligned(false)
+
+		err := w.Err()
+		if err == nil && len(w.buf) > b.budget && bits == 0 {
+			err = ErrTooLong
+		}
+		if err != nil || len(w.buf) > b.budget {
+			// drop the message, including its bits in the last byte of previous one
+			w.buf, w.bits, w.err = w.buf[:size], bits, nil
+			if bits&7 != 0 {
+				w.buf[size-1] &= 1<<(bits&7) - 1
+			}
+			return messages[i:], err
+		}
+	}
+	return nil, nil
+}
+
+// Bytes returns the packet, which is valid until Reset
+func (b *PacketBuilder) Bytes() []byte {
+	return b.w.Bytes()
+}
+
+// Len returns size of the packet in bytes
+func (b *PacketBuilder) Len() int {
+	return len(b.w.buf)
+}
+
+// Reset empties the packet, so that builder can pack the next one
+func (b *PacketBuilder) Reset() {
+	b.w.buf, b.w.bits = b.w.buf[:0], 0
+}
+
+// PacketReader reads messages of packet built by PacketBuilder
+type PacketReader struct {
+	r *BitReader
+}
+
+func NewPacketReader(data []byte) *PacketReader {
+	return &PacketReader{
+		r: NewBitReader(data),
+	}
+}
+
+// Read decodes next message of the packet. End of the packet is io.EOF, message after message which
+// failed to decode can't be found, so following reads return the same error.
+func (pr *PacketReader) Read() (Message, error) {
+	r := pr.r
+	if r.err != nil {
+		return nil, r.err
+	}
+	// ID takes at least 8 bits, the rest of the last byte is padding
+	if r.Remaining() < 8 {
+		return nil, io.EOF
+	}
+
+	id := uint16(r.ReadVarint(16))
+	new, ok := messages[id]
+	if !ok {
+		r.Fail(ErrUnknownMessage)
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	m := new()
+	if m.ByteAligned() {
+		r.pos = (r.pos + 7) &^ 7
+		r.SetByteAligned(true)
+	}
+	m.Decode(r)
+	r.SetByteAligned(false)
+	if r.err != nil {
+		return nil, r.err
+	}
+	return m, nil
+}
+
+// HuffmanCode is canonical Huffman code of values 0 to len(Codes)-1. Code of value is written as single
+// Lengths[value] wide field Codes[value], first bit of code lowest. Counts holds number of codes of each
+// length and Symbols values ordered by their codes, for reading codes bit by bit.
+type HuffmanCode struct {
+	Codes   []uint16
+	Lengths []uint8
+	Counts  []uint16
+	Symbols []uint8
+}
+
+// BitWriter writes values as little-endian bit fields, starting from least significant bit of first byte
+type BitWriter struct {
+	buf         []byte
+	bits        uint
+	err         error
+	byteAligned bool
+}
+
+func NewBitWriter() *BitWriter {
+	return &BitWriter{
+		buf: make([]byte, 0, 64),
+	}
+}
+
+// WriteBits writes lowest bits of value
+func (w *BitWriter) WriteBits(value uint64, bits uint) {
+	for bits > 0 {
+		used := w.bits & 7
+		if used == 0 {
+			w.buf = append(w.buf, 0)
+		}
+
+		n := 8 - used
+		if bits < n {
+			n = bits
+		}
+
+		w.buf[len(w.buf)-1] |= byte(value&(1<<n-1)) << used
+		value >>= n
+		bits -= n
+		w.bits += n
+	}
+
+	if w.byteAligned {
+		w.bits = (w.bits + 7) &^ 7
+	}
+}
+
+// SetByteAligned makes writer pad every following bit field with zero bits to whole bytes
+func (w *BitWriter) SetByteAligned(aligned bool) {
+	w.byteAligned = aligned
+}
+
+// WriteSignedRange writes value clamped to [min, max] as offset from min
+func (w *BitWriter) WriteSignedRange(value, min, max int64, bits uint) {
+	if value < min {
+		value = min
+	} else if value > max {
+		value = max
+	}
+	w.WriteBits(uint64(value)-uint64(min), bits)
+}
+
+// WriteUnsignedRange writes value clamped to [min, max] as offset from min
+func (w *BitWriter) WriteUnsignedRange(value, min, max uint64, bits uint) {
+	if value < min {
+		value = min
+	} else if value > max {
+		value = max
+	}
+	w.WriteBits(value-min, bits)
+}
+
+// WriteSymbol writes Huffman code of value, value without code is invalid
+func (w *BitWriter) WriteSymbol(value uint64, code *HuffmanCode) {
+	if value >= uint64(len(code.Codes)) {
+		w.Fail(ErrInvalidValue)
+		return
+	}
+	w.WriteBits(uint64(code.Codes[value]), uint(code.Lengths[value]))
+}
+
+// WriteSignedRangeSymbol writes value clamped to [min, max] as Huffman code of offset from min
+func (w *BitWriter) WriteSignedRangeSymbol(value, min, max int64, code *HuffmanCode) {
+	if value < min {
+		value = min
+	} else if value > max {
+		value = max
+	}
+	w.WriteSymbol(uint64(value)-uint64(min), code)
+}
+
+// WriteUnsignedRangeSymbol writes value clamped to [min, max] as Huffman code of offset from min
+func (w *BitWriter) WriteUnsignedRangeSymbol(value, min, max uint64, code *HuffmanCode) {
+	if value < min {
+		value = min
+	} else if value > max {
+		value = max
+	}
+	w.WriteSymbol(value-min, code)
+}
+
+// WriteQuantized writes value clamped to [min, max] as the nearest of steps equal steps from min
+func (w *BitWriter) WriteQuantized(value float32, min, max float64, steps uint64, bits uint) {
+	v := float64(value)
+	if !(v >= min) {
+		v = min
+	} else if v > max {
+		v = max
+	}
+	w.WriteBits(uint64((v-min)*float64(steps)/(max-min)+0.5), bits)
+}
+
+// WriteQuaternion writes 2 bit index of the largest component of normalized quaternion, followed by
+// the other three components quantized to [min, max]. Quaternion is negated if that component is negative.
+func (w *BitWriter) WriteQuaternion(i, j, k, l float32, min, max float64, steps uint64, bits uint) {
+	q := [4]float64{float64(i), float64(j), float64(k), float64(l)}
+	// explicit conversions keep products from being fused with sums
+	n := math.Sqrt(float64(q[0]*q[0]) + float64(q[1]*q[1]) + float64(q[2]*q[2]) + float64(q[3]*q[3]))
+	if n > 0 && !math.IsInf(n, 0) {
+		for c := range q {
+			q[c] /= n
+		}
+	}
+
+	largest := 0
+	for c := 1; c < 4; c++ {
+		if math.Abs(q[c]) > math.Abs(q[largest]) {
+			largest = c
+		}
+	}
+	if q[largest] < 0 {
+		for c := range q {
+			q[c] = -q[c]
+		}
+	}
+
+	w.WriteBits(uint64(largest), 2)
+	for c := range q {
+		if c != largest {
+			w.WriteQuantized(float32(q[c]), min, max, steps, bits)
+		}
+	}
+}
+
+// WriteNormalized writes unit vector as two octahedral coordinates quantized to [-1, 1]
+func (w *BitWriter) WriteNormalized(x, y, z float32, steps uint64, bits uint) {
+	v := [3]float64{float64(x), float64(y), float64(z)}
+	n := math.Abs(v[0]) + math.Abs(v[1]) + math.Abs(v[2])
+	if n > 0 && !math.IsInf(n, 0) {
+		for c := range v {
+			v[c] /= n
+		}
+	} else {
+		v = [3]float64{0, 0, 1}
+	}
+
+	u, t := v[0], v[1]
+	if v[2] < 0 {
+		u, t = (1-math.Abs(v[1]))*signNotZero(v[0]), (1-math.Abs(v[0]))*signNotZero(v[1])
+	}
+	w.WriteQuantized(float32(u), -1, 1, steps, bits)
+	w.WriteQuantized(float32(t), -1, 1, steps, bits)
+}
+
+// WriteVarint writes lowest bits (up to 64) of value in groups of 7 bits, starting from the lowest one.
+// Each group is written as 8 bit field, which has the highest bit set if another group follows.
+func (w *BitWriter) WriteVarint(value uint64, bits uint) {
+	if bits < 64 {
+		value &= 1<<bits - 1
+	}
+	for value >= 0x80 {
+		w.WriteBits(value&0x7F|0x80, 8)
+		value >>= 7
+	}
+	w.WriteBits(value, 8)
+}
+
+// WriteZigZag writes signed value as varint, after mapping 0, -1, 1, -2... to 0, 1, 2, 3...
+func (w *BitWriter) WriteZigZag(value int64) {
+	w.WriteVarint(uint64(value<<1)^uint64(value>>63), 64)
+}
+
+func (w *BitWriter) WriteBool(value bool) {
+	if value {
+		w.WriteBits(1, 1)
+	} else {
+		w.WriteBits(0, 1)
+	}
+}
+
+func (w *BitWriter) WriteFloat32(value float32) {
+	w.WriteBits(uint64(math.Float32bits(value)), 32)
+}
+
+func (w *BitWriter) WriteFloat64(value float64) {
+	w.WriteBits(math.Float64bits(value), 64)
+}
+
+// WriteString writes length of value in bytes as countBits wide integer, followed by its bytes
+func (w *BitWriter) WriteString(value string, maxLength uint64, countBits uint) {
+	w.writeString(value, maxLength, countBits, 8)
+}
+
+// WriteASCII writes length of value as countBits wide integer, followed by its characters, 7 bits each
+func (w *BitWriter) WriteASCII(value string, maxLength uint64, countBits uint) {
+	for i := 0; i < len(value); i++ {
+		if value[i] >= 0x80 {
+			w.Fail(ErrInvalidValue)
+			return
+		}
+	}
+	w.writeString(value, maxLength, countBits, 7)
+}
+
+func (w *BitWriter) writeString(value string, maxLength uint64, countBits uint, charBits uint) {
+	if uint64(len(value)) > maxLength {
+		w.Fail(ErrTooLong)
+		return
+	}
+
+	w.WriteBits(uint64(len(value)), countBits)
+	for i := 0; i < len(value); i++ {
+		w.WriteBits(uint64(value[i]), charBits)
+	}
+}
+
+// WriteCount writes length of dynamic array as countBits wide integer
+func (w *BitWriter) WriteCount(n int, maxCount uint64, countBits uint) {
+	if uint64(n) > maxCount {
+		w.Fail(ErrTooLong)
+		return
+	}
+	w.WriteBits(uint64(n), countBits)
+}
+
+// WriteEnum writes index of enumeral, index not smaller than count is invalid
+func (w *BitWriter) WriteEnum(value int32, count int32, bits uint) {
+	if value < 0 || value >= count {
+		w.Fail(ErrInvalidValue)
+		return
+	}
+	w.WriteBits(uint64(value), bits)
+}
+
+// WriteClass writes index of class of value in subtypes as bits wide tag, followed by its fields.
+// Nil is written as default instance of the first subtype.
+func (w *BitWriter) WriteClass(value Class, subtypes []string, bits uint) {
+	if value == nil {
+		w.WriteBits(0, bits)
+		classes[subtypes[0]]().Encode(w)
+		return
+	}
+
+	name := value.ClassName()
+	for tag, subtype := range subtypes {
+		if subtype == name {
+			w.WriteBits(uint64(tag), bits)
+			value.Encode(w)
+			return
+		}
+	}
+	w.Fail(ErrInvalidValue)
+}
+
+// WriteASCIIChar writes ASCII character as 7 bits
+func (w *BitWriter) WriteASCIIChar(value rune) {
+	if value < 0 || value >= 0x80 {
+		w.Fail(ErrInvalidValue)
+		return
+	}
+	w.WriteBits(uint64(value), 7)
+}
+
+// Fail records first error that occurred during encoding
+func (w *BitWriter) Fail(err error) {
+	if w.err == nil {
+		w.err = err
+	}
+}
+
+func (w *BitWriter) Err() error {
+	return w.err
+}
+
+// Len returns number of written bits
+func (w *BitWriter) Len() uint {
+	return w.bits
+}
+
+func (w *BitWriter) Bytes() []byte {
+	return w.buf
+}
+
+// BitReader reads values written by BitWriter. After first error all reads return zero values.
+type BitReader struct {
+	buf         []byte
+	pos         uint
+	err         error
+	byteAligned bool
+}
+
+func NewBitReader(data []byte) *BitReader {
+	return &BitReader{
+		buf: data,
+	}
+}
+
+func (r *BitReader) ReadBits(bits uint) uint64 {
+	if r.err != nil {
+		return 0
+	}
+
+	if bits > r.Remaining() {
+		r.err = ErrUnexpectedEnd
+		return 0
+	}
+
+	var value uint64
+	var shift uint
+	for bits > 0 {
+		used := r.pos & 7
+
+		n := 8 - used
+		if bits < n {
+			n = bits
+		}
+
+		value |= uint64(r.buf[r.pos>>3]>>used&(1<<n-1)) << shift
+		shift += n
+		bits -= n
+		r.pos += n
+	}
+
+	if r.byteAligned {
+		r.pos = (r.pos + 7) &^ 7
+	}
+	return value
+}
+
+// SetByteAligned makes reader skip padding after every following bit field, up to whole bytes
+func (r *BitReader) SetByteAligned(aligned bool) {
+	r.byteAligned = aligned
+}
+
+// ReadSignedRange reads value written by WriteSignedRange
+func (r *BitReader) ReadSignedRange(min, max int64, bits uint) int64 {
+	offset := r.ReadBits(bits)
+	if offset > uint64(max)-uint64(min) {
+		r.Fail(ErrInvalidValue)
+		return min
+	}
+	return int64(uint64(min) + offset)
+}
+
+// ReadUnsignedRange reads value written by WriteUnsignedRange
+func (r *BitReader) ReadUnsignedRange(min, max uint64, bits uint) uint64 {
+	offset := r.ReadBits(bits)
+	if offset > max-min {
+		r.Fail(ErrInvalidValue)
+		return min
+	}
+	return min + offset
+}
+
+// ReadSymbol reads value written by WriteSymbol. Code is read bit by bit, padding of byte aligned
+// reader is skipped only after the whole code.
+func (r *BitReader) ReadSymbol(code *HuffmanCode) uint64 {
+	aligned := r.byteAligned
+	r.byteAligned = false
+	value := r.readSymbol(code)
+	r.byteAligned = aligned
+	if aligned {
+		r.pos = (r.pos + 7) &^ 7
+	}
+	return value
+}
+
+func (r *BitReader) readSymbol(code *HuffmanCode) uint64 {
+	// codes of each length follow all shorter ones, counting up from first
+	c, first, index := 0, 0, 0
+	for length := 1; length < len(code.Counts); length++ {
+		c |= int(r.ReadBits(1))
+		if r.err != nil {
+			return 0
+		}
+		count := int(code.Counts[length])
+		if c-first < count {
+			return uint64(code.Symbols[index+c-first])
+		}
+		index += count
+		first = (first + count) << 1
+		c <<= 1
+	}
+
+	r.Fail(ErrInvalidValue)
+	return 0
+}
+
+// ReadSignedRangeSymbol reads value written by WriteSignedRangeSymbol
+func (r *BitReader) ReadSignedRangeSymbol(min int64, code *HuffmanCode) int64 {
+	return int64(uint64(min) + r.ReadSymbol(code))
+}
+
+// ReadUnsignedRangeSymbol reads value written by WriteUnsignedRangeSymbol
+func (r *BitReader) ReadUnsignedRangeSymbol(min uint64, code *HuffmanCode) uint64 {
+	return min + r.ReadSymbol(code)
+}
+
+// ReadQuantized reads value written by WriteQuantized
+func (r *BitReader) ReadQuantized(min, max float64, steps uint64, bits uint) float32 {
+	q := r.ReadBits(bits)
+	if q > steps {
+		r.Fail(ErrInvalidValue)
+		return float32(min)
+	}
+	return float32(min + (max-min)*float64(q)/float64(steps))
+}
+
+// ReadQuaternion reads quaternion written by WriteQuaternion, its largest component is computed from the others
+func (r *BitReader) ReadQuaternion(min, max float64, steps uint64, bits uint) (i, j, k, l float32) {
+	largest := int(r.ReadBits(2))
+
+	var q [4]float32
+	sum := 0.0
+	for c := range q {
+		if c != largest {
+			q[c] = r.ReadQuantized(min, max, steps, bits)
+			sum += float64(float64(q[c]) * float64(q[c]))
+		}
+	}
+	q[largest] = float32(math.Sqrt(math.Max(0, 1-sum)))
+	return q[0], q[1], q[2], q[3]
+}
+
+// ReadNormalized reads vector written by WriteNormalized and returns it with unit length
+func (r *BitReader) ReadNormalized(steps uint64, bits uint) (x, y, z float32) {
+	u := float64(r.ReadQuantized(-1, 1, steps, bits))
+	t := float64(r.ReadQuantized(-1, 1, steps, bits))
+
+	l := 1 - math.Abs(u) - math.Abs(t)
+	if l < 0 {
+		u, t = (1-math.Abs(t))*signNotZero(u), (1-math.Abs(u))*signNotZero(t)
+	}
+	// explicit conversions keep products from being fused with sums
+	n := math.Sqrt(float64(u*u) + float64(t*t) + float64(l*l))
+	return float32(u / n), float32(t / n), float32(l / n)
+}
+
+func signNotZero(v float64) float64 {
+	if v < 0 {
+		return -1
+	}
+	return 1
+}
+
+// ReadVarint reads value written by WriteVarint, value which doesn't fit bits is invalid
+func (r *BitReader) ReadVarint(bits uint) uint64 {
+	var value uint64
+	for shift := uint(0); shift < bits; shift += 7 {
+		group := r.ReadBits(8)
+		if r.err != nil {
+			return 0
+		}
+
+		data := group & 0x7F
+		if shift+7 > bits && data>>(bits-shift) != 0 {
+			r.Fail(ErrInvalidValue)
+			return 0
+		}
+		value |= data << shift
+
+		if group&0x80 == 0 {
+			return value
+		}
+	}
+
+	r.Fail(ErrInvalidValue)
+	return 0
+}
+
+// ReadZigZag reads value written by WriteZigZag, which has to fit bits wide signed integer
+func (r *BitReader) ReadZigZag(bits uint) int64 {
+	value := r.ReadVarint(bits)
+	return int64(value>>1) ^ -int64(value&1)
+}
+
+func (r *BitReader) ReadBool() bool {
+	return r.ReadBits(1) == 1
+}
+
+func (r *BitReader) ReadFloat32() float32 {
+	return math.Float32frombits(uint32(r.ReadBits(32)))
+}
+
+func (r *BitReader) ReadFloat64() float64 {
+	return math.Float64frombits(r.ReadBits(64))
+}
+
+// ReadString reads string written by WriteString, longer strings are invalid
+func (r *BitReader) ReadString(maxLength uint64, countBits uint) string {
+	return r.readString(maxLength, countBits, 8)
+}
+
+// ReadASCII reads string written by WriteASCII, longer strings are invalid
+func (r *BitReader) ReadASCII(maxLength uint64, countBits uint) string {
+	return r.readString(maxLength, countBits, 7)
+}
+
+func (r *BitReader) readString(maxLength uint64, countBits uint, charBits uint) string {
+	n := r.ReadBits(countBits)
+	if n > maxLength {
+		r.Fail(ErrInvalidValue)
+		return ""
+	}
+	if n > uint64(r.Remaining()/charBits) {
+		r.Fail(ErrUnexpectedEnd)
+		return ""
+	}
+
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(r.ReadBits(charBits))
+	}
+	return string(b)
+}
+
+// ReadCount reads length of dynamic array, larger than maxCount is invalid. Length larger than remaining
+// data could hold, when each element takes at least minBits, is unexpected end, so that arrays aren't
+// allocated for elements which aren't there.
+func (r *BitReader) ReadCount(maxCount uint64, countBits uint, minBits uint64) int {
+	n := r.ReadBits(countBits)
+	if n > maxCount {
+		r.Fail(ErrInvalidValue)
+		return 0
+	}
+	if minBits > 0 && n > uint64(r.Remaining())/minBits {
+		r.Fail(ErrUnexpectedEnd)
+		return 0
+	}
+	return int(n)
+}
+
+// ReadEnum reads index of enumeral written by WriteEnum, index not smaller than count is invalid
+func (r *BitReader) ReadEnum(count int32, bits uint) int32 {
+	value := r.ReadBits(bits)
+	if value >= uint64(count) {
+		r.Fail(ErrInvalidValue)
+		return 0
+	}
+	return int32(value)
+}
+
+// ReadClass reads class written by WriteClass, tag outside of subtypes is invalid
+func (r *BitReader) ReadClass(subtypes []string, bits uint) Class {
+	tag := r.ReadBits(bits)
+	if tag >= uint64(len(subtypes)) || classes[subtypes[tag]] == nil {
+		r.Fail(ErrInvalidValue)
+		tag = 0
+	}
+
+	value := classes[subtypes[tag]]()
+	value.Decode(r)
+	return value
+}
+
+func (r *BitReader) ReadASCIIChar() rune {
+	return rune(r.ReadBits(7))
+}
+
+// Remaining returns number of bits left to read
+func (r *BitReader) Remaining() uint {
+	return uint(len(r.buf))*8 - r.pos
+}
+
+// Fail records first error that occurred during decoding
+func (r *BitReader) Fail(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+func (r *BitReader) Err() error {
+	return r.err
+}
+`
@@ -0,0 +1,1033 @@
+package java
+
+import (
+	"fmt"
+	"path/filepath"
+	"shrinken/gen"
+	"shrinken/sddl"
+	"shrinken/sddl/ast"
+	"strings"
+)
+
+// Java backend generates one source file per struct and enum, in Java package named after SDDL
+// package (or its exportAs attribute). Structs and classes become Java classes with public fields
+// (derived types extend their base class) and enums become Java enums. Types marked with message
+// attribute also get serialize and deserialize methods, ones marked with delta attribute also get
+// serializeDelta and deserializeDelta, which encode only fields changed against baseline instance.
+// Messages are also written with their IDs by serializeAny, which Messages.decodeAny of their package
+// dispatches to methods of MessageHandler. Classes with derived classes get static
+// encodeSubtype and decodeSubtype methods, which write subtype tag of references. Runtime lives in shrinken package and
+// works on java.nio.ByteBuffer. Generated code references other types by fully qualified names,
+// so names of SDDL types can't clash with names used by generated code.
+//
+// Java has no unsigned primitive types, so unsigned and char types are mapped as follows:
+//
+//	byte   -> byte, holds raw bits, mask it with 0xFF to get value from 0 to 255
+//	ushort -> int, holds value from 0 to 65535
+//	uint   -> long, holds value from 0 to 4294967295
+//	ulong  -> long, holds raw bits, use Long.toUnsignedString, Long.compareUnsigned etc.
+//	char   -> int, holds unicode code point, since Java char can't hold all of them
+//
+// Generated fields of these types are documented with the same mapping.
+
+type generator struct{}
+
+func init() {
+	gen.Register("java", &generator{})
+}
+
+func (g *generator) Generate(parsed *sddl.SDDLTree, outputPath string) error {
+	schema, err := gen.NewSchema(parsed)
+	if err != nil {
+		return err
+	}
+
+	for filename, content := range runtime {
+		err = gen.WriteFile(filepath.Join(outputPath, "shrinken", filename), []byte(content))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, pkg := range schema.Packages {
+		dir := filepath.Join(append([]string{outputPath}, packageParts(pkg)...)...)
+
+		for _, e := range pkg.Enums {
+			f := &typeFile{
+				schema: schema,
+				pkg:    pkg,
+			}
+
+			content, err := f.generateEnum(e)
+			if err != nil {
+				return err
+			}
+
+			err = gen.WriteFile(filepath.Join(dir, typeName(e.ExportedName)+".java"), content)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, s := range pkg.Structs {
+			f := &typeFile{
+				schema: schema,
+				pkg:    pkg,
+			}
+
+			content, err := f.generateStruct(s)
+			if err != nil {
+				return err
+			}
+
+			err = gen.WriteFile(filepath.Join(dir, typeName(s.ExportedName)+".java"), content)
+			if err != nil {
+				return err
+			}
+		}
+
+		messages := pkg.Messages()
+		if len(messages) == 0 {
+			continue
+		}
+
+		f := &typeFile{
+			schema: schema,
+			pkg:    pkg,
+		}
+
+		content, err := f.generateHandler(messages)
+		if err != nil {
+			return err
+		}
+		err = gen.WriteFile(filepath.Join(dir, "MessageHandler.java"), content)
+		if err != nil {
+			return err
+		}
+
+		content, err = f.generateMessages(messages)
+		if err != nil {
+			return err
+		}
+		err = gen.WriteFile(filepath.Join(dir, "Messages.java"), content)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type typeFile struct {
+	schema  *gen.Schema
+	pkg     *gen.Package
+	temps   int
+	huffman gen.HuffmanTables
+	err     error
+}
+
+func (f *typeFile) header(w *gen.CodeWriter) {
+	w.Line("// Code generated by shrinken. DO NOT EDIT.")
+	w.Line("")
+	w.Line("package %v;", packageName(f.pkg))
+	w.Line("")
+}
+
+func (f *typeFile) generateEnum(e *gen.Enum) ([]byte, error) {
+	name := typeName(e.ExportedName)
+	codec := e.Codec()
+
+	w := gen.NewCodeWriter("    ")
+	f.header(w)
+
+	w.Line("public enum %v {", name)
+	w.Indent()
+	for i, value := range e.Values {
+		if i < len(e.Values)-1 {
+			w.Line("%v,", identifier(value))
+		} else {
+			w.Line("%v;", identifier(value))
+		}
+	}
+	w.Line("")
+	w.Line("private static final %v[] VALUES = values();", name)
+	w.Line("")
+
+	w.Line("public void encode(shrinken.BitWriter w) {")
+	w.Indent()
+	w.Line("w.writeBits(ordinal(), %v);", codec.Bits)
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("public static %v decode(shrinken.BitReader r) {", name)
+	w.Indent()
+	w.Line("return fromOrdinal(r.readBits(%v));", codec.Bits)
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("public static %v fromOrdinal(long value) {", name)
+	w.Indent()
+	w.Line("if (value < 0 || value >= VALUES.length) {")
+	w.Indent()
+	w.Line("throw new shrinken.ShrinkenException(\"shrinken: invalid value \" + value + \" of %v\");", name)
+	w.Dedent()
+	w.Line("}")
+	w.Line("return VALUES[(int) value];")
+	w.Dedent()
+	w.Line("}")
+
+	if e.IsMessage {
+		w.Line("")
+		f.writeMessageId(w, e.Id, false)
+		w.Line("")
+		f.writeSerialize(w, e.ByteAligned)
+		w.Line("")
+		f.writeDeserialize(w, name, fmt.Sprintf("%v value = decode(r);", name), e.ByteAligned)
+	}
+
+	w.Dedent()
+	w.Line("}")
+
+	return w.Bytes(), f.err
+}
+
+func (f *typeFile) generateStruct(s *gen.Struct) ([]byte, error) {
+	name := typeName(s.ExportedName)
+
+	w := gen.NewCodeWriter("    ")
+	f.header(w)
+
+	if s.Base != nil {
+		w.Line("public class %v extends %v {", name, f.typeName(s.Base.Package, typeName(s.Base.ExportedName)))
+	} else {
+		w.Line("public class %v {", name)
+	}
+	w.Indent()
+
+	for _, field := range s.Fields {
+		if note := typeNote(field.Codec); note != "" {
+			w.Line("/** %v */", note)
+		}
+		if init := f.initializer(field.Codec); init != "" {
+			w.Line("public %v %v = %v;", f.javaType(field.Codec), fieldName(field.ExportedName), init)
+		} else {
+			w.Line("public %v %v;", f.javaType(field.Codec), fieldName(field.ExportedName))
+		}
+	}
+	if len(s.Fields) > 0 {
+		w.Line("")
+	}
+
+	if s.Base != nil {
+		w.Line("@Override")
+	}
+	w.Line("public void encode(shrinken.BitWriter w) {")
+	w.Indent()
+	if s.Base != nil {
+		w.Line("super.encode(w);")
+	}
+	if c := s.Quaternion; c != nil {
+		q := c.Quantization
+		w.Line("w.writeQuaternion(%v, %v, %v, %vL, %v);", componentFields(s), q.MinString(), q.MaxString(), q.Steps, c.Bits)
+	} else if c := s.Normalized; c != nil {
+		w.Line("w.writeNormalized(%v, %vL, %v);", componentFields(s), c.Quantization.Steps, c.Bits)
+	} else {
+		for _, field := range s.Fields {
+			f.encode(w, field.Codec, "this."+fieldName(field.ExportedName), 0)
+		}
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if s.Base != nil {
+		w.Line("@Override")
+	}
+	w.Line("public void decode(shrinken.BitReader r) {")
+	w.Indent()
+	if s.Base != nil {
+		w.Line("super.decode(r);")
+	}
+	if c := s.Quaternion; c != nil {
+		q := c.Quantization
+		w.Line("float[] quaternion = r.readQuaternion(%v, %v, %vL, %v);", q.MinString(), q.MaxString(), q.Steps, c.Bits)
+		for i, field := range s.Fields {
+			w.Line("this.%v = quaternion[%v];", fieldName(field.ExportedName), i)
+		}
+	} else if c := s.Normalized; c != nil {
+		w.Line("float[] vector = r.readNormalized(%vL, %v);", c.Quantization.Steps, c.Bits)
+		for i, field := range s.Fields {
+			w.Line("this.%v = vector[%v];", fieldName(field.ExportedName), i)
+		}
+	} else {
+		for _, field := range s.Fields {
+			f.decode(w, field.Codec, "this."+fieldName(field.ExportedName), 0)
+		}
+	}
+	w.Dedent()
+	w.Line("}")
+
+	if s.IsMessage {
+		w.Line("")
+		f.writeMessageId(w, s.Id, hasMessageBase(s))
+
+		// serialize is inherited from base message, but deserialize is static and has to return this type
+		if !hasMessageBase(s) {
+			w.Line("")
+			f.writeSerialize(w, s.ByteAligned)
+		}
+		w.Line("")
+		f.writeDeserialize(w, name, fmt.Sprintf("%v value = new %v();\nvalue.decode(r);", name, name), s.ByteAligned)
+	}
+
+	if s.HasDelta {
+		w.Line("")
+		f.writeDeltaMethods(w, s)
+	}
+
+	if len(s.Derived) > 0 {
+		w.Line("")
+		f.writeSubtypeMethods(w, s)
+	}
+
+	for i, code := range f.huffman.Tables {
+		w.Line("")
+		w.Line("/** Huffman code of %v. */", code.Name)
+		w.Line("private static final shrinken.HuffmanCode HUFFMAN%v = new shrinken.HuffmanCode(", i)
+		w.Indent()
+		w.Indent()
+		w.Line("new int[] {%v},", gen.JoinInts(code.Codes))
+		w.Line("new int[] {%v},", gen.JoinInts(code.Lengths))
+		w.Line("new int[] {%v},", gen.JoinInts(code.Counts))
+		w.Line("new int[] {%v});", gen.JoinInts(code.Symbols))
+		w.Dedent()
+		w.Dedent()
+	}
+
+	w.Dedent()
+	w.Line("}")
+
+	return w.Bytes(), f.err
+}
+
+// componentFields returns comma separated components of quaternion or normalized vector struct
+func componentFields(s *gen.Struct) string {
+	names := make([]string, len(s.Fields))
+	for i, field := range s.Fields {
+		names[i] = "this." + fieldName(field.ExportedName)
+	}
+	return strings.Join(names, ", ")
+}
+
+// writeSubtypeMethods writes methods encoding and decoding references to class, which start with subtype tag
+func (f *typeFile) writeSubtypeMethods(w *gen.CodeWriter, s *gen.Struct) {
+	name := typeName(s.ExportedName)
+	subtypes := s.Subtypes()
+
+	w.Line("/** Writes tag of the most derived class of value, followed by its fields. */")
+	w.Line("public static void encodeSubtype(shrinken.BitWriter w, %v value) {", name)
+	w.Indent()
+	w.Line("int tag = 0;")
+	w.Line("if (value == null) {")
+	w.Indent()
+	w.Line("value = new %v();", name)
+	w.Dedent()
+	for _, tag := range gen.DerivedFirst(subtypes) {
+		if tag == 0 {
+			continue
+		}
+		w.Line("} else if (value instanceof %v) {", f.typeName(subtypes[tag].Package, typeName(subtypes[tag].ExportedName)))
+		w.Indent()
+		w.Line("tag = %v;", tag)
+		w.Dedent()
+	}
+	w.Line("}")
+	w.Line("w.writeBits(tag, %v);", s.SubtypeBits())
+	w.Line("value.encode(w);")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("public static %v decodeSubtype(shrinken.BitReader r) {", name)
+	w.Indent()
+	w.Line("%v value;", name)
+	w.Line("switch ((int) r.readBits(%v)) {", s.SubtypeBits())
+	for tag, subtype := range subtypes {
+		w.Line("case %v:", tag)
+		w.Indent()
+		w.Line("value = new %v();", f.typeName(subtype.Package, typeName(subtype.ExportedName)))
+		w.Line("break;")
+		w.Dedent()
+	}
+	w.Line("default:")
+	w.Indent()
+	w.Line("throw new shrinken.ShrinkenException(\"shrinken: unknown subtype of %v\");", s.QualifiedName())
+	w.Dedent()
+	w.Line("}")
+	w.Line("value.decode(r);")
+	w.Line("return value;")
+	w.Dedent()
+	w.Line("}")
+}
+
+// writeMessageId writes ID of message, as constant for switches and as method returning ID of the most
+// derived message class
+func (f *typeFile) writeMessageId(w *gen.CodeWriter, id uint16, override bool) {
+	w.Line("public static final int MESSAGE_ID = %v;", id)
+	w.Line("")
+	if override {
+		w.Line("@Override")
+	}
+	w.Line("public int messageId() {")
+	w.Indent()
+	w.Line("return MESSAGE_ID;")
+	w.Dedent()
+	w.Line("}")
+}
+
+func (f *typeFile) writeSerialize(w *gen.CodeWriter, byteAligned bool) {
+	w.Line("public byte[] serialize() {")
+	w.Indent()
+	w.Line("shrinken.BitWriter w = new shrinken.BitWriter();")
+	if byteAligned {
+		w.Line("w.setByteAligned(true);")
+	}
+	w.Line("encode(w);")
+	w.Line("return w.toByteArray();")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("/** Writes value from position of buffer and moves position after written bytes. */")
+	w.Line("public void serialize(java.nio.ByteBuffer buffer) {")
+	w.Indent()
+	w.Line("shrinken.BitWriter w = new shrinken.BitWriter(buffer);")
+	if byteAligned {
+		w.Line("w.setByteAligned(true);")
+	}
+	w.Line("encode(w);")
+	w.Line("w.finish();")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("/** Writes messageId() followed by value. */")
+	w.Line("public byte[] serializeAny() {")
+	w.Indent()
+	w.Line("shrinken.BitWriter w = new shrinken.BitWriter();")
+	w.Line("w.writeVarint(messageId(), 16);")
+	if byteAligned {
+		w.Line("w.setByteAligned(true);")
+	}
+	w.Line("encode(w);")
+	w.Line("return w.toByteArray();")
+	w.Dedent()
+	w.Line("}")
+}
+
+// generateHandler generates MessageHandler interface with method for each message of package
+func (f *typeFile) generateHandler(messages []*gen.Message) ([]byte, error) {
+	w := gen.NewCodeWriter("    ")
+	f.header(w)
+
+	w.Line("/** Handles messages decoded by Messages.decodeAny. */")
+	w.Line("public interface MessageHandler {")
+	w.Indent()
+	for i, m := range messages {
+		if i > 0 {
+			w.Line("")
+		}
+		name := typeName(m.ExportedName)
+		w.Line("void handle%v(%v message);", name, name)
+	}
+	w.Dedent()
+	w.Line("}")
+
+	return w.Bytes(), f.err
+}
+
+// generateMessages generates Messages class, which decodes messages of package by their IDs
+func (f *typeFile) generateMessages(messages []*gen.Message) ([]byte, error) {
+	w := gen.NewCodeWriter("    ")
+	f.header(w)
+
+	w.Line("public final class Messages {")
+	w.Indent()
+	w.Line("private Messages() {")
+	w.Line("}")
+	w.Line("")
+
+	w.Line("public static void decodeAny(byte[] data, MessageHandler handler) {")
+	w.Indent()
+	w.Line("decodeAny(java.nio.ByteBuffer.wrap(data), handler);")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("/**")
+	w.Line(" * Reads message written by serializeAny from position of buffer, moves position after read bytes and")
+	w.Line(" * passes message to handler. ID of message of another package throws ShrinkenException.")
+	w.Line(" */")
+	w.Line("public static void decodeAny(java.nio.ByteBuffer buffer, MessageHandler handler) {")
+	w.Indent()
+	w.Line("shrinken.BitReader r = new shrinken.BitReader(buffer);")
+	w.Line("int id = (int) r.readVarint(16);")
+	w.Line("switch (id) {")
+	w.Indent()
+	for _, m := range messages {
+		name := typeName(m.ExportedName)
+		w.Line("case %v.MESSAGE_ID: {", name)
+		w.Indent()
+		if m.Struct != nil {
+			if m.Struct.ByteAligned {
+				w.Line("r.setByteAligned(true);")
+			}
+			w.Line("%v message = new %v();", name, name)
+			w.Line("message.decode(r);")
+		} else {
+			if m.Enum.ByteAligned {
+				w.Line("r.setByteAligned(true);")
+			}
+			w.Line("%v message = %v.decode(r);", name, name)
+		}
+		w.Line("r.finish();")
+		w.Line("handler.handle%v(message);", name)
+		w.Line("return;")
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Line("default:")
+	w.Indent()
+	w.Line("throw new shrinken.ShrinkenException(\"shrinken: unknown message \" + id);")
+	w.Dedent()
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+
+	return w.Bytes(), f.err
+}
+
+func (f *typeFile) writeDeserialize(w *gen.CodeWriter, name string, decode string, byteAligned bool) {
+	w.Line("public static %v deserialize(byte[] data) {", name)
+	w.Indent()
+	w.Line("return deserialize(java.nio.ByteBuffer.wrap(data));")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("/** Reads value from position of buffer and moves position after read bytes. */")
+	w.Line("public static %v deserialize(java.nio.ByteBuffer buffer) {", name)
+	w.Indent()
+	w.Line("shrinken.BitReader r = new shrinken.BitReader(buffer);")
+	if byteAligned {
+		w.Line("r.setByteAligned(true);")
+	}
+	for _, line := range strings.Split(decode, "\n") {
+		w.Line(line)
+	}
+	w.Line("r.finish();")
+	w.Line("return value;")
+	w.Dedent()
+	w.Line("}")
+}
+
+// writeDeltaMethods writes methods comparing object with baseline and encoding it as delta against it
+func (f *typeFile) writeDeltaMethods(w *gen.CodeWriter, s *gen.Struct) {
+	name := typeName(s.ExportedName)
+	fields := s.AllFields()
+
+	w.Line("/** Reports which fields, including inherited ones, are changed against baseline. */")
+	w.Line("public boolean[] changedFields(%v baseline) {", name)
+	w.Indent()
+	w.Line("boolean[] changed = new boolean[%v];", len(fields))
+	for i, field := range fields {
+		fieldName := fieldName(field.ExportedName)
+		f.compare(w, field.Codec, "this."+fieldName, "baseline."+fieldName, fmt.Sprintf("changed[%v]", i), 0)
+	}
+	w.Line("return changed;")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("public boolean differs(%v baseline) {", name)
+	w.Indent()
+	w.Line("for (boolean c : changedFields(baseline)) {")
+	w.Indent()
+	w.Line("if (c) {")
+	w.Indent()
+	w.Line("return true;")
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Line("return false;")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("/** Writes mask of fields changed against baseline, followed by changed fields. */")
+	w.Line("public void encodeDelta(shrinken.BitWriter w, %v baseline) {", name)
+	w.Indent()
+	w.Line("boolean[] changed = changedFields(baseline);")
+	w.Line("for (boolean c : changed) {")
+	w.Indent()
+	w.Line("w.writeBool(c);")
+	w.Dedent()
+	w.Line("}")
+	for i, field := range fields {
+		fieldName := fieldName(field.ExportedName)
+		w.Line("if (changed[%v]) {", i)
+		w.Indent()
+		if field.Codec.IsDelta() {
+			typ := f.javaType(field.Codec)
+			w.Line("(this.%v != null ? this.%v : new %v()).encodeDelta(w, baseline.%v != null ? baseline.%v : new %v());", fieldName, fieldName, typ, fieldName, fieldName, typ)
+		} else {
+			f.encode(w, field.Codec, "this."+fieldName, 0)
+		}
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("/** Reads delta written by encodeDelta and replaces changed fields, this object holds baseline. */")
+	if hasDeltaBase(s, false) {
+		w.Line("@Override")
+	}
+	w.Line("public void decodeDelta(shrinken.BitReader r) {")
+	w.Indent()
+	w.Line("boolean[] changed = new boolean[%v];", len(fields))
+	w.Line("for (int i = 0; i < changed.length; i++) {")
+	w.Indent()
+	w.Line("changed[i] = r.readBool();")
+	w.Dedent()
+	w.Line("}")
+	for i, field := range fields {
+		fieldName := fieldName(field.ExportedName)
+		w.Line("if (changed[%v]) {", i)
+		w.Indent()
+		if field.Codec.IsDelta() {
+			w.Line("if (this.%v == null) {", fieldName)
+			w.Indent()
+			w.Line("this.%v = new %v();", fieldName, f.javaType(field.Codec))
+			w.Dedent()
+			w.Line("}")
+			w.Line("this.%v.decodeDelta(r);", fieldName)
+		} else {
+			f.decode(w, field.Codec, "this."+fieldName, 0)
+		}
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Dedent()
+	w.Line("}")
+
+	if !s.IsDelta {
+		return
+	}
+
+	override := hasDeltaBase(s, true)
+	w.Line("")
+	w.Line("public byte[] serializeDelta(%v baseline) {", name)
+	w.Indent()
+	w.Line("shrinken.BitWriter w = new shrinken.BitWriter();")
+	if s.ByteAligned {
+		w.Line("w.setByteAligned(true);")
+	}
+	w.Line("encodeDelta(w, baseline);")
+	w.Line("return w.toByteArray();")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("/** Writes delta against baseline from position of buffer and moves position after written bytes. */")
+	w.Line("public void serializeDelta(%v baseline, java.nio.ByteBuffer buffer) {", name)
+	w.Indent()
+	w.Line("shrinken.BitWriter w = new shrinken.BitWriter(buffer);")
+	if s.ByteAligned {
+		w.Line("w.setByteAligned(true);")
+	}
+	w.Line("encodeDelta(w, baseline);")
+	w.Line("w.finish();")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if override {
+		w.Line("@Override")
+	}
+	w.Line("public void deserializeDelta(byte[] data) {")
+	w.Indent()
+	w.Line("deserializeDelta(java.nio.ByteBuffer.wrap(data));")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("/** Applies delta to this object, which has to hold baseline the delta was written against. */")
+	if override {
+		w.Line("@Override")
+	}
+	w.Line("public void deserializeDelta(java.nio.ByteBuffer buffer) {")
+	w.Indent()
+	w.Line("shrinken.BitReader r = new shrinken.BitReader(buffer);")
+	if s.ByteAligned {
+		w.Line("r.setByteAligned(true);")
+	}
+	w.Line("decodeDelta(r);")
+	w.Line("r.finish();")
+	w.Dedent()
+	w.Line("}")
+}
+
+// compare writes statements setting target to whether value a is changed against b, as defined for delta
+// encoding. References to polymorphic classes are always changed, null strings and arrays are empty.
+func (f *typeFile) compare(w *gen.CodeWriter, c *gen.Codec, a, b, target string, depth int) {
+	switch c.Kind {
+	case gen.StructCodec:
+		if c.IsPolymorphic() {
+			w.Line("%v = true;", target)
+			return
+		}
+		typ := f.javaType(c)
+		w.Line("%v = (%v != null ? %v : new %v()).differs(%v != null ? %v : new %v());", target, a, a, typ, b, b, typ)
+	case gen.StringCodec:
+		w.Line("%v = !(%v != null ? %v : \"\").equals(%v != null ? %v : \"\");", target, a, a, b, b)
+	case gen.ArrayCodec:
+		i := fmt.Sprintf("i%v", depth)
+		n := f.temp("n")
+		if c.IsDynamic() {
+			w.Line("int %v = %v != null ? %v.length : 0;", n, a, a)
+			w.Line("%v = %v != (%v != null ? %v.length : 0);", target, n, b, b)
+		} else {
+			w.Line("int %v = %v;", n, c.Size)
+			w.Line("%v = false;", target)
+		}
+		w.Line("for (int %v = 0; !%v && %v < %v; %v++) {", i, target, i, n, i)
+		w.Indent()
+		f.compare(w, c.Elem, fmt.Sprintf("%v[%v]", a, i), fmt.Sprintf("%v[%v]", b, i), target, depth+1)
+		w.Dedent()
+		w.Line("}")
+	default:
+		w.Line("%v = %v != %v;", target, a, b)
+	}
+}
+
+// hasDeltaBase reports whether base class of s has delta methods, or delta message methods if message is set
+func hasDeltaBase(s *gen.Struct, message bool) bool {
+	for base := s.Base; base != nil; base = base.Base {
+		if base.HasDelta && (!message || base.IsDelta) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasMessageBase(s *gen.Struct) bool {
+	for base := s.Base; base != nil; base = base.Base {
+		if base.IsMessage {
+			return true
+		}
+	}
+	return false
+}
+
+// temp returns name of temporary variable, unique within generated file
+func (f *typeFile) temp(prefix string) string {
+	f.temps++
+	return fmt.Sprintf("%v%v", prefix, f.temps)
+}
+
+func (f *typeFile) encode(w *gen.CodeWriter, c *gen.Codec, expr string, depth int) {
+	switch c.Kind {
+	case gen.BoolCodec:
+		w.Line("w.writeBool(%v);", expr)
+	case gen.IntCodec, gen.CharCodec:
+		if c.IsASCII() {
+			w.Line("w.writeAsciiChar(%v);", expr)
+		} else if c.Huffman != nil && c.Type.GenericType == ast.UnsignedInteger64 {
+			w.Line("w.writeUnsignedRangeSymbol(%v, %vL, %vL, HUFFMAN%v);", expr, int64(c.Range.Min), int64(c.Range.Max), f.huffman.Index(c.Huffman))
+		} else if c.Huffman != nil && c.Type.GenericType == ast.Byte {
+			w.Line("w.writeSignedRangeSymbol(%v & 0xFF, %vL, %vL, HUFFMAN%v);", expr, c.MinString(), c.MaxString(), f.huffman.Index(c.Huffman))
+		} else if c.Huffman != nil {
+			w.Line("w.writeSignedRangeSymbol(%v, %vL, %vL, HUFFMAN%v);", expr, c.MinString(), c.MaxString(), f.huffman.Index(c.Huffman))
+		} else if c.Range != nil && c.Type.GenericType == ast.UnsignedInteger64 {
+			w.Line("w.writeUnsignedRange(%v, %vL, %vL, %v);", expr, int64(c.Range.Min), int64(c.Range.Max), c.Bits)
+		} else if c.Range != nil && c.Type.GenericType == ast.Byte {
+			// Java byte holds raw bits of unsigned value
+			w.Line("w.writeSignedRange(%v & 0xFF, %vL, %vL, %v);", expr, c.MinString(), c.MaxString(), c.Bits)
+		} else if c.Range != nil {
+			w.Line("w.writeSignedRange(%v, %vL, %vL, %v);", expr, c.MinString(), c.MaxString(), c.Bits)
+		} else if c.Encoding == gen.VarintEncoding {
+			w.Line("w.writeVarint(%v, %v);", expr, c.Bits)
+		} else if c.Encoding == gen.ZigZagEncoding {
+			w.Line("w.writeZigZag(%v);", expr)
+		} else {
+			w.Line("w.writeBits(%v, %v);", expr, c.Bits)
+		}
+	case gen.EnumCodec:
+		if c.Huffman != nil {
+			w.Line("w.writeSymbol(%v.ordinal(), HUFFMAN%v);", expr, f.huffman.Index(c.Huffman))
+		} else {
+			w.Line("%v.encode(w);", expr)
+		}
+	case gen.FloatCodec:
+		if q := c.Quantization; q != nil {
+			w.Line("w.writeQuantized(%v, %v, %v, %vL, %v);", expr, q.MinString(), q.MaxString(), q.Steps, c.Bits)
+		} else if c.Bits == 32 {
+			w.Line("w.writeFloat(%v);", expr)
+		} else {
+			w.Line("w.writeDouble(%v);", expr)
+		}
+	case gen.StringCodec:
+		if c.IsASCII() {
+			w.Line("w.writeAscii(%v, %vL, %v);", expr, c.MaxLength, c.CountBits)
+		} else {
+			w.Line("w.writeString(%v, %vL, %v);", expr, c.MaxLength, c.CountBits)
+		}
+	case gen.StructCodec:
+		if c.IsPolymorphic() {
+			w.Line("%v.encodeSubtype(w, %v);", f.javaType(c), expr)
+			return
+		}
+		// null references are encoded as default instances
+		w.Line("(%v != null ? %v : new %v()).encode(w);", expr, expr, f.javaType(c))
+	case gen.ArrayCodec:
+		i := fmt.Sprintf("i%v", depth)
+		n := f.temp("n")
+		if c.IsDynamic() {
+			// null arrays are written as empty arrays
+			w.Line("int %v = %v != null ? %v.length : 0;", n, expr, expr)
+			w.Line("w.writeCount(%v, %vL, %v);", n, c.MaxCount, c.CountBits)
+		} else {
+			w.Line("int %v = %v;", n, c.Size)
+			w.Line("w.checkSize(%v.length, %v);", expr, n)
+		}
+		w.Line("for (int %v = 0; %v < %v; %v++) {", i, i, n, i)
+		w.Indent()
+		f.encode(w, c.Elem, fmt.Sprintf("%v[%v]", expr, i), depth+1)
+		w.Dedent()
+		w.Line("}")
+	}
+}
+
+func (f *typeFile) decode(w *gen.CodeWriter, c *gen.Codec, expr string, depth int) {
+	switch c.Kind {
+	case gen.BoolCodec:
+		w.Line("%v = r.readBool();", expr)
+	case gen.IntCodec:
+		read := fmt.Sprintf("r.readBits(%v)", c.Bits)
+		if c.Huffman != nil && c.Type.GenericType == ast.UnsignedInteger64 {
+			read = fmt.Sprintf("r.readRangeSymbol(%vL, HUFFMAN%v)", int64(c.Range.Min), f.huffman.Index(c.Huffman))
+		} else if c.Huffman != nil {
+			read = fmt.Sprintf("r.readRangeSymbol(%vL, HUFFMAN%v)", c.MinString(), f.huffman.Index(c.Huffman))
+		} else if c.Range != nil && c.Type.GenericType == ast.UnsignedInteger64 {
+			read = fmt.Sprintf("r.readUnsignedRange(%vL, %vL, %v)", int64(c.Range.Min), int64(c.Range.Max), c.Bits)
+		} else if c.Range != nil {
+			read = fmt.Sprintf("r.readSignedRange(%vL, %vL, %v)", c.MinString(), c.MaxString(), c.Bits)
+		} else if c.Encoding == gen.VarintEncoding {
+			read = fmt.Sprintf("r.readVarint(%v)", c.Bits)
+		} else if c.Encoding == gen.ZigZagEncoding {
+			read = fmt.Sprintf("r.readZigZag(%v)", c.Bits)
+		}
+
+		if t := f.javaType(c); t == "long" {
+			w.Line("%v = %v;", expr, read)
+		} else {
+			// narrowing keeps lowest bits, which restores sign of signed types
+			w.Line("%v = (%v) %v;", expr, t, read)
+		}
+	case gen.CharCodec:
+		if c.IsASCII() {
+			w.Line("%v = r.readAsciiChar();", expr)
+		} else {
+			w.Line("%v = r.readChar();", expr)
+		}
+	case gen.EnumCodec:
+		if c.Huffman != nil {
+			w.Line("%v = %v.fromOrdinal(r.readSymbol(HUFFMAN%v));", expr, f.javaType(c), f.huffman.Index(c.Huffman))
+		} else {
+			w.Line("%v = %v.decode(r);", expr, f.javaType(c))
+		}
+	case gen.FloatCodec:
+		if q := c.Quantization; q != nil {
+			w.Line("%v = r.readQuantized(%v, %v, %vL, %v);", expr, q.MinString(), q.MaxString(), q.Steps, c.Bits)
+		} else if c.Bits == 32 {
+			w.Line("%v = r.readFloat();", expr)
+		} else {
+			w.Line("%v = r.readDouble();", expr)
+		}
+	case gen.StringCodec:
+		if c.IsASCII() {
+			w.Line("%v = r.readAscii(%vL, %v);", expr, c.MaxLength, c.CountBits)
+		} else {
+			w.Line("%v = r.readString(%vL, %v);", expr, c.MaxLength, c.CountBits)
+		}
+	case gen.StructCodec:
+		if c.IsPolymorphic() {
+			w.Line("%v = %v.decodeSubtype(r);", expr, f.javaType(c))
+			return
+		}
+		w.Line("%v = new %v();", expr, f.javaType(c))
+		w.Line("%v.decode(r);", expr)
+	case gen.ArrayCodec:
+		i := fmt.Sprintf("i%v", depth)
+		n := f.temp("n")
+		if c.IsDynamic() {
+			w.Line("int %v = r.readCount(%vL, %v, %vL);", n, c.MaxCount, c.CountBits, c.Elem.MinBits())
+		} else {
+			w.Line("int %v = %v;", n, c.Size)
+		}
+		w.Line("%v = %v;", expr, f.newArray(c, n))
+		w.Line("for (int %v = 0; %v < %v; %v++) {", i, i, n, i)
+		w.Indent()
+		f.decode(w, c.Elem, fmt.Sprintf("%v[%v]", expr, i), depth+1)
+		w.Dedent()
+		w.Line("}")
+	}
+}
+
+// initializer returns field initializer, so that fields of new objects can be encoded right away
+func (f *typeFile) initializer(c *gen.Codec) string {
+	switch c.Kind {
+	case gen.ArrayCodec:
+		if c.IsDynamic() {
+			return f.newArray(c, "0")
+		}
+		return f.newArray(c, fmt.Sprint(c.Size))
+	case gen.StructCodec:
+		if c.Struct.IsClass {
+			return ""
+		}
+		return fmt.Sprintf("new %v()", f.javaType(c))
+	case gen.EnumCodec:
+		return f.javaType(c) + "." + identifier(c.Enum.Values[0])
+	case gen.StringCodec:
+		return "\"\""
+	}
+	return ""
+}
+
+// newArray returns expression which creates array of given length, size has to be placed
+// into first brackets of array type (int[][] -> new int[n][])
+func (f *typeFile) newArray(c *gen.Codec, length string) string {
+	elemType := f.javaType(c.Elem)
+	bracket := strings.Index(elemType, "[")
+	if bracket == -1 {
+		return fmt.Sprintf("new %v[%v]", elemType, length)
+	}
+	return fmt.Sprintf("new %v[%v]%v", elemType[:bracket], length, elemType[bracket:])
+}
+
+func (f *typeFile) javaType(c *gen.Codec) string {
+	switch c.Kind {
+	case gen.ArrayCodec:
+		return f.javaType(c.Elem) + "[]"
+	case gen.StructCodec:
+		return f.typeName(c.Struct.Package, typeName(c.Struct.ExportedName))
+	case gen.EnumCodec:
+		return f.typeName(c.Enum.Package, typeName(c.Enum.ExportedName))
+	}
+
+	switch c.Type.GenericType {
+	case ast.Integer32, ast.UnsignedShort, ast.Char:
+		return "int"
+	case ast.Integer64, ast.UnsignedInteger32, ast.UnsignedInteger64:
+		return "long"
+	case ast.Short:
+		return "short"
+	case ast.Byte:
+		return "byte"
+	case ast.Bool:
+		return "boolean"
+	case ast.String:
+		return "java.lang.String"
+	case ast.Float:
+		return "float"
+	case ast.Double:
+		return "double"
+	}
+
+	f.err = fmt.Errorf("Java backend doesn't support type %v", c.Type.GenericType.String())
+	return ""
+}
+
+// typeNote returns documentation of types which don't map directly to Java types
+func typeNote(c *gen.Codec) string {
+	for c.Kind == gen.ArrayCodec {
+		c = c.Elem
+	}
+
+	if c.Type == nil || !c.Type.IsGeneric {
+		return ""
+	}
+
+	switch c.Type.GenericType {
+	case ast.Byte:
+		return "SDDL byte, holds raw bits of value from 0 to 255, mask it with 0xFF to get the value"
+	case ast.UnsignedShort:
+		return "SDDL ushort, holds value from 0 to 65535"
+	case ast.UnsignedInteger32:
+		return "SDDL uint, holds value from 0 to 4294967295"
+	case ast.UnsignedInteger64:
+		return "SDDL ulong, holds raw bits of unsigned value (use Long.toUnsignedString, Long.compareUnsigned etc.)"
+	case ast.Char:
+		return "SDDL char, holds unicode code point"
+	}
+	return ""
+}
+
+// typeName returns name of type, fully qualified if type is declared in other package
+func (f *typeFile) typeName(pkg *gen.Package, name string) string {
+	if pkg == f.pkg {
+		return name
+	}
+	return packageName(pkg) + "." + name
+}
+
+// packageName returns Java package of SDDL package, which is exportAs attribute or SDDL package name
+func packageName(pkg *gen.Package) string {
+	return strings.Join(packageParts(pkg), ".")
+}
+
+func packageParts(pkg *gen.Package) []string {
+	parts := strings.Split(pkg.ExportedName, ".")
+	for i, part := range parts {
+		parts[i] = identifier(part)
+	}
+	return parts
+}
+
+func typeName(name string) string {
+	return identifier(gen.UpperFirst(name))
+}
+
+func fieldName(name string) string {
+	return identifier(gen.LowerFirst(name))
+}
+
+// identifier appends underscore to names which are reserved in Java
+func identifier(name string) string {
+	if keywords[name] {
+		return name + "_"
+	}
+	return name
+}
+
+var keywords = map[string]bool{
+	"abstract": true, "assert": true, "boolean": true, "break": true, "byte": true, "case": true,
+	"catch": true, "char": true, "class": true, "const": true, "continue": true, "default": true,
+	"do": true, "double": true, "else": true, "enum": true, "extends": true, "final": true,
+	"finally": true, "float": true, "for": true, "goto": true, "if": true, "implements": true,
+	"import": true, "instanceof": true, "int": true, "interface": true, "long": true, "native": true,
+	"new": true, "package": true, "private": true, "protected": true, "public": true, "return": true,
+	"short": true, "static": true, "strictfp": true, "super": true, "switch": true,
+	"synchronized": true, "this": true, "throw": true, "throws": true, "transient": true, "try": true,
+	"void": true, "volatile": true, "while": true, "true": true, "false": true, "null": true,
+	"var": true, "record": true, "yield": true, "sealed": true, "permits": true, "_": true,
+}
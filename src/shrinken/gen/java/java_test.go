@@ -0,0 +1,315 @@
+package java
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"shrinken/gen"
+	"shrinken/wire/wiretest"
+	"strings"
+	"testing"
+)
+
+func generate(t *testing.T, filename string) string {
+	tree := wiretest.Parse(t, filename)
+
+	dir, err := ioutil.TempDir("", "shrinken")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = gen.Generate(tree, "java", dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal("Java code couldn't be generated!", err)
+	}
+
+	return dir
+}
+
+func readGenerated(t *testing.T, filename string) string {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal("Generated file is missing!", err)
+	}
+	return string(b)
+}
+
+func TestBasic(t *testing.T) {
+	dir := generate(t, "../../sddl/test_data/single_file/basic.sddl")
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"BitWriter.java", "BitReader.java", "ShrinkenException.java"} {
+		readGenerated(t, filepath.Join(dir, "shrinken", name))
+	}
+
+	pkgDir := filepath.Join(dir, "com", "github", "namespace")
+	for _, name := range []string{"Entity.java", "Vector3.java", "Quaternion.java"} {
+		readGenerated(t, filepath.Join(pkgDir, name))
+	}
+
+	code := readGenerated(t, filepath.Join(pkgDir, "Player.java"))
+
+	expected := []string{
+		"package com.github.namespace;",
+		"public class Player extends Entity {",
+		"super.encode(w);",
+		"public static Player deserialize(java.nio.ByteBuffer buffer) {",
+	}
+	for _, e := range expected {
+		if !strings.Contains(code, e) {
+			t.Fatalf("Generated code doesn't contain %q", e)
+		}
+	}
+
+	entity := readGenerated(t, filepath.Join(pkgDir, "Entity.java"))
+	if !strings.Contains(entity, "public Vector3 pos = new Vector3();") {
+		t.Fatal("ExportAs attribute is not honoured on variables")
+	}
+}
+
+func TestQuaternion(t *testing.T) {
+	dir := generate(t, "../../sddl/test_data/single_file/quaternion.sddl")
+	defer os.RemoveAll(dir)
+
+	rotation := readGenerated(t, filepath.Join(dir, "com", "github", "namespace", "Rotation.java"))
+	if !strings.Contains(rotation, "w.writeQuaternion(this.i, this.j, this.k, this.w, ") ||
+		!strings.Contains(rotation, "this.w = quaternion[3];") {
+		t.Fatal("Quaternion is not written with smallest-three encoding")
+	}
+}
+
+func TestNormalized(t *testing.T) {
+	dir := generate(t, "../../sddl/test_data/single_file/normalized.sddl")
+	defer os.RemoveAll(dir)
+
+	direction := readGenerated(t, filepath.Join(dir, "com", "github", "namespace", "Direction.java"))
+	if !strings.Contains(direction, "w.writeNormalized(this.x, this.y, this.z, 65534L, 16);") {
+		t.Fatal("Normalized vector is not written with octahedral encoding")
+	}
+}
+
+func TestMultiplePackages(t *testing.T) {
+	dir := generate(t, "../../sddl/test_data/multipkg/same_name/")
+	defer os.RemoveAll(dir)
+
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// runtime package and at least one directory of generated packages
+	if len(entries) < 2 {
+		t.Fatal("Generated packages are missing")
+	}
+}
+
+func TestDelta(t *testing.T) {
+	dir := generate(t, wiretest.Schema)
+	defer os.RemoveAll(dir)
+
+	code := readGenerated(t, filepath.Join(dir, "conformance", "Sample.java"))
+
+	expected := []string{
+		"public boolean[] changedFields(Sample baseline) {",
+		"changed[1] = this.flag != baseline.flag;",
+		"changed[19] = true;",
+		"this.pos.decodeDelta(r);",
+		"public byte[] serializeDelta(Sample baseline) {",
+		"public void deserializeDelta(java.nio.ByteBuffer buffer) {",
+	}
+	for _, e := range expected {
+		if !strings.Contains(code, e) {
+			t.Fatalf("Generated code doesn't contain %q", e)
+		}
+	}
+}
+
+func TestAlignment(t *testing.T) {
+	dir := generate(t, wiretest.Schema)
+	defer os.RemoveAll(dir)
+
+	// serializers and deserializers of whole message and delta, to byte array and to buffer, and serializer
+	// of message with its ID
+	aligned := readGenerated(t, filepath.Join(dir, "conformance", "Aligned.java"))
+	if n := strings.Count(aligned, "w.setByteAligned(true);"); n != 5 {
+		t.Fatalf("Writer of byte aligned message is made byte aligned %v times, expected 5", n)
+	}
+	if n := strings.Count(aligned, "r.setByteAligned(true);"); n != 2 {
+		t.Fatalf("Reader of byte aligned message is made byte aligned %v times, expected 2", n)
+	}
+
+	sample := readGenerated(t, filepath.Join(dir, "conformance", "Sample.java"))
+	if strings.Contains(sample, "setByteAligned") {
+		t.Fatal("Bit aligned message is written byte aligned")
+	}
+}
+
+func TestEntropy(t *testing.T) {
+	dir := generate(t, wiretest.Schema)
+	defer os.RemoveAll(dir)
+
+	code := readGenerated(t, filepath.Join(dir, "conformance", "Coded.java"))
+
+	expected := []string{
+		"w.writeSymbol(this.color.ordinal(), HUFFMAN0);",
+		"this.color = Color.fromOrdinal(r.readSymbol(HUFFMAN0));",
+		"w.writeSignedRangeSymbol(this.offset, -10L, 10L, HUFFMAN2);",
+		"this.offset = (short) r.readRangeSymbol(-10L, HUFFMAN2);",
+		"new int[] {0, 1, 3},",
+	}
+	for _, e := range expected {
+		if !strings.Contains(code, e) {
+			t.Fatalf("Generated code doesn't contain %q", e)
+		}
+	}
+
+	// fields without entropy table are written as before
+	if !strings.Contains(code, "this.slots[i0].encode(w);") {
+		t.Fatal("Enum array without entropy table isn't encoded by its enum")
+	}
+}
+
+func TestMessageIds(t *testing.T) {
+	dir := generate(t, wiretest.Schema)
+	defer os.RemoveAll(dir)
+
+	code := readGenerated(t, filepath.Join(dir, "conformance", "Aligned.java"))
+	expected := []string{
+		"public static final int MESSAGE_ID = 300;",
+		"public int messageId() {",
+		"w.writeVarint(messageId(), 16);\n        w.setByteAligned(true);",
+	}
+	for _, e := range expected {
+		if !strings.Contains(code, e) {
+			t.Fatalf("Generated code doesn't contain %q", e)
+		}
+	}
+
+	code = readGenerated(t, filepath.Join(dir, "conformance", "MessageHandler.java"))
+	if !strings.Contains(code, "void handleCoded(Coded message);") {
+		t.Fatal("MessageHandler is missing method of message Coded")
+	}
+
+	code = readGenerated(t, filepath.Join(dir, "conformance", "Messages.java"))
+	expected = []string{
+		"case Aligned.MESSAGE_ID: {\n                r.setByteAligned(true);\n                Aligned message = new Aligned();",
+		"handler.handleSample(message);",
+		"throw new shrinken.ShrinkenException(\"shrinken: unknown message \" + id);",
+	}
+	for _, e := range expected {
+		if !strings.Contains(code, e) {
+			t.Fatalf("Generated code doesn't contain %q", e)
+		}
+	}
+}
+
+const conformanceMain = `
+import conformance.*;
+
+import java.io.BufferedReader;
+import java.io.InputStreamReader;
+
+public class Main implements MessageHandler {
+    static byte[] fromHex(String hex) {
+        byte[] data = new byte[hex.length() / 2];
+        for (int i = 0; i < data.length; i++) {
+            data[i] = (byte) Integer.parseInt(hex.substring(i * 2, i * 2 + 2), 16);
+        }
+        return data;
+    }
+
+    static String toHex(byte[] data) {
+        StringBuilder sb = new StringBuilder();
+        for (byte b : data) {
+            sb.append(String.format("%02x", b & 0xff));
+        }
+        return sb.toString();
+    }
+
+    public void handleSample(Sample message) {
+        System.out.println(toHex(message.serializeAny()));
+    }
+
+    public void handleAligned(Aligned message) {
+        System.out.println(toHex(message.serializeAny()));
+    }
+
+    public void handleCoded(Coded message) {
+        System.out.println(toHex(message.serializeAny()));
+    }
+
+    public void handleBulk(Bulk message) {
+        System.out.println(toHex(message.serializeAny()));
+    }
+
+    public static void main(String[] args) throws Exception {
+        BufferedReader in = new BufferedReader(new InputStreamReader(System.in));
+        byte[] data = fromHex(in.readLine().trim());
+        String mode = args.length > 0 ? args[0] : "";
+
+        if (mode.equals("any")) {
+            Messages.decodeAny(data, new Main());
+            for (String line = in.readLine(); line != null; line = in.readLine()) {
+                if (!line.trim().isEmpty()) {
+                    Messages.decodeAny(fromHex(line.trim()), new Main());
+                }
+            }
+        } else if (mode.equals("truncated")) {
+            try {
+                Bulk.deserialize(data);
+                System.out.println("ok");
+            } catch (shrinken.ShrinkenException e) {
+                System.out.println(e.getMessage());
+            }
+        } else if (mode.equals("aligned")) {
+            System.out.println(toHex(Aligned.deserialize(data).serialize()));
+        } else if (mode.equals("coded")) {
+            System.out.println(toHex(Coded.deserialize(data).serialize()));
+        } else if (mode.equals("delta")) {
+            Sample sample = Sample.deserialize(data);
+            Sample baseline = Sample.deserialize(data);
+            sample.deserializeDelta(fromHex(in.readLine().trim()));
+            System.out.println(toHex(sample.serialize()));
+            System.out.println(toHex(sample.serializeDelta(baseline)));
+        } else {
+            System.out.println(toHex(Sample.deserialize(data).serialize()));
+        }
+    }
+}
+`
+
+func TestConformance(t *testing.T) {
+	javac := wiretest.Tool(t, "javac")
+	java := wiretest.Tool(t, "java")
+
+	dir := generate(t, wiretest.Schema)
+	defer os.RemoveAll(dir)
+
+	err := ioutil.WriteFile(filepath.Join(dir, "Main.java"), []byte(conformanceMain), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sources := []string{"Main.java"}
+	for _, pkg := range []string{"shrinken", "conformance"} {
+		files, err := filepath.Glob(filepath.Join(dir, pkg, "*.java"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		sources = append(sources, files...)
+	}
+
+	out := filepath.Join(dir, "out")
+	cmd := exec.Command(javac, append([]string{"-d", out}, sources...)...)
+	cmd.Dir = dir
+	wiretest.Build(t, cmd)
+
+	wiretest.Run(t, exec.Command(java, "-cp", out, "Main"))
+	wiretest.RunDelta(t, exec.Command(java, "-cp", out, "Main"))
+	wiretest.RunAligned(t, exec.Command(java, "-cp", out, "Main"))
+	wiretest.RunCoded(t, exec.Command(java, "-cp", out, "Main"))
+	wiretest.RunAny(t, exec.Command(java, "-cp", out, "Main"))
+	wiretest.RunTruncated(t, exec.Command(java, "-cp", out, "Main"))
+}
@@ -0,0 +1,606 @@
+package java
+
+// runtime files are written to shrinken package next to generated packages
+
+var runtime = map[string]string{
+	"ShrinkenException.java": runtimeException,
+	"BitWriter.java":         runtimeWriter,
+	"BitReader.java":         runtimeReader,
+	"HuffmanCode.java":       runtimeHuffmanCode,
+}
+
+const runtimeException = `// Code generated by shrinken. DO NOT EDIT.
+
+package shrinken;
+
+public class ShrinkenException extends RuntimeException {
+    public ShrinkenException(String message) {
+        super(message);
+    }
+}
+`
+
+const runtimeHuffmanCode = `// Code generated by shrinken. DO NOT EDIT.
+
+package shrinken;
+
+/**
+ * Canonical Huffman code of values 0 to codes.length-1. Code of value is written as single lengths[value] wide
+ * field codes[value], first bit of code lowest. Counts holds number of codes of each length up to the longest one
+ * and symbols values ordered by their codes, for reading codes bit by bit.
+ */
+public final class HuffmanCode {
+    final int[] codes;
+    final int[] lengths;
+    final int[] counts;
+    final int[] symbols;
+
+    public HuffmanCode(int[] codes, int[] lengths, int[] counts, int[] symbols) {
+        this.codes = codes;
+        this.lengths = lengths;
+        this.counts = counts;
+        this.symbols = symbols;
+    }
+}
+`
+
+const runtimeWriter = `// Code generated by shrinken. DO NOT EDIT.
+
+package shrinken;
+
+import java.nio.ByteBuffer;
+import java.nio.charset.StandardCharsets;
+import java.util.Arrays;
+
+/**
+ * Writes values as little-endian bit fields, starting from least significant bit of first byte.
+ * Values are written from position of buffer. Writer created without buffer grows its own buffer,
+ * while writer over given buffer throws ShrinkenException when it runs out of space.
+ */
+public final class BitWriter {
+    private ByteBuffer buffer;
+    private final int start;
+    private final boolean growable;
+    private long bits;
+    private boolean byteAligned;
+
+    public BitWriter() {
+        this(ByteBuffer.allocate(64), true);
+    }
+
+    public BitWriter(ByteBuffer buffer) {
+        this(buffer, false);
+    }
+
+    private BitWriter(ByteBuffer buffer, boolean growable) {
+        this.buffer = buffer;
+        this.start = buffer.position();
+        this.growable = growable;
+    }
+
+    /** Writes lowest bits (up to 64) of value. */
+    public void writeBits(long value, int bits) {
+        while (bits > 0) {
+            int used = (int) (this.bits & 7);
+            int index = start + (int) (this.bits >>> 3);
+            if (used == 0) {
+                ensureCapacity(index + 1);
+                buffer.put(index, (byte) 0);
+            }
+
+            int n = Math.min(8 - used, bits);
+            int current = buffer.get(index) & 0xFF;
+            buffer.put(index, (byte) (current | (int) ((value & ((1L << n) - 1)) << used)));
+            value >>>= n;
+            bits -= n;
+            this.bits += n;
+        }
+
+        if (byteAligned) {
+            this.bits = (this.bits + 7) & ~7L;
+        }
+    }
+
+    /** Makes writer pad every following bit field with zero bits to whole bytes. */
+    public void setByteAligned(boolean byteAligned) {
+        this.byteAligned = byteAligned;
+    }
+
+    /** Writes value clamped to [min, max] as offset from min. */
+    public void writeSignedRange(long value, long min, long max, int bits) {
+        if (value < min) {
+            value = min;
+        } else if (value > max) {
+            value = max;
+        }
+        writeBits(value - min, bits);
+    }
+
+    /** Writes value clamped to [min, max] as offset from min, all three are treated as unsigned. */
+    public void writeUnsignedRange(long value, long min, long max, int bits) {
+        if (value + Long.MIN_VALUE < min + Long.MIN_VALUE) {
+            value = min;
+        } else if (value + Long.MIN_VALUE > max + Long.MIN_VALUE) {
+            value = max;
+        }
+        writeBits(value - min, bits);
+    }
+
+    /** Writes Huffman code of value, value without code is invalid. */
+    public void writeSymbol(long value, HuffmanCode code) {
+        if (value < 0 || value >= code.codes.length) {
+            throw new ShrinkenException("shrinken: " + value + " has no Huffman code");
+        }
+        writeBits(code.codes[(int) value], code.lengths[(int) value]);
+    }
+
+    /** Writes value clamped to [min, max] as Huffman code of offset from min. */
+    public void writeSignedRangeSymbol(long value, long min, long max, HuffmanCode code) {
+        if (value < min) {
+            value = min;
+        } else if (value > max) {
+            value = max;
+        }
+        writeSymbol(value - min, code);
+    }
+
+    /** Writes value clamped to [min, max] as Huffman code of offset from min, all three are treated as unsigned. */
+    public void writeUnsignedRangeSymbol(long value, long min, long max, HuffmanCode code) {
+        if (value + Long.MIN_VALUE < min + Long.MIN_VALUE) {
+            value = min;
+        } else if (value + Long.MIN_VALUE > max + Long.MIN_VALUE) {
+            value = max;
+        }
+        writeSymbol(value - min, code);
+    }
+
+    /**
+     * Writes lowest bits of value in groups of 7 bits, starting from the lowest one, each one as 8 bit field
+     * with highest bit set if another group follows.
+     */
+    public void writeVarint(long value, int bits) {
+        if (bits < 64) {
+            value &= (1L << bits) - 1;
+        }
+        while ((value & ~0x7FL) != 0) {
+            writeBits((value & 0x7F) | 0x80, 8);
+            value >>>= 7;
+        }
+        writeBits(value, 8);
+    }
+
+    /** Maps 0, -1, 1, -2... to 0, 1, 2, 3... and writes it as varint. */
+    public void writeZigZag(long value) {
+        writeVarint((value << 1) ^ (value >> 63), 64);
+    }
+
+    /** Writes value clamped to [min, max] as the nearest of steps equal steps from min. */
+    public void writeQuantized(float value, double min, double max, long steps, int bits) {
+        double v = value;
+        // NaN is written as min
+        if (!(v >= min)) {
+            v = min;
+        } else if (v > max) {
+            v = max;
+        }
+        writeBits((long) ((v - min) * steps / (max - min) + 0.5), bits);
+    }
+
+    /**
+     * Writes 2 bit index of the largest component of normalized quaternion, followed by the other three
+     * components quantized to [min, max], see specification.
+     */
+    public void writeQuaternion(float i, float j, float k, float l, double min, double max, long steps, int bits) {
+        double[] q = {i, j, k, l};
+        double n = Math.sqrt(q[0] * q[0] + q[1] * q[1] + q[2] * q[2] + q[3] * q[3]);
+        if (n > 0 && !Double.isInfinite(n)) {
+            for (int c = 0; c < 4; c++) {
+                q[c] /= n;
+            }
+        }
+        int largest = 0;
+        for (int c = 1; c < 4; c++) {
+            if (Math.abs(q[c]) > Math.abs(q[largest])) {
+                largest = c;
+            }
+        }
+        // q and -q are the same rotation
+        if (q[largest] < 0) {
+            for (int c = 0; c < 4; c++) {
+                q[c] = -q[c];
+            }
+        }
+
+        writeBits(largest, 2);
+        for (int c = 0; c < 4; c++) {
+            if (c != largest) {
+                writeQuantized((float) q[c], min, max, steps, bits);
+            }
+        }
+    }
+
+    /** Writes unit vector as two octahedral coordinates quantized to [-1, 1], see specification. */
+    public void writeNormalized(float x, float y, float z, long steps, int bits) {
+        double[] v = {x, y, z};
+        double n = Math.abs(v[0]) + Math.abs(v[1]) + Math.abs(v[2]);
+        if (n > 0 && !Double.isInfinite(n)) {
+            for (int c = 0; c < 3; c++) {
+                v[c] /= n;
+            }
+        } else {
+            v = new double[] {0, 0, 1};
+        }
+
+        double u = v[0];
+        double t = v[1];
+        if (v[2] < 0) {
+            u = (1 - Math.abs(v[1])) * signNotZero(v[0]);
+            t = (1 - Math.abs(v[0])) * signNotZero(v[1]);
+        }
+        writeQuantized((float) u, -1, 1, steps, bits);
+        writeQuantized((float) t, -1, 1, steps, bits);
+    }
+
+    static double signNotZero(double value) {
+        return value < 0 ? -1 : 1;
+    }
+
+    public void writeBool(boolean value) {
+        writeBits(value ? 1 : 0, 1);
+    }
+
+    public void writeFloat(float value) {
+        writeBits(Float.floatToRawIntBits(value), 32);
+    }
+
+    public void writeDouble(double value) {
+        writeBits(Double.doubleToRawLongBits(value), 64);
+    }
+
+    /** Writes UTF-8 length of value as countBits wide integer, followed by its bytes. */
+    public void writeString(String value, long maxLength, int countBits) {
+        byte[] bytes = value.getBytes(StandardCharsets.UTF_8);
+        writeChars(bytes, maxLength, countBits, 8);
+    }
+
+    /** Writes length of value as countBits wide integer, followed by its characters, 7 bits each. */
+    public void writeAscii(String value, long maxLength, int countBits) {
+        byte[] bytes = new byte[value.length()];
+        for (int i = 0; i < bytes.length; i++) {
+            char c = value.charAt(i);
+            if (c >= 0x80) {
+                throw new ShrinkenException("shrinken: character is not ASCII");
+            }
+            bytes[i] = (byte) c;
+        }
+        writeChars(bytes, maxLength, countBits, 7);
+    }
+
+    /** Writes length of dynamic array as countBits wide integer. */
+    public void writeCount(int count, long maxCount, int countBits) {
+        if (count > maxCount) {
+            throw new ShrinkenException("shrinken: array of " + count + " elements is longer than " + maxCount);
+        }
+        writeBits(count, countBits);
+    }
+
+    public void writeAsciiChar(int value) {
+        if (value < 0 || value >= 0x80) {
+            throw new ShrinkenException("shrinken: character is not ASCII");
+        }
+        writeBits(value, 7);
+    }
+
+    private void writeChars(byte[] bytes, long maxLength, int countBits, int charBits) {
+        if (bytes.length > maxLength) {
+            throw new ShrinkenException("shrinken: string of " + bytes.length + " bytes is longer than " + maxLength);
+        }
+
+        writeBits(bytes.length, countBits);
+        for (byte b : bytes) {
+            writeBits(b, charBits);
+        }
+    }
+
+    /** Throws ShrinkenException if fixed size array doesn't have expected length. */
+    public void checkSize(int length, int size) {
+        if (length != size) {
+            throw new ShrinkenException("shrinken: array has " + length + " elements, expected " + size);
+        }
+    }
+
+    /** Number of written bits. */
+    public long bitLength() {
+        return bits;
+    }
+
+    /** Number of written bytes, including last partially written byte. */
+    public int byteLength() {
+        return (int) ((bits + 7) >>> 3);
+    }
+
+    /** Moves position of buffer after written bytes. */
+    public void finish() {
+        buffer.position(start + byteLength());
+    }
+
+    public byte[] toByteArray() {
+        byte[] bytes = new byte[byteLength()];
+        for (int i = 0; i < bytes.length; i++) {
+            bytes[i] = buffer.get(start + i);
+        }
+        return bytes;
+    }
+
+    private void ensureCapacity(int size) {
+        if (size <= buffer.limit()) {
+            return;
+        }
+
+        if (!growable) {
+            throw new ShrinkenException("shrinken: buffer is too small");
+        }
+
+        byte[] grown = Arrays.copyOf(buffer.array(), Math.max(size, buffer.capacity() * 2));
+        buffer = ByteBuffer.wrap(grown);
+    }
+}
+`
+
+const runtimeReader = `// Code generated by shrinken. DO NOT EDIT.
+
+package shrinken;
+
+import java.nio.ByteBuffer;
+import java.nio.CharBuffer;
+import java.nio.charset.CharacterCodingException;
+import java.nio.charset.CharsetDecoder;
+import java.nio.charset.CodingErrorAction;
+import java.nio.charset.StandardCharsets;
+
+/** Reads values written by BitWriter, from position to limit of buffer. */
+public final class BitReader {
+    private final ByteBuffer buffer;
+    private final int start;
+    private long pos;
+    private boolean byteAligned;
+
+    public BitReader(byte[] data) {
+        this(ByteBuffer.wrap(data));
+    }
+
+    public BitReader(ByteBuffer buffer) {
+        this.buffer = buffer;
+        this.start = buffer.position();
+    }
+
+    /** Number of bits left to read. */
+    public long remaining() {
+        return (long) (buffer.limit() - start) * 8 - pos;
+    }
+
+    /** Reads unsigned value of up to 64 bits. */
+    public long readBits(int bits) {
+        if (bits > remaining()) {
+            throw new ShrinkenException("shrinken: unexpected end of data");
+        }
+
+        long value = 0;
+        int shift = 0;
+        while (bits > 0) {
+            int used = (int) (pos & 7);
+            int n = Math.min(8 - used, bits);
+            int current = buffer.get(start + (int) (pos >>> 3)) & 0xFF;
+            value |= (long) ((current >>> used) & ((1 << n) - 1)) << shift;
+            shift += n;
+            bits -= n;
+            pos += n;
+        }
+
+        if (byteAligned) {
+            pos = (pos + 7) & ~7L;
+        }
+        return value;
+    }
+
+    /** Makes reader skip padding after every following bit field, up to whole bytes. */
+    public void setByteAligned(boolean byteAligned) {
+        this.byteAligned = byteAligned;
+    }
+
+    /** Reads length of dynamic array or string, which has to fit Java array. */
+    public int readLength(int bits) {
+        long n = readBits(bits);
+        if (n > Integer.MAX_VALUE - 8) {
+            throw new ShrinkenException("shrinken: length " + n + " is too large");
+        }
+        return (int) n;
+    }
+
+    public long readSignedRange(long min, long max, int bits) {
+        long offset = readBits(bits);
+        // unsigned comparison, since span of 64 bit range doesn't fit long
+        if (offset + Long.MIN_VALUE > (max - min) + Long.MIN_VALUE) {
+            throw new ShrinkenException("shrinken: value is out of range");
+        }
+        return min + offset;
+    }
+
+    /** Reads value written by writeUnsignedRange, as raw bits of unsigned value. */
+    public long readUnsignedRange(long min, long max, int bits) {
+        return readSignedRange(min, max, bits);
+    }
+
+    /** Reads Huffman code bit by bit, padding of byte aligned reader is skipped only after the whole code. */
+    public int readSymbol(HuffmanCode code) {
+        boolean aligned = byteAligned;
+        byteAligned = false;
+        try {
+            // codes of each length follow all shorter ones, counting up from first
+            int c = 0, first = 0, index = 0;
+            for (int length = 1; length < code.counts.length; length++) {
+                c |= (int) readBits(1);
+                if (c - first < code.counts[length]) {
+                    return code.symbols[index + c - first];
+                }
+                index += code.counts[length];
+                first = (first + code.counts[length]) << 1;
+                c <<= 1;
+            }
+            throw new ShrinkenException("shrinken: incomplete Huffman code");
+        } finally {
+            byteAligned = aligned;
+            if (aligned) {
+                pos = (pos + 7) & ~7L;
+            }
+        }
+    }
+
+    /** Reads value written by range symbol methods, unsigned value as its raw bits. */
+    public long readRangeSymbol(long min, HuffmanCode code) {
+        return min + readSymbol(code);
+    }
+
+    /** Reads value written by writeVarint, as raw bits of unsigned value. */
+    public long readVarint(int bits) {
+        long value = 0;
+        for (int shift = 0; shift < bits; shift += 7) {
+            long group = readBits(8);
+            long data = group & 0x7F;
+            if (shift + 7 > bits && (data >>> (bits - shift)) != 0) {
+                throw new ShrinkenException("shrinken: varint doesn't fit its type");
+            }
+            value |= data << shift;
+
+            if ((group & 0x80) == 0) {
+                return value;
+            }
+        }
+        throw new ShrinkenException("shrinken: varint doesn't fit its type");
+    }
+
+    public long readZigZag(int bits) {
+        long value = readVarint(bits);
+        return (value >>> 1) ^ -(value & 1);
+    }
+
+    /** Reads value written by writeQuantized. */
+    public float readQuantized(double min, double max, long steps, int bits) {
+        long q = readBits(bits);
+        if (q + Long.MIN_VALUE > steps + Long.MIN_VALUE) {
+            throw new ShrinkenException("shrinken: value is out of range");
+        }
+        return (float) (min + (max - min) * q / steps);
+    }
+
+    /** Reads quaternion written by writeQuaternion and returns its four components. */
+    public float[] readQuaternion(double min, double max, long steps, int bits) {
+        int largest = (int) readBits(2);
+        float[] q = new float[4];
+        double sum = 0;
+        for (int c = 0; c < 4; c++) {
+            if (c != largest) {
+                q[c] = readQuantized(min, max, steps, bits);
+                sum += (double) q[c] * q[c];
+            }
+        }
+        q[largest] = (float) Math.sqrt(Math.max(0, 1 - sum));
+        return q;
+    }
+
+    /** Reads vector written by writeNormalized and returns its three components with unit length. */
+    public float[] readNormalized(long steps, int bits) {
+        double u = readQuantized(-1, 1, steps, bits);
+        double t = readQuantized(-1, 1, steps, bits);
+        double l = 1 - Math.abs(u) - Math.abs(t);
+        if (l < 0) {
+            double folded = (1 - Math.abs(t)) * BitWriter.signNotZero(u);
+            t = (1 - Math.abs(u)) * BitWriter.signNotZero(t);
+            u = folded;
+        }
+        double n = Math.sqrt(u * u + t * t + l * l);
+        return new float[] {(float) (u / n), (float) (t / n), (float) (l / n)};
+    }
+
+    public boolean readBool() {
+        return readBits(1) == 1;
+    }
+
+    public float readFloat() {
+        return Float.intBitsToFloat((int) readBits(32));
+    }
+
+    /**
+     * Reads length of dynamic array, larger than maxCount is invalid. Length larger than remaining data could
+     * hold, with elements of at least minBits, is unexpected end.
+     */
+    public int readCount(long maxCount, int countBits, long minBits) {
+        int n = readLength(countBits);
+        if (n > maxCount) {
+            throw new ShrinkenException("shrinken: array of " + n + " elements is longer than " + maxCount);
+        }
+        if (minBits > 0 && n > remaining() / minBits) {
+            throw new ShrinkenException("shrinken: unexpected end of data");
+        }
+        return n;
+    }
+
+    public double readDouble() {
+        return Double.longBitsToDouble(readBits(64));
+    }
+
+    /** Reads string written by writeString, longer strings are invalid. */
+    public String readString(long maxLength, int countBits) {
+        byte[] bytes = readChars(maxLength, countBits, 8);
+
+        CharsetDecoder decoder = StandardCharsets.UTF_8.newDecoder()
+                .onMalformedInput(CodingErrorAction.REPORT)
+                .onUnmappableCharacter(CodingErrorAction.REPORT);
+        try {
+            CharBuffer chars = decoder.decode(ByteBuffer.wrap(bytes));
+            return chars.toString();
+        } catch (CharacterCodingException e) {
+            throw new ShrinkenException("shrinken: invalid UTF-8 string");
+        }
+    }
+
+    /** Reads string written by writeAscii, longer strings are invalid. */
+    public String readAscii(long maxLength, int countBits) {
+        return new String(readChars(maxLength, countBits, 7), StandardCharsets.US_ASCII);
+    }
+
+    public int readAsciiChar() {
+        return (int) readBits(7);
+    }
+
+    private byte[] readChars(long maxLength, int countBits, int charBits) {
+        int n = readLength(countBits);
+        if (n > maxLength) {
+            throw new ShrinkenException("shrinken: string of " + n + " bytes is longer than " + maxLength);
+        }
+        if ((long) n * charBits > remaining()) {
+            throw new ShrinkenException("shrinken: unexpected end of data");
+        }
+
+        byte[] bytes = new byte[n];
+        for (int i = 0; i < n; i++) {
+            bytes[i] = (byte) readBits(charBits);
+        }
+        return bytes;
+    }
+
+    /** Reads unicode code point. */
+    public int readChar() {
+        int value = (int) readBits(32);
+        if (!Character.isValidCodePoint(value)) {
+            throw new ShrinkenException("shrinken: invalid code point " + (value & 0xFFFFFFFFL));
+        }
+        return value;
+    }
+
+    /** Moves position of buffer after read bytes. */
+    public void finish() {
+        buffer.position(start + (int) ((pos + 7) >>> 3));
+    }
+}
+`
@@ -0,0 +1,53 @@
+package gen
+
+import (
+	"unicode"
+)
+
+// helpers for converting SDDL names to naming conventions of target languages
+
+// UpperFirst returns name with first letter in upper case
+func UpperFirst(name string) string {
+	if name == "" {
+		return name
+	}
+
+	runes := []rune(name)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+// LowerFirst returns name with first letter in lower case
+func LowerFirst(name string) string {
+	if name == "" {
+		return name
+	}
+
+	runes := []rune(name)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+// SnakeCase converts camelCase or PascalCase name to snake_case
+func SnakeCase(name string) string {
+	runes := []rune(name)
+	out := make([]rune, 0, len(runes)+4)
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// new word starts at upper case letter which follows lower case letter or digit,
+			// or at last upper case letter of acronym which is followed by lower case letter
+			if i > 0 && runes[i-1] != '_' &&
+				(unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+					i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1])) {
+
+				out = append(out, '_')
+			}
+			out = append(out, unicode.ToLower(r))
+		} else {
+			out = append(out, r)
+		}
+	}
+
+	return string(out)
+}
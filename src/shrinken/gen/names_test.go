@@ -0,0 +1,23 @@
+package gen
+
+import "testing"
+
+func TestSnakeCase(t *testing.T) {
+	cases := map[string]string{
+		"position":     "position",
+		"maxHealth":    "max_health",
+		"PlayerState":  "player_state",
+		"HTTPServer":   "http_server",
+		"myHTTPServer": "my_http_server",
+		"vector3":      "vector3",
+		"Vector3Value": "vector3_value",
+		"snake_case":   "snake_case",
+		"ID":           "id",
+	}
+
+	for name, expected := range cases {
+		if result := SnakeCase(name); result != expected {
+			t.Errorf("SnakeCase(%q) returned %q, expected %q", name, result, expected)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package gen
+
+// helpers for backends of languages which need types declared before they're used
+
+// DeclarationOrder returns structs of package ordered so that each struct comes after its base struct
+// and after structs it contains by value. Class references don't need ordering, as they're pointers
+// in all languages that care about declaration order.
+func (pkg *Package) DeclarationOrder() []*Struct {
+	ordered := make([]*Struct, 0, len(pkg.Structs))
+	visited := make(map[*Struct]bool)
+
+	var visit func(s *Struct)
+	visit = func(s *Struct) {
+		if visited[s] || s.Package != pkg {
+			return
+		}
+		visited[s] = true
+
+		if s.Base != nil {
+			visit(s.Base)
+		}
+		for _, field := range s.Fields {
+			if dep := valueStruct(field.Codec); dep != nil {
+				visit(dep)
+			}
+		}
+
+		ordered = append(ordered, s)
+	}
+
+	for _, s := range pkg.Structs {
+		visit(s)
+	}
+
+	return ordered
+}
+
+// Dependencies returns other packages whose types are used by types of this package
+func (pkg *Package) Dependencies() []*Package {
+	deps := make([]*Package, 0)
+	seen := make(map[*Package]bool)
+
+	add := func(other *Package) {
+		if other != pkg && !seen[other] {
+			seen[other] = true
+			deps = append(deps, other)
+		}
+	}
+
+	for _, s := range pkg.Structs {
+		if s.Base != nil {
+			add(s.Base.Package)
+		}
+		for _, field := range s.Fields {
+			c := field.Codec
+			for c.Kind == ArrayCodec {
+				c = c.Elem
+			}
+			if c.Struct != nil {
+				add(c.Struct.Package)
+			}
+			if c.Enum != nil {
+				add(c.Enum.Package)
+			}
+		}
+	}
+
+	return deps
+}
+
+// DependencyCycle returns packages forming circular dependency, or nil if there is none
+func (schema *Schema) DependencyCycle() []*Package {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[*Package]int)
+	stack := make([]*Package, 0)
+
+	var visit func(pkg *Package) []*Package
+	visit = func(pkg *Package) []*Package {
+		switch state[pkg] {
+		case visiting:
+			for i, p := range stack {
+				if p == pkg {
+					return append([]*Package{}, stack[i:]...)
+				}
+			}
+		case done:
+			return nil
+		}
+
+		state[pkg] = visiting
+		stack = append(stack, pkg)
+		for _, dep := range pkg.Dependencies() {
+			if cycle := visit(dep); cycle != nil {
+				return cycle
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[pkg] = done
+
+		return nil
+	}
+
+	for _, pkg := range schema.Packages {
+		if cycle := visit(pkg); cycle != nil {
+			return cycle
+		}
+	}
+
+	return nil
+}
+
+// valueStruct returns struct which is contained by value in codec, if any
+func valueStruct(c *Codec) *Struct {
+	for c.Kind == ArrayCodec {
+		c = c.Elem
+	}
+
+	if c.Kind == StructCodec && !c.Struct.IsClass {
+		return c.Struct
+	}
+	return nil
+}
@@ -0,0 +1,717 @@
+package python
+
+import (
+	"fmt"
+	"path/filepath"
+	"shrinken/gen"
+	"shrinken/sddl"
+	"shrinken/sddl/ast"
+	"strings"
+)
+
+// Python backend generates Python package with one module per SDDL package. Structs and classes
+// become dataclasses (derived classes extend their base dataclass) with encode and decode methods,
+// and enums become IntEnums. Types marked with message attribute also get to_bytes and from_bytes
+// methods, or module level <enum>_to_bytes and <enum>_from_bytes functions for enums, since IntEnum
+// already inherits to_bytes and from_bytes from int. Messages marked with delta attribute also get
+// to_delta_bytes and apply_delta_bytes, which encode only fields changed against baseline. Messages are also
+// written with their IDs by to_any_bytes, which decode_any of their module dispatches to methods of
+// MessageHandler. Layout of values is taken from schema codecs,
+// same as in all other backends, so encoded data is interchangeable. Classes with base or derived classes
+// are registered in runtime by their qualified names, so that references to base classes can be decoded
+// as classes derived in modules which import the base one. Generated code requires Python 3.7.
+
+type generator struct{}
+
+func init() {
+	gen.Register("python", &generator{})
+}
+
+func (g *generator) Generate(parsed *sddl.SDDLTree, outputPath string) error {
+	schema, err := gen.NewSchema(parsed)
+	if err != nil {
+		return err
+	}
+
+	// base classes have to be defined when module is imported, so modules can't import each other
+	if cycle := schema.DependencyCycle(); cycle != nil {
+		names := make([]string, len(cycle))
+		for i, pkg := range cycle {
+			names[i] = pkg.Name
+		}
+		return fmt.Errorf("Python backend doesn't support circular dependency between packages (%v)", strings.Join(names, ", "))
+	}
+
+	err = gen.WriteFile(filepath.Join(outputPath, "shrinken.py"), []byte(runtime))
+	if err != nil {
+		return err
+	}
+
+	err = gen.WriteFile(filepath.Join(outputPath, "__init__.py"), []byte("# Code generated by shrinken. DO NOT EDIT.\n"))
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range schema.Packages {
+		f := &packageFile{
+			schema: schema,
+			pkg:    pkg,
+		}
+
+		content, err := f.generate()
+		if err != nil {
+			return err
+		}
+
+		err = gen.WriteFile(filepath.Join(outputPath, moduleName(pkg)+".py"), content)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type packageFile struct {
+	schema  *gen.Schema
+	pkg     *gen.Package
+	huffman gen.HuffmanTables
+	err     error
+}
+
+func (f *packageFile) generate() ([]byte, error) {
+	w := gen.NewCodeWriter("    ")
+	w.Line("# Code generated by shrinken. DO NOT EDIT.")
+	w.Line("")
+	w.Line("from __future__ import annotations")
+	w.Line("")
+	w.Line("import dataclasses")
+	w.Line("import enum")
+	w.Line("from typing import List, Optional")
+	w.Line("")
+	w.Line("from . import shrinken")
+	for _, dep := range f.pkg.Dependencies() {
+		w.Line("from . import %v", moduleName(dep))
+	}
+
+	for _, e := range f.pkg.Enums {
+		f.writeEnum(w, e)
+	}
+
+	for _, s := range f.pkg.DeclarationOrder() {
+		f.writeStruct(w, s)
+	}
+
+	if messages := f.pkg.Messages(); len(messages) > 0 {
+		f.writeDispatcher(w, messages)
+	}
+
+	// codes are looked up when functions using them are called, so they can follow them
+	if len(f.huffman.Tables) > 0 {
+		w.Line("")
+	}
+	for i, code := range f.huffman.Tables {
+		w.Line("")
+		w.Line("# Huffman code of %v", code.Name)
+		w.Line("_huffman%v = shrinken.HuffmanCode(", i)
+		w.Indent()
+		w.Line("(%v),", gen.JoinInts(code.Codes))
+		w.Line("(%v),", gen.JoinInts(code.Lengths))
+		w.Line("(%v),", gen.JoinInts(code.Counts))
+		w.Line("(%v),", gen.JoinInts(code.Symbols))
+		w.Dedent()
+		w.Line(")")
+	}
+
+	return w.Bytes(), f.err
+}
+
+func (f *packageFile) writeEnum(w *gen.CodeWriter, e *gen.Enum) {
+	name := typeName(e.ExportedName)
+
+	w.Line("")
+	w.Line("")
+	w.Line("class %v(enum.IntEnum):", name)
+	w.Indent()
+	for i, value := range e.Values {
+		w.Line("%v = %v", identifier(value), i)
+	}
+	w.Dedent()
+
+	if e.IsMessage {
+		prefix := gen.SnakeCase(gen.UpperFirst(e.ExportedName))
+
+		w.Line("")
+		w.Line("")
+		w.Line("def %v_to_bytes(value: %v) -> bytes:", prefix, name)
+		w.Indent()
+		w.Line("w = %v", bitWriter(e.ByteAligned))
+		f.encode(w, e.Codec(), "value", 0)
+		w.Line("return w.to_bytes()")
+		w.Dedent()
+
+		w.Line("")
+		w.Line("")
+		w.Line("def %v_from_bytes(data: bytes) -> %v:", prefix, name)
+		w.Indent()
+		w.Line("r = %v", bitReader(e.ByteAligned))
+		w.Line("return %v", f.decodeExpr(e.Codec()))
+		w.Dedent()
+
+		w.Line("")
+		w.Line("")
+		w.Line("%v_MESSAGE_ID = %v", strings.ToUpper(prefix), e.Id)
+		w.Line("")
+		w.Line("")
+		w.Line("def %v_to_any_bytes(value: %v) -> bytes:", prefix, name)
+		w.Indent()
+		w.Line("return shrinken.write_message_id(%v_MESSAGE_ID) + %v_to_bytes(value)", strings.ToUpper(prefix), prefix)
+		w.Dedent()
+	}
+}
+
+func (f *packageFile) writeStruct(w *gen.CodeWriter, s *gen.Struct) {
+	name := typeName(s.ExportedName)
+
+	w.Line("")
+	w.Line("")
+	if inHierarchy(s) {
+		w.Line("@shrinken.register_class(%q)", s.QualifiedName())
+	}
+	w.Line("@dataclasses.dataclass")
+	if s.Base != nil {
+		w.Line("class %v(%v):", name, f.typeName(s.Base.Package, typeName(s.Base.ExportedName)))
+	} else {
+		w.Line("class %v:", name)
+	}
+	w.Indent()
+
+	for _, field := range s.Fields {
+		w.Line("%v: %v = %v", fieldName(field.ExportedName), f.pythonType(field.Codec), f.defaultValue(field.Codec))
+	}
+	if len(s.Fields) > 0 {
+		w.Line("")
+	}
+
+	w.Line("def encode(self, w: shrinken.BitWriter) -> None:")
+	w.Indent()
+	if s.Base != nil {
+		w.Line("super().encode(w)")
+	}
+	if c := s.Quaternion; c != nil {
+		q := c.Quantization
+		w.Line("w.write_quaternion((%v), %v, %v, %v, %v)", componentFields(s), q.MinString(), q.MaxString(), q.Steps, c.Bits)
+	} else if c := s.Normalized; c != nil {
+		w.Line("w.write_normalized((%v), %v, %v)", componentFields(s), c.Quantization.Steps, c.Bits)
+	} else {
+		for _, field := range s.Fields {
+			f.encode(w, field.Codec, "self."+fieldName(field.ExportedName), 0)
+		}
+	}
+	if s.Base == nil && len(s.Fields) == 0 {
+		w.Line("pass")
+	}
+	w.Dedent()
+	w.Line("")
+
+	// decode fills existing object, so that derived classes can reuse decode method of base class
+
+	w.Line("def decode(self, r: shrinken.BitReader) -> %v:", name)
+	w.Indent()
+	if s.Base != nil {
+		w.Line("super().decode(r)")
+	}
+	if c := s.Quaternion; c != nil {
+		q := c.Quantization
+		w.Line("%v = r.read_quaternion(%v, %v, %v, %v)", componentFields(s), q.MinString(), q.MaxString(), q.Steps, c.Bits)
+	} else if c := s.Normalized; c != nil {
+		w.Line("%v = r.read_normalized(%v, %v)", componentFields(s), c.Quantization.Steps, c.Bits)
+	} else {
+		for _, field := range s.Fields {
+			w.Line("self.%v = %v", fieldName(field.ExportedName), f.decodeExpr(field.Codec))
+		}
+	}
+	w.Line("return self")
+	w.Dedent()
+
+	if s.IsMessage {
+		w.Line("")
+		w.Line("MESSAGE_ID = %v", s.Id)
+		w.Line("")
+		w.Line("def to_bytes(self) -> bytes:")
+		w.Indent()
+		w.Line("w = %v", bitWriter(s.ByteAligned))
+		w.Line("self.encode(w)")
+		w.Line("return w.to_bytes()")
+		w.Dedent()
+		w.Line("")
+		w.Line("@classmethod")
+		w.Line("def from_bytes(cls, data: bytes) -> %v:", name)
+		w.Indent()
+		w.Line("return cls().decode(%v)", bitReader(s.ByteAligned))
+		w.Dedent()
+		w.Line("")
+		w.Line("def to_any_bytes(self) -> bytes:")
+		w.Indent()
+		w.Line("return shrinken.write_message_id(self.MESSAGE_ID) + self.to_bytes()")
+		w.Dedent()
+	}
+
+	if s.HasDelta {
+		f.writeDeltaMethods(w, s)
+	}
+
+	w.Dedent()
+
+	if s.IsClass && len(s.Derived) > 0 {
+		names := make([]string, 0)
+		for _, subtype := range s.Subtypes() {
+			names = append(names, fmt.Sprintf("%q", subtype.QualifiedName()))
+		}
+
+		w.Line("")
+		w.Line("")
+		w.Line("# classes which reference to %v can hold, indexed by subtype tag", name)
+		w.Line("%v = (%v)", subtypesName(s), strings.Join(names, ", "))
+	}
+}
+
+// writeDispatcher writes MessageHandler with method for each message of package and decode_any, which calls
+// it with decoded message
+func (f *packageFile) writeDispatcher(w *gen.CodeWriter, messages []*gen.Message) {
+	w.Line("")
+	w.Line("")
+	w.Line("class MessageHandler:")
+	w.Indent()
+	w.Line("\"\"\"Handles messages decoded by decode_any.\"\"\"")
+	for _, m := range messages {
+		w.Line("")
+		w.Line("def handle_%v(self, message: %v) -> None:", gen.SnakeCase(gen.UpperFirst(m.ExportedName)), typeName(m.ExportedName))
+		w.Indent()
+		w.Line("raise NotImplementedError")
+		w.Dedent()
+	}
+	w.Dedent()
+
+	w.Line("")
+	w.Line("")
+	w.Line("def decode_any(data: bytes, handler: MessageHandler) -> None:")
+	w.Indent()
+	w.Line("\"\"\"Decodes message written by to_any_bytes and passes it to handler, ID of message of another package")
+	w.Line("raises ShrinkenError.\"\"\"")
+	w.Line("message_id, data = shrinken.read_message_id(data)")
+	for i, m := range messages {
+		keyword := "elif"
+		if i == 0 {
+			keyword = "if"
+		}
+		name := typeName(m.ExportedName)
+		handle := "handler.handle_" + gen.SnakeCase(gen.UpperFirst(m.ExportedName))
+		if m.Struct != nil {
+			w.Line("%v message_id == %v.MESSAGE_ID:", keyword, name)
+			w.Indent()
+			w.Line("%v(%v.from_bytes(data))", handle, name)
+		} else {
+			prefix := gen.SnakeCase(gen.UpperFirst(m.ExportedName))
+			w.Line("%v message_id == %v_MESSAGE_ID:", keyword, strings.ToUpper(prefix))
+			w.Indent()
+			w.Line("%v(%v_from_bytes(data))", handle, prefix)
+		}
+		w.Dedent()
+	}
+	w.Line("else:")
+	w.Indent()
+	w.Line("raise shrinken.ShrinkenError(\"shrinken: unknown message %d\" % message_id)")
+	w.Dedent()
+	w.Dedent()
+}
+
+// bitWriter returns expression creating writer of message with given alignment
+func bitWriter(byteAligned bool) string {
+	if byteAligned {
+		return "shrinken.BitWriter(byte_aligned=True)"
+	}
+	return "shrinken.BitWriter()"
+}
+
+// bitReader returns expression creating reader of data holding message with given alignment
+func bitReader(byteAligned bool) string {
+	if byteAligned {
+		return "shrinken.BitReader(data, byte_aligned=True)"
+	}
+	return "shrinken.BitReader(data)"
+}
+
+// componentFields returns comma separated components of quaternion or normalized vector struct
+func componentFields(s *gen.Struct) string {
+	names := make([]string, len(s.Fields))
+	for i, field := range s.Fields {
+		names[i] = "self." + fieldName(field.ExportedName)
+	}
+	return strings.Join(names, ", ")
+}
+
+// writeDeltaMethods writes methods comparing object with baseline and encoding it as delta against it
+func (f *packageFile) writeDeltaMethods(w *gen.CodeWriter, s *gen.Struct) {
+	name := typeName(s.ExportedName)
+	fields := s.AllFields()
+
+	w.Line("")
+	w.Line("def changed_fields(self, baseline: %v) -> List[bool]:", name)
+	w.Indent()
+	if len(fields) == 0 {
+		w.Line("return []")
+	} else {
+		w.Line("return [")
+		w.Indent()
+		for _, field := range fields {
+			name := fieldName(field.ExportedName)
+			w.Line("%v,", f.changed(field.Codec, "self."+name, "baseline."+name, 0))
+		}
+		w.Dedent()
+		w.Line("]")
+	}
+	w.Dedent()
+	w.Line("")
+
+	w.Line("def differs(self, baseline: %v) -> bool:", name)
+	w.Indent()
+	w.Line("return any(self.changed_fields(baseline))")
+	w.Dedent()
+	w.Line("")
+
+	w.Line("def encode_delta(self, w: shrinken.BitWriter, baseline: %v) -> None:", name)
+	w.Indent()
+	w.Line("changed = self.changed_fields(baseline)")
+	w.Line("for c in changed:")
+	w.Indent()
+	w.Line("w.write_bool(c)")
+	w.Dedent()
+	for i, field := range fields {
+		name := fieldName(field.ExportedName)
+		w.Line("if changed[%v]:", i)
+		w.Indent()
+		if c := field.Codec; c.IsDelta() && c.Struct.IsClass {
+			typ := f.typeName(c.Struct.Package, typeName(c.Struct.ExportedName))
+			w.Line("(self.%v if self.%v is not None else %v()).encode_delta(", name, name, typ)
+			w.Indent()
+			w.Line("w, baseline.%v if baseline.%v is not None else %v())", name, name, typ)
+			w.Dedent()
+		} else if c.IsDelta() {
+			w.Line("self.%v.encode_delta(w, baseline.%v)", name, name)
+		} else {
+			f.encode(w, c, "self."+name, 0)
+		}
+		w.Dedent()
+	}
+	w.Dedent()
+	w.Line("")
+
+	// decode_delta replaces changed fields of object holding baseline
+	w.Line("def decode_delta(self, r: shrinken.BitReader) -> %v:", name)
+	w.Indent()
+	w.Line("changed = [r.read_bool() for _ in range(%v)]", len(fields))
+	for i, field := range fields {
+		name := fieldName(field.ExportedName)
+		w.Line("if changed[%v]:", i)
+		w.Indent()
+		if c := field.Codec; c.IsDelta() && c.Struct.IsClass {
+			w.Line("if self.%v is None:", name)
+			w.Indent()
+			w.Line("self.%v = %v()", name, f.typeName(c.Struct.Package, typeName(c.Struct.ExportedName)))
+			w.Dedent()
+			w.Line("self.%v.decode_delta(r)", name)
+		} else if c.IsDelta() {
+			w.Line("self.%v.decode_delta(r)", name)
+		} else {
+			w.Line("self.%v = %v", name, f.decodeExpr(c))
+		}
+		w.Dedent()
+	}
+	w.Line("return self")
+	w.Dedent()
+
+	if s.IsDelta {
+		w.Line("")
+		w.Line("def to_delta_bytes(self, baseline: %v) -> bytes:", name)
+		w.Indent()
+		w.Line("w = %v", bitWriter(s.ByteAligned))
+		w.Line("self.encode_delta(w, baseline)")
+		w.Line("return w.to_bytes()")
+		w.Dedent()
+		w.Line("")
+		w.Line("# apply_delta_bytes applies delta to this object, which has to hold baseline the delta was written against")
+		w.Line("def apply_delta_bytes(self, data: bytes) -> %v:", name)
+		w.Indent()
+		w.Line("return self.decode_delta(%v)", bitReader(s.ByteAligned))
+		w.Dedent()
+	}
+}
+
+// changed returns expression telling whether value a is changed against b, as defined for delta
+// encoding. References to polymorphic classes are always changed. Elements of arrays are compared
+// one by one, since list equality treats identical NaN as equal.
+func (f *packageFile) changed(c *gen.Codec, a, b string, depth int) string {
+	switch c.Kind {
+	case gen.StructCodec:
+		if c.IsPolymorphic() {
+			return "True"
+		}
+		if c.Struct.IsClass {
+			typ := f.typeName(c.Struct.Package, typeName(c.Struct.ExportedName))
+			return fmt.Sprintf("(%v if %v is not None else %v()).differs(%v if %v is not None else %v())", a, a, typ, b, b, typ)
+		}
+		return fmt.Sprintf("%v.differs(%v)", a, b)
+	case gen.ArrayCodec:
+		x, y := fmt.Sprintf("x%v", depth), fmt.Sprintf("y%v", depth)
+		return fmt.Sprintf("len(%v) != len(%v) or any(%v for %v, %v in zip(%v, %v))", a, b, f.changed(c.Elem, x, y, depth+1), x, y, a, b)
+	}
+	return fmt.Sprintf("%v != %v", a, b)
+}
+
+func (f *packageFile) encode(w *gen.CodeWriter, c *gen.Codec, expr string, depth int) {
+	switch c.Kind {
+	case gen.BoolCodec:
+		w.Line("w.write_bool(%v)", expr)
+	case gen.IntCodec:
+		if c.Huffman != nil {
+			w.Line("w.write_range_symbol(%v, %v, %v, _huffman%v)", expr, c.MinString(), c.MaxString(), f.huffman.Index(c.Huffman))
+		} else if c.Range != nil {
+			w.Line("w.write_range(%v, %v, %v, %v)", expr, c.MinString(), c.MaxString(), c.Bits)
+		} else if c.Encoding == gen.VarintEncoding {
+			w.Line("w.write_varint(%v, %v)", expr, c.Bits)
+		} else if c.Encoding == gen.ZigZagEncoding {
+			w.Line("w.write_zigzag(%v)", expr)
+		} else {
+			w.Line("w.write_bits(%v, %v)", expr, c.Bits)
+		}
+	case gen.EnumCodec:
+		if c.Huffman != nil {
+			w.Line("w.write_symbol(int(%v), _huffman%v)", expr, f.huffman.Index(c.Huffman))
+		} else {
+			w.Line("w.write_bits(int(%v), %v)", expr, c.Bits)
+		}
+	case gen.CharCodec:
+		if c.IsASCII() {
+			w.Line("w.write_ascii_char(%v)", expr)
+		} else {
+			w.Line("w.write_char(%v)", expr)
+		}
+	case gen.FloatCodec:
+		if q := c.Quantization; q != nil {
+			w.Line("w.write_quantized(%v, %v, %v, %v, %v)", expr, q.MinString(), q.MaxString(), q.Steps, c.Bits)
+		} else {
+			w.Line("w.write_float%v(%v)", c.Bits, expr)
+		}
+	case gen.StringCodec:
+		if c.IsASCII() {
+			w.Line("w.write_ascii(%v, %v, %v)", expr, c.MaxLength, c.CountBits)
+		} else {
+			w.Line("w.write_string(%v, %v, %v)", expr, c.MaxLength, c.CountBits)
+		}
+	case gen.StructCodec:
+		if c.IsPolymorphic() {
+			name := f.typeName(c.Struct.Package, typeName(c.Struct.ExportedName))
+			subtypes := f.typeName(c.Struct.Package, subtypesName(c.Struct))
+			w.Line("w.write_class(%v if %v is not None else %v(), %v, %v)", expr, expr, name, subtypes, c.Bits)
+		} else if c.Struct.IsClass {
+			// None is encoded as default instance
+			w.Line("(%v if %v is not None else %v()).encode(w)", expr, expr, f.typeName(c.Struct.Package, typeName(c.Struct.ExportedName)))
+		} else {
+			w.Line("%v.encode(w)", expr)
+		}
+	case gen.ArrayCodec:
+		v := fmt.Sprintf("v%v", depth)
+		if c.IsDynamic() {
+			w.Line("w.write_count(len(%v), %v, %v)", expr, c.MaxCount, c.CountBits)
+		} else {
+			w.Line("shrinken.check_size(%v, %v)", expr, c.Size)
+		}
+		w.Line("for %v in %v:", v, expr)
+		w.Indent()
+		f.encode(w, c.Elem, v, depth+1)
+		w.Dedent()
+	}
+}
+
+func (f *packageFile) decodeExpr(c *gen.Codec) string {
+	switch c.Kind {
+	case gen.BoolCodec:
+		return "r.read_bool()"
+	case gen.IntCodec:
+		if c.Huffman != nil {
+			return fmt.Sprintf("r.read_range_symbol(%v, _huffman%v)", c.MinString(), f.huffman.Index(c.Huffman))
+		}
+		if c.Range != nil {
+			return fmt.Sprintf("r.read_range(%v, %v, %v)", c.MinString(), c.MaxString(), c.Bits)
+		}
+		switch {
+		case c.Encoding == gen.VarintEncoding && c.Signed:
+			return fmt.Sprintf("r.read_signed_varint(%v)", c.Bits)
+		case c.Encoding == gen.VarintEncoding:
+			return fmt.Sprintf("r.read_varint(%v)", c.Bits)
+		case c.Encoding == gen.ZigZagEncoding:
+			return fmt.Sprintf("r.read_zigzag(%v)", c.Bits)
+		}
+		if c.Signed {
+			return fmt.Sprintf("r.read_signed(%v)", c.Bits)
+		}
+		return fmt.Sprintf("r.read_bits(%v)", c.Bits)
+	case gen.EnumCodec:
+		if c.Huffman != nil {
+			return fmt.Sprintf("%v(r.read_symbol(_huffman%v))", f.pythonType(c), f.huffman.Index(c.Huffman))
+		}
+		return fmt.Sprintf("r.read_enum(%v, %v)", f.pythonType(c), c.Bits)
+	case gen.CharCodec:
+		if c.IsASCII() {
+			return "r.read_ascii_char()"
+		}
+		return "r.read_char()"
+	case gen.FloatCodec:
+		if q := c.Quantization; q != nil {
+			return fmt.Sprintf("r.read_quantized(%v, %v, %v, %v)", q.MinString(), q.MaxString(), q.Steps, c.Bits)
+		}
+		return fmt.Sprintf("r.read_float%v()", c.Bits)
+	case gen.StringCodec:
+		if c.IsASCII() {
+			return fmt.Sprintf("r.read_ascii(%v, %v)", c.MaxLength, c.CountBits)
+		}
+		return fmt.Sprintf("r.read_string(%v, %v)", c.MaxLength, c.CountBits)
+	case gen.StructCodec:
+		if c.IsPolymorphic() {
+			return fmt.Sprintf("r.read_class(%v, %v)", f.typeName(c.Struct.Package, subtypesName(c.Struct)), c.Bits)
+		}
+		return fmt.Sprintf("%v().decode(r)", f.typeName(c.Struct.Package, typeName(c.Struct.ExportedName)))
+	case gen.ArrayCodec:
+		// comprehension evaluates range first, so length is read before elements
+		if c.IsDynamic() {
+			return fmt.Sprintf("[%v for _ in range(r.read_count(%v, %v))]", f.decodeExpr(c.Elem), c.MaxCount, c.CountBits)
+		}
+		return fmt.Sprintf("[%v for _ in range(%v)]", f.decodeExpr(c.Elem), c.Size)
+	}
+	return ""
+}
+
+func (f *packageFile) pythonType(c *gen.Codec) string {
+	switch c.Kind {
+	case gen.ArrayCodec:
+		return fmt.Sprintf("List[%v]", f.pythonType(c.Elem))
+	case gen.StructCodec:
+		name := f.typeName(c.Struct.Package, typeName(c.Struct.ExportedName))
+		if c.Struct.IsClass {
+			return fmt.Sprintf("Optional[%v]", name)
+		}
+		return name
+	case gen.EnumCodec:
+		return f.typeName(c.Enum.Package, typeName(c.Enum.ExportedName))
+	}
+
+	switch c.Type.GenericType {
+	case ast.Integer32, ast.Integer64, ast.Short, ast.UnsignedInteger32, ast.UnsignedInteger64, ast.UnsignedShort, ast.Byte:
+		return "int"
+	case ast.Float, ast.Double:
+		return "float"
+	case ast.Bool:
+		return "bool"
+	case ast.String, ast.Char:
+		return "str"
+	}
+
+	f.err = fmt.Errorf("Python backend doesn't support type %v", c.Type.GenericType.String())
+	return ""
+}
+
+// defaultValue returns dataclass field default, mutable values have to be created by factory
+func (f *packageFile) defaultValue(c *gen.Codec) string {
+	switch c.Kind {
+	case gen.ArrayCodec:
+		return fmt.Sprintf("dataclasses.field(default_factory=lambda: %v)", f.zeroValue(c))
+	case gen.StructCodec:
+		if c.Struct.IsClass {
+			return "None"
+		}
+		return fmt.Sprintf("dataclasses.field(default_factory=lambda: %v)", f.zeroValue(c))
+	}
+	return f.zeroValue(c)
+}
+
+func (f *packageFile) zeroValue(c *gen.Codec) string {
+	switch c.Kind {
+	case gen.ArrayCodec:
+		if c.IsDynamic() {
+			return "[]"
+		}
+		return fmt.Sprintf("[%v for _ in range(%v)]", f.zeroValue(c.Elem), c.Size)
+	case gen.StructCodec:
+		if c.Struct.IsClass {
+			return "None"
+		}
+		return f.typeName(c.Struct.Package, typeName(c.Struct.ExportedName)) + "()"
+	case gen.EnumCodec:
+		return f.pythonType(c) + "." + identifier(c.Enum.Values[0])
+	case gen.BoolCodec:
+		return "False"
+	case gen.StringCodec:
+		return "\"\""
+	case gen.CharCodec:
+		return "\"\\0\""
+	case gen.FloatCodec:
+		return "0.0"
+	}
+	return "0"
+}
+
+// typeName returns name qualified by module name if it's declared in other package
+func (f *packageFile) typeName(pkg *gen.Package, name string) string {
+	if pkg == f.pkg {
+		return name
+	}
+	return moduleName(pkg) + "." + name
+}
+
+func moduleName(pkg *gen.Package) string {
+	name := strings.ToLower(strings.Replace(pkg.ExportedName, ".", "_", -1))
+	if name == "shrinken" {
+		return name + "_"
+	}
+	return identifier(name)
+}
+
+func subtypesName(s *gen.Struct) string {
+	return strings.ToUpper(gen.SnakeCase(gen.UpperFirst(s.ExportedName))) + "_SUBTYPES"
+}
+
+// inHierarchy reports whether class has base or derived classes, so it's registered
+func inHierarchy(s *gen.Struct) bool {
+	return s.IsClass && len(s.Root().Derived) > 0
+}
+
+func typeName(name string) string {
+	return identifier(gen.UpperFirst(name))
+}
+
+func fieldName(name string) string {
+	return identifier(gen.SnakeCase(name))
+}
+
+// identifier appends underscore to names which are reserved in Python, or which would shadow
+// modules and methods used by generated code
+func identifier(name string) string {
+	if keywords[name] {
+		return name + "_"
+	}
+	return name
+}
+
+var keywords = map[string]bool{
+	"False": true, "None": true, "True": true, "and": true, "as": true, "assert": true,
+	"async": true, "await": true, "break": true, "class": true, "continue": true, "def": true,
+	"del": true, "elif": true, "else": true, "except": true, "finally": true, "for": true,
+	"from": true, "global": true, "if": true, "import": true, "in": true, "is": true,
+	"lambda": true, "nonlocal": true, "not": true, "or": true, "pass": true, "raise": true,
+	"return": true, "try": true, "while": true, "with": true, "yield": true,
+	"encode": true, "decode": true, "to_bytes": true, "from_bytes": true,
+	"changed_fields": true, "differs": true, "encode_delta": true, "decode_delta": true,
+	"to_delta_bytes": true, "apply_delta_bytes": true,
+	"dataclasses": true, "enum": true, "shrinken": true, "List": true, "Optional": true,
+}
@@ -0,0 +1,153 @@
+package python
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"shrinken/gen"
+	"shrinken/wire/wiretest"
+	"strings"
+	"testing"
+)
+
+func generate(t *testing.T, filename string, expectedToSucceed bool) string {
+	tree := wiretest.Parse(t, filename)
+
+	dir, err := ioutil.TempDir("", "shrinken")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = gen.Generate(tree, "python", dir)
+	if (err == nil) != expectedToSucceed {
+		os.RemoveAll(dir)
+		if expectedToSucceed {
+			t.Fatal("Python code couldn't be generated!", err)
+		} else {
+			t.Fatal("Python code was generated, but expected generation to fail!")
+		}
+	}
+
+	return dir
+}
+
+func TestBasic(t *testing.T) {
+	dir := generate(t, "../../sddl/test_data/single_file/basic.sddl", true)
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"__init__.py", "shrinken.py"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Fatalf("File %v is missing! %v", name, err)
+		}
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, "com_github_namespace.py"))
+	if err != nil {
+		t.Fatal("Generated module is missing!", err)
+	}
+	code := string(b)
+
+	expected := []string{
+		"class Player(Entity):",
+		"pos: Vector3 = dataclasses.field(default_factory=lambda: Vector3())",
+		"rot: Quaternion = ",
+		"def from_bytes(cls, data: bytes) -> Player:",
+		"def handle_player(self, message: Player) -> None:",
+	}
+	for _, e := range expected {
+		if !strings.Contains(code, e) {
+			t.Fatalf("Generated code doesn't contain %q", e)
+		}
+	}
+
+	// base class has to be defined before derived class
+	if strings.Index(code, "class Entity:") > strings.Index(code, "class Player(Entity):") {
+		t.Fatal("Class Entity is defined after class Player which extends it")
+	}
+}
+
+func TestCircularPackages(t *testing.T) {
+	dir := generate(t, "../../sddl/test_data/multipkg/same_name/", false)
+	os.RemoveAll(dir)
+}
+
+// conformanceMain gets name of generated package as argument, since it's imported from its parent directory
+const conformanceMain = `
+import importlib
+import sys
+
+conformance = importlib.import_module(sys.argv[1] + ".conformance")
+
+
+class Printer(conformance.MessageHandler):
+    """Prints every handled message serialized with its ID."""
+
+    def handle_sample(self, message):
+        print(message.to_any_bytes().hex())
+
+    def handle_aligned(self, message):
+        print(message.to_any_bytes().hex())
+
+    def handle_coded(self, message):
+        print(message.to_any_bytes().hex())
+
+    def handle_bulk(self, message):
+        print(message.to_any_bytes().hex())
+
+
+data = bytes.fromhex(sys.stdin.readline().strip())
+if sys.argv[2:] == ["any"]:
+    conformance.decode_any(data, Printer())
+    for line in sys.stdin:
+        conformance.decode_any(bytes.fromhex(line.strip()), Printer())
+elif sys.argv[2:] == ["truncated"]:
+    try:
+        conformance.Bulk.from_bytes(data)
+        print("ok")
+    except Exception as e:
+        print(e)
+elif sys.argv[2:] == ["aligned"]:
+    print(conformance.Aligned.from_bytes(data).to_bytes().hex())
+elif sys.argv[2:] == ["coded"]:
+    print(conformance.Coded.from_bytes(data).to_bytes().hex())
+elif sys.argv[2:] == ["delta"]:
+    sample = conformance.Sample.from_bytes(data)
+    baseline = conformance.Sample.from_bytes(data)
+    sample.apply_delta_bytes(bytes.fromhex(sys.stdin.readline().strip()))
+    print(sample.to_bytes().hex())
+    print(sample.to_delta_bytes(baseline).hex())
+else:
+    print(conformance.Sample.from_bytes(data).to_bytes().hex())
+`
+
+func TestConformance(t *testing.T) {
+	python := wiretest.Tool(t, "python3")
+
+	dir := generate(t, wiretest.Schema, true)
+	defer os.RemoveAll(dir)
+
+	cmd := exec.Command(python, "-c", conformanceMain, filepath.Base(dir))
+	cmd.Dir = filepath.Dir(dir)
+	wiretest.Run(t, cmd)
+
+	cmd = exec.Command(python, "-c", conformanceMain, filepath.Base(dir))
+	cmd.Dir = filepath.Dir(dir)
+	wiretest.RunDelta(t, cmd)
+
+	cmd = exec.Command(python, "-c", conformanceMain, filepath.Base(dir))
+	cmd.Dir = filepath.Dir(dir)
+	wiretest.RunAligned(t, cmd)
+
+	cmd = exec.Command(python, "-c", conformanceMain, filepath.Base(dir))
+	cmd.Dir = filepath.Dir(dir)
+	wiretest.RunCoded(t, cmd)
+
+	cmd = exec.Command(python, "-c", conformanceMain, filepath.Base(dir))
+	cmd.Dir = filepath.Dir(dir)
+	wiretest.RunAny(t, cmd)
+
+	cmd = exec.Command(python, "-c", conformanceMain, filepath.Base(dir))
+	cmd.Dir = filepath.Dir(dir)
+	wiretest.RunTruncated(t, cmd)
+}
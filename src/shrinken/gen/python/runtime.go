@@ -0,0 +1,396 @@
+package python
+
+// runtime is written to shrinken.py next to generated modules
+
+const runtime = `# Code generated by shrinken. DO NOT EDIT.
+
+import math
+import struct
+
+
+class ShrinkenError(Exception):
+    pass
+
+
+# generated classes by their qualified names, references to base classes can be decoded as any of them
+_classes = {}
+
+
+def register_class(name: str):
+    """Class decorator which makes class available to read_class."""
+    def register(cls):
+        cls._sddl_class = name
+        _classes[name] = cls
+        return cls
+    return register
+
+
+def _sign_not_zero(value: float) -> float:
+    return -1.0 if value < 0 else 1.0
+
+
+def _clamp(value: float, min_value: float, max_value: float) -> float:
+    if not value >= min_value:
+        return min_value
+    return min(value, max_value)
+
+
+class HuffmanCode:
+    """Canonical Huffman code of values 0 to len(codes)-1. Code of value is written as single lengths[value] wide
+    field codes[value], first bit of code lowest. counts holds number of codes of each length up to the longest one
+    and symbols values ordered by their codes, for reading codes bit by bit."""
+
+    def __init__(self, codes: tuple, lengths: tuple, counts: tuple, symbols: tuple) -> None:
+        self.codes = codes
+        self.lengths = lengths
+        self.counts = counts
+        self.symbols = symbols
+
+
+class BitWriter:
+    """Writes values as little-endian bit fields, starting from least significant bit of first byte."""
+
+    def __init__(self, byte_aligned: bool = False) -> None:
+        self._buf = bytearray()
+        self._acc = 0  # bits which don't fill whole byte yet
+        self._acc_bits = 0
+        self._byte_aligned = byte_aligned  # every bit field is padded with zero bits to whole bytes
+
+    def write_bits(self, value: int, bits: int) -> None:
+        """Writes lowest bits of value, negative values are written in two's complement."""
+        self._acc |= (value & ((1 << bits) - 1)) << self._acc_bits
+        self._acc_bits += bits
+        if self._byte_aligned:
+            self._acc_bits = (self._acc_bits + 7) & ~7
+        while self._acc_bits >= 8:
+            self._buf.append(self._acc & 0xFF)
+            self._acc >>= 8
+            self._acc_bits -= 8
+
+    def write_range(self, value: int, min_value: int, max_value: int, bits: int) -> None:
+        """Writes value clamped to [min_value, max_value] as offset from min_value."""
+        self.write_bits(min(max(value, min_value), max_value) - min_value, bits)
+
+    def write_symbol(self, value: int, code: HuffmanCode) -> None:
+        """Writes Huffman code of value, value without code is invalid."""
+        if not 0 <= value < len(code.codes):
+            raise ShrinkenError("shrinken: %d has no Huffman code" % value)
+        self.write_bits(code.codes[value], code.lengths[value])
+
+    def write_range_symbol(self, value: int, min_value: int, max_value: int, code: HuffmanCode) -> None:
+        """Writes value clamped to [min_value, max_value] as Huffman code of offset from min_value."""
+        self.write_symbol(min(max(value, min_value), max_value) - min_value, code)
+
+    def write_varint(self, value: int, bits: int) -> None:
+        """Writes lowest bits of value in groups of 7 bits, starting from the lowest one, each one as 8 bit
+        field with highest bit set if another group follows."""
+        value &= (1 << bits) - 1
+        while value >= 0x80:
+            self.write_bits((value & 0x7F) | 0x80, 8)
+            value >>= 7
+        self.write_bits(value, 8)
+
+    def write_zigzag(self, value: int) -> None:
+        """Maps 0, -1, 1, -2... to 0, 1, 2, 3... and writes it as varint."""
+        self.write_varint((value << 1) ^ (value >> 63), 64)
+
+    def write_quantized(self, value: float, min_value: float, max_value: float, steps: int, bits: int) -> None:
+        """Writes float value clamped to [min_value, max_value] as the nearest of steps equal steps from min_value."""
+        # clamped first, since values out of float range can't be rounded to float (NaN is written as min_value)
+        v = _clamp(value, min_value, max_value)
+        v = _clamp(struct.unpack("<f", struct.pack("<f", v))[0], min_value, max_value)
+        self.write_bits(int((v - min_value) * steps / (max_value - min_value) + 0.5), bits)
+
+    def write_quaternion(self, value: tuple, min_value: float, max_value: float, steps: int, bits: int) -> None:
+        """Writes 2 bit index of the largest component of normalized quaternion, followed by the other three
+        components quantized to [min_value, max_value], see specification."""
+        q = [struct.unpack("<f", struct.pack("<f", c))[0] for c in value]
+        n = math.sqrt(q[0] * q[0] + q[1] * q[1] + q[2] * q[2] + q[3] * q[3])
+        if 0 < n < math.inf:
+            q = [c / n for c in q]
+        largest = 0
+        for c in range(1, 4):
+            if abs(q[c]) > abs(q[largest]):
+                largest = c
+        # q and -q are the same rotation
+        if q[largest] < 0:
+            q = [-c for c in q]
+
+        self.write_bits(largest, 2)
+        for c in range(4):
+            if c != largest:
+                self.write_quantized(q[c], min_value, max_value, steps, bits)
+
+    def write_normalized(self, value: tuple, steps: int, bits: int) -> None:
+        """Writes unit vector as two octahedral coordinates quantized to [-1, 1], see specification."""
+        v = [struct.unpack("<f", struct.pack("<f", c))[0] for c in value]
+        n = abs(v[0]) + abs(v[1]) + abs(v[2])
+        if 0 < n < math.inf:
+            v = [c / n for c in v]
+        else:
+            v = [0.0, 0.0, 1.0]
+
+        u, t = v[0], v[1]
+        if v[2] < 0:
+            u, t = (1 - abs(v[1])) * _sign_not_zero(v[0]), (1 - abs(v[0])) * _sign_not_zero(v[1])
+        self.write_quantized(u, -1.0, 1.0, steps, bits)
+        self.write_quantized(t, -1.0, 1.0, steps, bits)
+
+    def write_bool(self, value: bool) -> None:
+        self.write_bits(1 if value else 0, 1)
+
+    def write_float32(self, value: float) -> None:
+        self.write_bits(struct.unpack("<I", struct.pack("<f", value))[0], 32)
+
+    def write_float64(self, value: float) -> None:
+        self.write_bits(struct.unpack("<Q", struct.pack("<d", value))[0], 64)
+
+    # write_string writes UTF-8 length of value as count_bits wide integer, followed by its bytes
+    def write_string(self, value: str, max_length: int, count_bits: int) -> None:
+        self._write_chars(value.encode("utf-8"), max_length, count_bits, 8)
+
+    # write_ascii writes length of value as count_bits wide integer, followed by its characters, 7 bits each
+    def write_ascii(self, value: str, max_length: int, count_bits: int) -> None:
+        try:
+            data = value.encode("ascii")
+        except UnicodeEncodeError:
+            raise ShrinkenError("shrinken: string is not ASCII") from None
+        self._write_chars(data, max_length, count_bits, 7)
+
+    def _write_chars(self, data: bytes, max_length: int, count_bits: int, char_bits: int) -> None:
+        if len(data) > max_length:
+            raise ShrinkenError("shrinken: string of %d bytes is longer than %d" % (len(data), max_length))
+
+        self.write_bits(len(data), count_bits)
+        for b in data:
+            self.write_bits(b, char_bits)
+
+    # write_count writes length of dynamic array as count_bits wide integer
+    def write_count(self, count: int, max_count: int, count_bits: int) -> None:
+        if count > max_count:
+            raise ShrinkenError("shrinken: array of %d elements is longer than %d" % (count, max_count))
+        self.write_bits(count, count_bits)
+
+    def write_char(self, value: str) -> None:
+        self.write_bits(ord(value[0]) if value else 0, 32)
+
+    def write_ascii_char(self, value: str) -> None:
+        c = ord(value[0]) if value else 0
+        if c >= 0x80:
+            raise ShrinkenError("shrinken: character is not ASCII")
+        self.write_bits(c, 7)
+
+    def write_class(self, value, subtypes: tuple, bits: int) -> None:
+        """Writes index of class of value in subtypes as tag, followed by its fields."""
+        try:
+            tag = subtypes.index(value._sddl_class)
+        except ValueError:
+            raise ShrinkenError("shrinken: %s is not one of %s" % (value._sddl_class, ", ".join(subtypes))) from None
+        self.write_bits(tag, bits)
+        value.encode(self)
+
+    @property
+    def bit_length(self) -> int:
+        """Number of written bits."""
+        return len(self._buf) * 8 + self._acc_bits
+
+    def to_bytes(self) -> bytes:
+        if self._acc_bits > 0:
+            return bytes(self._buf) + bytes([self._acc])
+        return bytes(self._buf)
+
+
+class BitReader:
+    """Reads values written by BitWriter."""
+
+    def __init__(self, data: bytes, byte_aligned: bool = False) -> None:
+        self._data = data
+        self._pos = 0
+        self._byte_aligned = byte_aligned  # padding after every bit field is skipped
+
+    @property
+    def remaining(self) -> int:
+        """Number of bits left to read."""
+        return len(self._data) * 8 - self._pos
+
+    def read_bits(self, bits: int) -> int:
+        """Reads unsigned value."""
+        if bits > self.remaining:
+            raise ShrinkenError("shrinken: unexpected end of data")
+
+        start = self._pos >> 3
+        end = (self._pos + bits + 7) >> 3
+        value = int.from_bytes(self._data[start:end], "little") >> (self._pos & 7)
+        self._pos += bits
+        if self._byte_aligned:
+            self._pos = (self._pos + 7) & ~7
+        return value & ((1 << bits) - 1)
+
+    def read_signed(self, bits: int) -> int:
+        """Reads two's complement value."""
+        value = self.read_bits(bits)
+        if value >> (bits - 1):
+            return value - (1 << bits)
+        return value
+
+    def read_range(self, min_value: int, max_value: int, bits: int) -> int:
+        offset = self.read_bits(bits)
+        if offset > max_value - min_value:
+            raise ShrinkenError("shrinken: value is out of range")
+        return min_value + offset
+
+    def read_symbol(self, code: HuffmanCode) -> int:
+        """Reads Huffman code bit by bit, padding of byte aligned reader is skipped only after the whole code."""
+        aligned = self._byte_aligned
+        self._byte_aligned = False
+        try:
+            # codes of each length follow all shorter ones, counting up from first
+            c, first, index = 0, 0, 0
+            for count in code.counts[1:]:
+                c |= self.read_bits(1)
+                if c - first < count:
+                    return code.symbols[index + c - first]
+                index += count
+                first = (first + count) << 1
+                c <<= 1
+            raise ShrinkenError("shrinken: incomplete Huffman code")
+        finally:
+            self._byte_aligned = aligned
+            if aligned:
+                self._pos = (self._pos + 7) & ~7
+
+    def read_range_symbol(self, min_value: int, code: HuffmanCode) -> int:
+        return min_value + self.read_symbol(code)
+
+    def read_varint(self, bits: int) -> int:
+        value = 0
+        for shift in range(0, bits, 7):
+            group = self.read_bits(8)
+            value |= (group & 0x7F) << shift
+            if not group & 0x80:
+                if value >> bits:
+                    break
+                return value
+        raise ShrinkenError("shrinken: varint doesn't fit its type")
+
+    def read_signed_varint(self, bits: int) -> int:
+        """Reads two's complement value written by write_varint."""
+        value = self.read_varint(bits)
+        if value >> (bits - 1):
+            return value - (1 << bits)
+        return value
+
+    def read_zigzag(self, bits: int) -> int:
+        value = self.read_varint(bits)
+        return (value >> 1) ^ -(value & 1)
+
+    def read_quantized(self, min_value: float, max_value: float, steps: int, bits: int) -> float:
+        q = self.read_bits(bits)
+        if q > steps:
+            raise ShrinkenError("shrinken: value is out of range")
+        v = min_value + (max_value - min_value) * q / steps
+        return struct.unpack("<f", struct.pack("<f", v))[0]
+
+    def read_quaternion(self, min_value: float, max_value: float, steps: int, bits: int) -> tuple:
+        """Reads quaternion written by write_quaternion and returns its four components."""
+        largest = self.read_bits(2)
+        q = [0.0] * 4
+        total = 0.0
+        for c in range(4):
+            if c != largest:
+                q[c] = self.read_quantized(min_value, max_value, steps, bits)
+                total += q[c] * q[c]
+        q[largest] = struct.unpack("<f", struct.pack("<f", math.sqrt(max(0.0, 1 - total))))[0]
+        return tuple(q)
+
+    def read_normalized(self, steps: int, bits: int) -> tuple:
+        """Reads vector written by write_normalized and returns its three components with unit length."""
+        u = self.read_quantized(-1.0, 1.0, steps, bits)
+        t = self.read_quantized(-1.0, 1.0, steps, bits)
+        z = 1 - abs(u) - abs(t)
+        if z < 0:
+            u, t = (1 - abs(t)) * _sign_not_zero(u), (1 - abs(u)) * _sign_not_zero(t)
+        n = math.sqrt(u * u + t * t + z * z)
+        return tuple(struct.unpack("<f", struct.pack("<f", c / n))[0] for c in (u, t, z))
+
+    def read_bool(self) -> bool:
+        return self.read_bits(1) == 1
+
+    def read_float32(self) -> float:
+        return struct.unpack("<f", struct.pack("<I", self.read_bits(32)))[0]
+
+    def read_float64(self) -> float:
+        return struct.unpack("<d", struct.pack("<Q", self.read_bits(64)))[0]
+
+    # read_string reads string written by write_string, longer strings are invalid
+    def read_string(self, max_length: int, count_bits: int) -> str:
+        data = self._read_chars(max_length, count_bits, 8)
+        try:
+            return data.decode("utf-8")
+        except UnicodeDecodeError:
+            raise ShrinkenError("shrinken: invalid UTF-8 string") from None
+
+    # read_ascii reads string written by write_ascii, longer strings are invalid
+    def read_ascii(self, max_length: int, count_bits: int) -> str:
+        return self._read_chars(max_length, count_bits, 7).decode("ascii")
+
+    def _read_chars(self, max_length: int, count_bits: int, char_bits: int) -> bytes:
+        n = self.read_bits(count_bits)
+        if n > max_length:
+            raise ShrinkenError("shrinken: string of %d bytes is longer than %d" % (n, max_length))
+        if n * char_bits > self.remaining:
+            raise ShrinkenError("shrinken: unexpected end of data")
+
+        return bytes(self.read_bits(char_bits) for _ in range(n))
+
+    # read_count reads length of dynamic array, larger than max_count is invalid
+    def read_count(self, max_count: int, count_bits: int) -> int:
+        count = self.read_bits(count_bits)
+        if count > max_count:
+            raise ShrinkenError("shrinken: array of %d elements is longer than %d" % (count, max_count))
+        return count
+
+    def read_char(self) -> str:
+        value = self.read_bits(32)
+        try:
+            return chr(value)
+        except ValueError:
+            raise ShrinkenError("shrinken: invalid code point %d" % value) from None
+
+    def read_ascii_char(self) -> str:
+        return chr(self.read_bits(7))
+
+    def read_class(self, subtypes: tuple, bits: int):
+        """Reads value written by write_class, its class has to be imported."""
+        tag = self.read_bits(bits)
+        if tag >= len(subtypes) or subtypes[tag] not in _classes:
+            raise ShrinkenError("shrinken: unknown subtype tag %d" % tag)
+        return _classes[subtypes[tag]]().decode(self)
+
+    def read_enum(self, enum_type, bits: int):
+        value = self.read_bits(bits)
+        try:
+            return enum_type(value)
+        except ValueError:
+            raise ShrinkenError("shrinken: invalid value %d of %s" % (value, enum_type.__name__)) from None
+
+
+def check_size(value: list, size: int) -> None:
+    """Raises error if fixed size array doesn't have expected length."""
+    if len(value) != size:
+        raise ShrinkenError("shrinken: array has %d elements, expected %d" % (len(value), size))
+
+
+def write_message_id(message_id: int) -> bytes:
+    """Returns ID of message as varint, which to_any_bytes writes before message."""
+    w = BitWriter()
+    w.write_varint(message_id, 16)
+    return w.to_bytes()
+
+
+def read_message_id(data: bytes) -> tuple:
+    """Returns ID written by write_message_id and data of message following it."""
+    r = BitReader(data)
+    message_id = r.read_varint(16)
+    return message_id, data[len(data) - r.remaining // 8:]
+`
@@ -0,0 +1,688 @@
+package rust
+
+// runtime is written to shrinken.rs next to generated modules
+
+const runtime = `// Code generated by shrinken. DO NOT EDIT.
+
+#![allow(dead_code)]
+
+use alloc::string::String;
+use alloc::vec::Vec;
+use core::fmt;
+
+#[derive(Debug, Clone, Copy, PartialEq, Eq)]
+pub enum Error {
+    /// data ended before all values were read
+    UnexpectedEnd,
+    /// value can't be represented, for example unknown enumeral or invalid UTF-8
+    InvalidValue,
+    /// encoded string is longer than its maximum length
+    TooLong,
+    /// message ID doesn't belong to any message handled by decode_any
+    UnknownMessage,
+}
+
+impl fmt::Display for Error {
+    fn fmt(&self, f: &mut fmt::Formatter) -> fmt::Result {
+        match self {
+            Error::UnexpectedEnd => f.write_str("shrinken: unexpected end of data"),
+            Error::InvalidValue => f.write_str("shrinken: invalid value"),
+            Error::TooLong => f.write_str("shrinken: value is longer than its limit"),
+            Error::UnknownMessage => f.write_str("shrinken: unknown message"),
+        }
+    }
+}
+
+pub trait Encode {
+    fn encode(&self, w: &mut BitWriter);
+}
+
+/// Decode reads value even if reader has failed, errors are reported by BitReader::finish
+pub trait Decode: Sized {
+    fn decode(r: &mut BitReader) -> Self;
+}
+
+pub trait Message: Encode + Decode {
+    /// MESSAGE_ID identifies message among messages of all packages
+    const MESSAGE_ID: u16;
+    /// BYTE_ALIGNED pads every bit field of message with zero bits to whole bytes
+    const BYTE_ALIGNED: bool = false;
+
+    fn serialize(&self) -> Result<Vec<u8>, Error> {
+        let mut w = BitWriter::new();
+        w.set_byte_aligned(Self::BYTE_ALIGNED);
+        self.encode(&mut w);
+        w.finish()
+    }
+
+    fn deserialize(data: &[u8]) -> Result<Self, Error> {
+        let mut r = BitReader::new(data);
+        r.set_byte_aligned(Self::BYTE_ALIGNED);
+        let value = Self::decode(&mut r);
+        r.finish().map(|_| value)
+    }
+
+    /// serialize_any writes MESSAGE_ID as varint, followed by the message, for decode_any
+    fn serialize_any(&self) -> Result<Vec<u8>, Error> {
+        let mut w = BitWriter::new();
+        w.write_varint(u64::from(Self::MESSAGE_ID), 16);
+        w.set_byte_aligned(Self::BYTE_ALIGNED);
+        self.encode(&mut w);
+        w.finish()
+    }
+}
+
+/// read_message_id reads ID written by serialize_any and returns it with data of the message
+pub fn read_message_id(data: &[u8]) -> Result<(u16, &[u8]), Error> {
+    let mut r = BitReader::new(data);
+    let id = r.read_varint(16) as u16;
+    r.finish()?;
+    Ok((id, &data[data.len() - r.remaining() / 8..]))
+}
+
+/// Delta encodes value as mask of fields changed against baseline, followed by changed fields
+pub trait Delta {
+    fn differs(&self, baseline: &Self) -> bool;
+    fn encode_delta(&self, w: &mut BitWriter, baseline: &Self);
+    /// decode_delta replaces changed fields of self, which holds baseline the delta was written against
+    fn decode_delta(&mut self, r: &mut BitReader);
+}
+
+pub trait DeltaMessage: Message + Delta {
+    fn serialize_delta(&self, baseline: &Self) -> Result<Vec<u8>, Error> {
+        let mut w = BitWriter::new();
+        w.set_byte_aligned(Self::BYTE_ALIGNED);
+        self.encode_delta(&mut w, baseline);
+        w.finish()
+    }
+
+    fn deserialize_delta(&mut self, data: &[u8]) -> Result<(), Error> {
+        let mut r = BitReader::new(data);
+        r.set_byte_aligned(Self::BYTE_ALIGNED);
+        self.decode_delta(&mut r);
+        r.finish()
+    }
+}
+
+/// sign_not_zero returns -1 for negative values and 1 for others, including negative zero
+fn sign_not_zero(value: f64) -> f64 {
+    if value < 0.0 {
+        -1.0
+    } else {
+        1.0
+    }
+}
+
+/// sqrt returns correctly rounded square root like f64::sqrt, which needs std. Root of mantissa is computed
+/// digit by digit with 2 more bits than f64 holds, the lowest one is set when the root isn't exact, so that
+/// conversion to f64 rounds it like the exact root.
+fn sqrt(value: f64) -> f64 {
+    if value == 0.0 || value == f64::INFINITY {
+        return value;
+    }
+    if !(value > 0.0) {
+        return f64::NAN;
+    }
+
+    let bits = value.to_bits();
+    let mut m = bits & ((1 << 52) - 1);
+    let mut e = (bits >> 52) as i32;
+    if e == 0 {
+        // subnormal value is normalized
+        e = 1;
+        while m & (1 << 52) == 0 {
+            m <<= 1;
+            e -= 1;
+        }
+    } else {
+        m |= 1 << 52;
+    }
+    // value is m * 2^e, with even e
+    e -= 1075;
+    if e & 1 != 0 {
+        m <<= 1;
+        e -= 1;
+    }
+
+    let n = (m as u128) << 56;
+    let mut rem = n;
+    let mut root: u128 = 0;
+    let mut bit: u128 = 1 << 108;
+    while bit > n {
+        bit >>= 2;
+    }
+    while bit != 0 {
+        if rem >= root + bit {
+            rem -= root + bit;
+            root = (root >> 1) + bit;
+        } else {
+            root >>= 1;
+        }
+        bit >>= 2;
+    }
+    if rem != 0 {
+        root |= 1;
+    }
+
+    root as f64 * f64::from_bits((((e - 56) / 2 + 1023) as u64) << 52)
+}
+
+/// HuffmanCode is canonical Huffman code of values 0 to codes.len()-1. Code of value is written as single
+/// lengths[value] wide field codes[value], first bit of code lowest. counts holds number of codes of each length
+/// up to the longest one and symbols values ordered by their codes, for reading codes bit by bit.
+#[derive(Debug)]
+pub struct HuffmanCode {
+    pub codes: &'static [u16],
+    pub lengths: &'static [u8],
+    pub counts: &'static [u16],
+    pub symbols: &'static [u8],
+}
+
+/// BitWriter writes values as little-endian bit fields, starting from least significant bit of first byte
+#[derive(Debug, Default)]
+pub struct BitWriter {
+    buf: Vec<u8>,
+    bits: usize,
+    byte_aligned: bool,
+    error: Option<Error>,
+}
+
+impl BitWriter {
+    pub fn new() -> Self {
+        Self::default()
+    }
+
+    /// write_bits writes lowest bits (up to 64) of value
+    pub fn write_bits(&mut self, mut value: u64, mut bits: u32) {
+        while bits > 0 {
+            let used = (self.bits & 7) as u32;
+            if used == 0 {
+                self.buf.push(0);
+            }
+
+            let n = core::cmp::min(8 - used, bits);
+            let last = self.buf.len() - 1;
+            self.buf[last] |= ((value & ((1u64 << n) - 1)) << used) as u8;
+            value >>= n;
+            bits -= n;
+            self.bits += n as usize;
+        }
+
+        if self.byte_aligned {
+            self.bits = (self.bits + 7) & !7;
+        }
+    }
+
+    /// set_byte_aligned makes writer pad every following bit field with zero bits to whole bytes
+    pub fn set_byte_aligned(&mut self, aligned: bool) {
+        self.byte_aligned = aligned;
+    }
+
+    /// write_signed_range writes value clamped to [min, max] as offset from min
+    pub fn write_signed_range(&mut self, value: i64, min: i64, max: i64, bits: u32) {
+        let value = value.max(min).min(max);
+        self.write_bits((value as u64).wrapping_sub(min as u64), bits);
+    }
+
+    /// write_unsigned_range writes value clamped to [min, max] as offset from min
+    pub fn write_unsigned_range(&mut self, value: u64, min: u64, max: u64, bits: u32) {
+        let value = value.max(min).min(max);
+        self.write_bits(value - min, bits);
+    }
+
+    /// write_symbol writes Huffman code of value, value without code is invalid
+    pub fn write_symbol(&mut self, value: u64, code: &HuffmanCode) {
+        if value >= code.codes.len() as u64 {
+            self.fail(Error::InvalidValue);
+            return;
+        }
+        self.write_bits(code.codes[value as usize] as u64, code.lengths[value as usize] as u32);
+    }
+
+    /// write_signed_range_symbol writes value clamped to [min, max] as Huffman code of offset from min
+    pub fn write_signed_range_symbol(&mut self, value: i64, min: i64, max: i64, code: &HuffmanCode) {
+        let value = value.max(min).min(max);
+        self.write_symbol((value as u64).wrapping_sub(min as u64), code);
+    }
+
+    /// write_unsigned_range_symbol writes value clamped to [min, max] as Huffman code of offset from min
+    pub fn write_unsigned_range_symbol(&mut self, value: u64, min: u64, max: u64, code: &HuffmanCode) {
+        let value = value.max(min).min(max);
+        self.write_symbol(value - min, code);
+    }
+
+    /// write_varint writes lowest bits of value in groups of 7 bits, starting from the lowest one,
+    /// each one as 8 bit field with highest bit set if another group follows
+    pub fn write_varint(&mut self, mut value: u64, bits: u32) {
+        if bits < 64 {
+            value &= (1u64 << bits) - 1;
+        }
+        while value >= 0x80 {
+            self.write_bits((value & 0x7F) | 0x80, 8);
+            value >>= 7;
+        }
+        self.write_bits(value, 8);
+    }
+
+    /// write_zigzag maps 0, -1, 1, -2... to 0, 1, 2, 3... and writes it as varint
+    pub fn write_zigzag(&mut self, value: i64) {
+        self.write_varint(((value << 1) ^ (value >> 63)) as u64, 64);
+    }
+
+    /// write_quantized writes value clamped to [min, max] as the nearest of steps equal steps from min
+    pub fn write_quantized(&mut self, value: f32, min: f64, max: f64, steps: u64, bits: u32) {
+        let mut v = value as f64;
+        // NaN is written as min
+        if !(v >= min) {
+            v = min;
+        } else if v > max {
+            v = max;
+        }
+        self.write_bits(((v - min) * steps as f64 / (max - min) + 0.5) as u64, bits);
+    }
+
+    /// write_quaternion writes 2 bit index of the largest component of normalized quaternion, followed by
+    /// the other three components quantized to [min, max], see specification
+    pub fn write_quaternion(&mut self, value: [f32; 4], min: f64, max: f64, steps: u64, bits: u32) {
+        let mut q = value.map(|c| c as f64);
+        let n = sqrt(q[0] * q[0] + q[1] * q[1] + q[2] * q[2] + q[3] * q[3]);
+        if n > 0.0 && !n.is_infinite() {
+            for c in q.iter_mut() {
+                *c /= n;
+            }
+        }
+        let mut largest = 0;
+        for c in 1..4 {
+            if q[c].abs() > q[largest].abs() {
+                largest = c;
+            }
+        }
+        // q and -q are the same rotation
+        if q[largest] < 0.0 {
+            for c in q.iter_mut() {
+                *c = -*c;
+            }
+        }
+
+        self.write_bits(largest as u64, 2);
+        for c in 0..4 {
+            if c != largest {
+                self.write_quantized(q[c] as f32, min, max, steps, bits);
+            }
+        }
+    }
+
+    /// write_normalized writes unit vector as two octahedral coordinates quantized to [-1, 1], see specification
+    pub fn write_normalized(&mut self, value: [f32; 3], steps: u64, bits: u32) {
+        let mut v = value.map(|c| c as f64);
+        let n = v[0].abs() + v[1].abs() + v[2].abs();
+        if n > 0.0 && !n.is_infinite() {
+            for c in v.iter_mut() {
+                *c /= n;
+            }
+        } else {
+            v = [0.0, 0.0, 1.0];
+        }
+
+        let (mut u, mut t) = (v[0], v[1]);
+        if v[2] < 0.0 {
+            u = (1.0 - v[1].abs()) * sign_not_zero(v[0]);
+            t = (1.0 - v[0].abs()) * sign_not_zero(v[1]);
+        }
+        self.write_quantized(u as f32, -1.0, 1.0, steps, bits);
+        self.write_quantized(t as f32, -1.0, 1.0, steps, bits);
+    }
+
+    pub fn write_bool(&mut self, value: bool) {
+        self.write_bits(value as u64, 1);
+    }
+
+    pub fn write_f32(&mut self, value: f32) {
+        self.write_bits(value.to_bits() as u64, 32);
+    }
+
+    pub fn write_f64(&mut self, value: f64) {
+        self.write_bits(value.to_bits(), 64);
+    }
+
+    /// write_str writes length of value in bytes as count_bits wide integer, followed by its bytes
+    pub fn write_str(&mut self, value: &str, max_length: u64, count_bits: u32) {
+        self.write_chars(value, max_length, count_bits, 8);
+    }
+
+    /// write_ascii writes length of value as count_bits wide integer, followed by its characters, 7 bits each
+    pub fn write_ascii(&mut self, value: &str, max_length: u64, count_bits: u32) {
+        if !value.is_ascii() {
+            self.fail(Error::InvalidValue);
+            return;
+        }
+        self.write_chars(value, max_length, count_bits, 7);
+    }
+
+    fn write_chars(&mut self, value: &str, max_length: u64, count_bits: u32, char_bits: u32) {
+        if value.len() as u64 > max_length {
+            self.fail(Error::TooLong);
+            return;
+        }
+
+        self.write_bits(value.len() as u64, count_bits);
+        for b in value.bytes() {
+            self.write_bits(b as u64, char_bits);
+        }
+    }
+
+    /// write_count writes length of dynamic array, larger than max_count fails encoding
+    pub fn write_count(&mut self, count: usize, max_count: u64, count_bits: u32) {
+        if count as u64 > max_count {
+            self.fail(Error::TooLong);
+            return;
+        }
+        self.write_bits(count as u64, count_bits);
+    }
+
+    pub fn write_char(&mut self, value: char) {
+        self.write_bits(value as u64, 32);
+    }
+
+    pub fn write_ascii_char(&mut self, value: char) {
+        if !value.is_ascii() {
+            self.fail(Error::InvalidValue);
+            return;
+        }
+        self.write_bits(value as u64, 7);
+    }
+
+    /// fail marks encoding as failed, only first error is kept
+    pub fn fail(&mut self, error: Error) {
+        if self.error.is_none() {
+            self.error = Some(error);
+        }
+    }
+
+    /// number of written bits
+    pub fn bit_len(&self) -> usize {
+        self.bits
+    }
+
+    pub fn finish(self) -> Result<Vec<u8>, Error> {
+        match self.error {
+            Some(error) => Err(error),
+            None => Ok(self.buf),
+        }
+    }
+}
+
+/// BitReader reads values written by BitWriter. After first error all reads return zero values.
+#[derive(Debug)]
+pub struct BitReader<'a> {
+    data: &'a [u8],
+    pos: usize,
+    byte_aligned: bool,
+    error: Option<Error>,
+}
+
+impl<'a> BitReader<'a> {
+    pub fn new(data: &'a [u8]) -> Self {
+        BitReader {
+            data,
+            pos: 0,
+            byte_aligned: false,
+            error: None,
+        }
+    }
+
+    /// read_bits reads unsigned value of up to 64 bits
+    pub fn read_bits(&mut self, mut bits: u32) -> u64 {
+        if self.error.is_some() || bits as usize > self.remaining() {
+            self.fail(Error::UnexpectedEnd);
+            return 0;
+        }
+
+        let mut value = 0u64;
+        let mut shift = 0;
+        while bits > 0 {
+            let used = (self.pos & 7) as u32;
+            let n = core::cmp::min(8 - used, bits);
+            value |= (((self.data[self.pos >> 3] >> used) as u64) & ((1u64 << n) - 1)) << shift;
+            shift += n;
+            bits -= n;
+            self.pos += n as usize;
+        }
+
+        if self.byte_aligned {
+            self.pos = (self.pos + 7) & !7;
+        }
+        value
+    }
+
+    /// set_byte_aligned makes reader skip padding after every following bit field, up to whole bytes
+    pub fn set_byte_aligned(&mut self, aligned: bool) {
+        self.byte_aligned = aligned;
+    }
+
+    pub fn read_signed_range(&mut self, min: i64, max: i64, bits: u32) -> i64 {
+        let offset = self.read_bits(bits);
+        if offset > (max as u64).wrapping_sub(min as u64) {
+            self.fail(Error::InvalidValue);
+            return min;
+        }
+        (min as u64).wrapping_add(offset) as i64
+    }
+
+    pub fn read_unsigned_range(&mut self, min: u64, max: u64, bits: u32) -> u64 {
+        let offset = self.read_bits(bits);
+        if offset > max - min {
+            self.fail(Error::InvalidValue);
+            return min;
+        }
+        min + offset
+    }
+
+    /// read_symbol reads Huffman code bit by bit, padding of byte aligned reader is skipped only after the whole code
+    pub fn read_symbol(&mut self, code: &HuffmanCode) -> u64 {
+        let aligned = self.byte_aligned;
+        self.byte_aligned = false;
+        let value = self.read_code(code);
+        self.byte_aligned = aligned;
+        if aligned && self.error.is_none() {
+            self.pos = (self.pos + 7) & !7;
+        }
+        value
+    }
+
+    pub fn read_signed_range_symbol(&mut self, min: i64, code: &HuffmanCode) -> i64 {
+        (min as u64).wrapping_add(self.read_symbol(code)) as i64
+    }
+
+    pub fn read_unsigned_range_symbol(&mut self, min: u64, code: &HuffmanCode) -> u64 {
+        min + self.read_symbol(code)
+    }
+
+    /// read_code fails on incomplete code, codes of each length follow all shorter ones, counting up from first
+    fn read_code(&mut self, code: &HuffmanCode) -> u64 {
+        let (mut c, mut first, mut index) = (0usize, 0usize, 0usize);
+        for &count in &code.counts[1..] {
+            c |= self.read_bits(1) as usize;
+            if self.error.is_some() {
+                return 0;
+            }
+            if c - first < count as usize {
+                return code.symbols[index + c - first] as u64;
+            }
+            index += count as usize;
+            first = (first + count as usize) << 1;
+            c <<= 1;
+        }
+        self.fail(Error::InvalidValue);
+        0
+    }
+
+    pub fn read_varint(&mut self, bits: u32) -> u64 {
+        let mut value = 0u64;
+        let mut shift = 0;
+        while shift < bits {
+            let group = self.read_bits(8);
+            let data = group & 0x7F;
+            if self.error.is_some() {
+                return 0;
+            }
+
+            if shift + 7 > bits && data >> (bits - shift) != 0 {
+                break;
+            }
+            value |= data << shift;
+
+            if group & 0x80 == 0 {
+                return value;
+            }
+            shift += 7;
+        }
+
+        // value doesn't fit bits
+        self.fail(Error::InvalidValue);
+        0
+    }
+
+    pub fn read_zigzag(&mut self, bits: u32) -> i64 {
+        let value = self.read_varint(bits);
+        (value >> 1) as i64 ^ -((value & 1) as i64)
+    }
+
+    pub fn read_quantized(&mut self, min: f64, max: f64, steps: u64, bits: u32) -> f32 {
+        let q = self.read_bits(bits);
+        if q > steps {
+            self.fail(Error::InvalidValue);
+            return min as f32;
+        }
+        (min + (max - min) * q as f64 / steps as f64) as f32
+    }
+
+    /// read_quaternion reads quaternion written by write_quaternion, its largest component is computed from the others
+    pub fn read_quaternion(&mut self, min: f64, max: f64, steps: u64, bits: u32) -> [f32; 4] {
+        let largest = self.read_bits(2) as usize;
+        let mut q = [0.0f32; 4];
+        let mut sum = 0.0f64;
+        for c in 0..4 {
+            if c != largest {
+                q[c] = self.read_quantized(min, max, steps, bits);
+                sum += q[c] as f64 * q[c] as f64;
+            }
+        }
+        q[largest] = sqrt((1.0 - sum).max(0.0)) as f32;
+        q
+    }
+
+    /// read_normalized reads vector written by write_normalized and returns it with unit length
+    pub fn read_normalized(&mut self, steps: u64, bits: u32) -> [f32; 3] {
+        let mut u = self.read_quantized(-1.0, 1.0, steps, bits) as f64;
+        let mut t = self.read_quantized(-1.0, 1.0, steps, bits) as f64;
+        let z = 1.0 - u.abs() - t.abs();
+        if z < 0.0 {
+            let folded = (1.0 - t.abs()) * sign_not_zero(u);
+            t = (1.0 - u.abs()) * sign_not_zero(t);
+            u = folded;
+        }
+        let n = sqrt(u * u + t * t + z * z);
+        [(u / n) as f32, (t / n) as f32, (z / n) as f32]
+    }
+
+    pub fn read_bool(&mut self) -> bool {
+        self.read_bits(1) == 1
+    }
+
+    pub fn read_f32(&mut self) -> f32 {
+        f32::from_bits(self.read_bits(32) as u32)
+    }
+
+    pub fn read_f64(&mut self) -> f64 {
+        f64::from_bits(self.read_bits(64))
+    }
+
+    /// read_string reads string written by write_str, longer strings are invalid
+    pub fn read_string(&mut self, max_length: u64, count_bits: u32) -> String {
+        let bytes = self.read_chars(max_length, count_bits, 8);
+        match String::from_utf8(bytes) {
+            Ok(value) => value,
+            Err(_) => {
+                self.fail(Error::InvalidValue);
+                String::new()
+            }
+        }
+    }
+
+    /// read_ascii reads string written by write_ascii, longer strings are invalid
+    pub fn read_ascii(&mut self, max_length: u64, count_bits: u32) -> String {
+        let bytes = self.read_chars(max_length, count_bits, 7);
+        // 7 bit characters are always valid UTF-8
+        String::from_utf8(bytes).unwrap_or_default()
+    }
+
+    fn read_chars(&mut self, max_length: u64, count_bits: u32, char_bits: u32) -> Vec<u8> {
+        let n = self.read_bits(count_bits);
+        if n > max_length {
+            self.fail(Error::InvalidValue);
+            return Vec::new();
+        }
+        if n > (self.remaining() / char_bits as usize) as u64 {
+            self.fail(Error::UnexpectedEnd);
+            return Vec::new();
+        }
+
+        let mut bytes = Vec::with_capacity(n as usize);
+        for _ in 0..n {
+            bytes.push(self.read_bits(char_bits) as u8);
+        }
+        bytes
+    }
+
+    pub fn read_ascii_char(&mut self) -> char {
+        self.read_bits(7) as u8 as char
+    }
+
+    pub fn read_char(&mut self) -> char {
+        match char::from_u32(self.read_bits(32) as u32) {
+            Some(value) => value,
+            None => {
+                self.fail(Error::InvalidValue);
+                '\0'
+            }
+        }
+    }
+
+    /// read_vec reads count_bits wide length followed by elements read by read_elem,
+    /// length larger than max_count is invalid
+    pub fn read_vec<T, F: FnMut(&mut Self) -> T>(&mut self, max_count: u64, count_bits: u32, mut read_elem: F) -> Vec<T> {
+        let n = self.read_bits(count_bits);
+        if n > max_count {
+            self.fail(Error::InvalidValue);
+        }
+        let mut values = Vec::new();
+        for _ in 0..n {
+            if self.error.is_some() {
+                break;
+            }
+            values.push(read_elem(self));
+        }
+        values
+    }
+
+    /// fail marks decoding as failed, only first error is kept
+    pub fn fail(&mut self, error: Error) {
+        if self.error.is_none() {
+            self.error = Some(error);
+        }
+    }
+
+    /// number of bits left to read
+    pub fn remaining(&self) -> usize {
+        self.data.len() * 8 - self.pos
+    }
+
+    pub fn finish(&self) -> Result<(), Error> {
+        match self.error {
+            Some(error) => Err(error),
+            None => Ok(()),
+        }
+    }
+}
+`
@@ -0,0 +1,836 @@
+package rust
+
+import (
+	"fmt"
+	"path/filepath"
+	"shrinken/gen"
+	"shrinken/sddl"
+	"shrinken/sddl/ast"
+	"strings"
+)
+
+// Rust backend generates one module per SDDL package, plus mod.rs declaring all of them, so generated
+// directory can be included as single module. Generated code doesn't use std, only core and alloc,
+// so crate which includes it has to declare extern crate alloc.
+// Structs become structs which implement Encode and Decode traits of runtime, enums become fieldless
+// enums. Rust has no inheritance, so derived classes contain all fields of their base classes and
+// every class which is extended gets additional <Name>Kind enum with variant for itself and each of
+// its subclasses, which is encoded with subtype tag of the variant. Class references are held as Option<Box<T>> (or Option<Box<TKind>>), so that
+// they can be null. Messages marked with delta attribute implement DeltaMessage,
+// which encodes only fields changed against baseline. Messages are also written with their IDs by
+// Message::serialize_any, which decode_any of their module dispatches to methods of its Handler.
+
+type generator struct{}
+
+func init() {
+	gen.Register("rust", &generator{})
+}
+
+func (g *generator) Generate(parsed *sddl.SDDLTree, outputPath string) error {
+	schema, err := gen.NewSchema(parsed)
+	if err != nil {
+		return err
+	}
+
+	err = gen.WriteFile(filepath.Join(outputPath, "shrinken.rs"), []byte(runtime))
+	if err != nil {
+		return err
+	}
+
+	mod := gen.NewCodeWriter("    ")
+	mod.Line("// Code generated by shrinken. DO NOT EDIT.")
+	mod.Line("//")
+	mod.Line("// Generated modules don't use std, but they need alloc crate, so crate root has to")
+	mod.Line("// declare extern crate alloc.")
+	mod.Line("")
+	mod.Line("pub mod shrinken;")
+
+	for _, pkg := range schema.Packages {
+		f := &packageFile{
+			schema: schema,
+			pkg:    pkg,
+		}
+
+		content, err := f.generate()
+		if err != nil {
+			return err
+		}
+
+		err = gen.WriteFile(filepath.Join(outputPath, moduleName(pkg)+".rs"), content)
+		if err != nil {
+			return err
+		}
+
+		mod.Line("pub mod %v;", moduleName(pkg))
+	}
+
+	return gen.WriteFile(filepath.Join(outputPath, "mod.rs"), mod.Bytes())
+}
+
+type packageFile struct {
+	schema  *gen.Schema
+	pkg     *gen.Package
+	huffman gen.HuffmanTables
+	err     error
+}
+
+func (f *packageFile) generate() ([]byte, error) {
+	w := gen.NewCodeWriter("    ")
+	w.Line("// Code generated by shrinken. DO NOT EDIT.")
+	w.Line("")
+	w.Line("#![allow(dead_code, unused_imports, non_camel_case_types, clippy::all)]")
+	w.Line("")
+	w.Line("use super::shrinken::{read_message_id, BitReader, BitWriter, Decode, Delta, DeltaMessage, Encode, Error, HuffmanCode, Message};")
+	w.Line("")
+
+	for _, e := range f.pkg.Enums {
+		f.writeEnum(w, e)
+	}
+
+	for _, s := range f.pkg.Structs {
+		f.writeStruct(w, s)
+
+		if len(s.Derived) > 0 {
+			f.writeKind(w, s)
+		}
+	}
+
+	if messages := f.pkg.Messages(); len(messages) > 0 {
+		writeDispatcher(w, messages)
+	}
+
+	for i, code := range f.huffman.Tables {
+		w.Line("/// HUFFMAN%v is Huffman code of %v", i, code.Name)
+		w.Line("static HUFFMAN%v: HuffmanCode = HuffmanCode {", i)
+		w.Indent()
+		w.Line("codes: &[%v],", gen.JoinInts(code.Codes))
+		w.Line("lengths: &[%v],", gen.JoinInts(code.Lengths))
+		w.Line("counts: &[%v],", gen.JoinInts(code.Counts))
+		w.Line("symbols: &[%v],", gen.JoinInts(code.Symbols))
+		w.Dedent()
+		w.Line("};")
+		w.Line("")
+	}
+
+	return w.Bytes(), f.err
+}
+
+func (f *packageFile) writeEnum(w *gen.CodeWriter, e *gen.Enum) {
+	name := typeName(e.ExportedName)
+
+	w.Line("#[derive(Debug, Clone, Copy, PartialEq, Eq, Hash)]")
+	w.Line("#[repr(i32)]")
+	w.Line("pub enum %v {", name)
+	w.Indent()
+	for i, value := range e.Values {
+		w.Line("%v = %v,", typeName(value), i)
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("impl Default for %v {", name)
+	w.Indent()
+	w.Line("fn default() -> Self {")
+	w.Indent()
+	w.Line("%v::%v", name, typeName(e.Values[0]))
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	codec := e.Codec()
+
+	w.Line("impl Encode for %v {", name)
+	w.Indent()
+	w.Line("fn encode(&self, w: &mut BitWriter) {")
+	w.Indent()
+	w.Line("w.write_bits(*self as u64, %v);", codec.Bits)
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	// unknown enumerals fail decoding, since they can't be represented by Rust enum
+
+	w.Line("impl %v {", name)
+	w.Indent()
+	w.Line("/// from_index returns enumeral with index, None if there is none")
+	w.Line("pub fn from_index(index: u64) -> Option<Self> {")
+	w.Indent()
+	w.Line("match index {")
+	w.Indent()
+	for i, value := range e.Values {
+		w.Line("%v => Some(%v::%v),", i, name, typeName(value))
+	}
+	w.Line("_ => None,")
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("impl Decode for %v {", name)
+	w.Indent()
+	w.Line("fn decode(r: &mut BitReader) -> Self {")
+	w.Indent()
+	w.Line("match Self::from_index(r.read_bits(%v)) {", codec.Bits)
+	w.Indent()
+	w.Line("Some(value) => value,")
+	w.Line("None => {")
+	w.Indent()
+	w.Line("r.fail(Error::InvalidValue);")
+	w.Line("%v::%v", name, typeName(e.Values[0]))
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if e.IsMessage {
+		writeMessageImpl(w, name, e.Id, e.ByteAligned)
+	}
+}
+
+func writeMessageImpl(w *gen.CodeWriter, name string, id uint16, byteAligned bool) {
+	w.Line("impl Message for %v {", name)
+	w.Indent()
+	w.Line("const MESSAGE_ID: u16 = %v;", id)
+	if byteAligned {
+		w.Line("const BYTE_ALIGNED: bool = true;")
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+}
+
+// writeDispatcher writes Handler trait with method for each message of package and decode_any, which calls
+// it with decoded message
+func writeDispatcher(w *gen.CodeWriter, messages []*gen.Message) {
+	w.Line("/// Handler handles messages decoded by decode_any")
+	w.Line("pub trait Handler {")
+	w.Indent()
+	for _, m := range messages {
+		w.Line("fn handle_%v(&mut self, message: %v) -> Result<(), Error>;", gen.SnakeCase(gen.UpperFirst(m.ExportedName)), typeName(m.ExportedName))
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("/// decode_any deserializes message written by serialize_any and passes it to handler, ID of message of")
+	w.Line("/// another package is Error::UnknownMessage")
+	w.Line("pub fn decode_any<H: Handler>(data: &[u8], handler: &mut H) -> Result<(), Error> {")
+	w.Indent()
+	w.Line("let (id, data) = read_message_id(data)?;")
+	w.Line("match id {")
+	w.Indent()
+	for _, m := range messages {
+		name := typeName(m.ExportedName)
+		w.Line("<%v as Message>::MESSAGE_ID => handler.handle_%v(%v::deserialize(data)?),", name, gen.SnakeCase(gen.UpperFirst(m.ExportedName)), name)
+	}
+	w.Line("_ => Err(Error::UnknownMessage),")
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+}
+
+func (f *packageFile) writeStruct(w *gen.CodeWriter, s *gen.Struct) {
+	name := typeName(s.ExportedName)
+	fields := s.AllFields()
+
+	w.Line("#[derive(Debug, Clone, PartialEq)]")
+	w.Line("pub struct %v {", name)
+	w.Indent()
+	for _, field := range fields {
+		w.Line("pub %v: %v,", fieldName(field.ExportedName), f.rustType(field.Codec))
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	// default is zero value, which is also encoded in place of missing class references
+
+	w.Line("impl Default for %v {", name)
+	w.Indent()
+	w.Line("fn default() -> Self {")
+	w.Indent()
+	w.Line("%v {", name)
+	w.Indent()
+	for _, field := range fields {
+		w.Line("%v: %v,", fieldName(field.ExportedName), f.zeroValue(field.Codec))
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("impl Encode for %v {", name)
+	w.Indent()
+	w.Line("fn encode(&self, w: &mut BitWriter) {")
+	w.Indent()
+	if c := s.Quaternion; c != nil {
+		q := c.Quantization
+		w.Line("w.write_quaternion([%v], %v, %v, %v, %v);", componentFields(s), q.MinString(), q.MaxString(), q.Steps, c.Bits)
+	} else if c := s.Normalized; c != nil {
+		w.Line("w.write_normalized([%v], %v, %v);", componentFields(s), c.Quantization.Steps, c.Bits)
+	} else {
+		for _, field := range fields {
+			f.encode(w, field.Codec, "self."+fieldName(field.ExportedName), 0)
+		}
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	// struct expression evaluates fields in order in which they are written, which is wire order
+
+	w.Line("impl Decode for %v {", name)
+	w.Indent()
+	w.Line("fn decode(r: &mut BitReader) -> Self {")
+	w.Indent()
+	if c := s.Quaternion; c != nil {
+		q := c.Quantization
+		w.Line("let q = r.read_quaternion(%v, %v, %v, %v);", q.MinString(), q.MaxString(), q.Steps, c.Bits)
+	} else if c := s.Normalized; c != nil {
+		w.Line("let q = r.read_normalized(%v, %v);", c.Quantization.Steps, c.Bits)
+	}
+	w.Line("%v {", name)
+	w.Indent()
+	for i, field := range fields {
+		if s.Quaternion != nil || s.Normalized != nil {
+			w.Line("%v: q[%v],", fieldName(field.ExportedName), i)
+		} else {
+			w.Line("%v: %v,", fieldName(field.ExportedName), f.decodeExpr(field.Codec))
+		}
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if s.IsMessage {
+		writeMessageImpl(w, name, s.Id, s.ByteAligned)
+	}
+
+	if s.HasDelta {
+		f.writeDelta(w, s)
+	}
+}
+
+// componentFields returns comma separated components of quaternion or normalized vector struct
+func componentFields(s *gen.Struct) string {
+	names := make([]string, len(s.Fields))
+	for i, field := range s.Fields {
+		names[i] = "self." + fieldName(field.ExportedName)
+	}
+	return strings.Join(names, ", ")
+}
+
+// writeDelta implements Delta, fields are compared as defined for delta encoding rather than by PartialEq
+func (f *packageFile) writeDelta(w *gen.CodeWriter, s *gen.Struct) {
+	name := typeName(s.ExportedName)
+	fields := s.AllFields()
+
+	w.Line("impl %v {", name)
+	w.Indent()
+	w.Line("/// changed_fields reports which fields are changed against baseline")
+	w.Line("pub fn changed_fields(&self, baseline: &Self) -> [bool; %v] {", len(fields))
+	w.Indent()
+	if len(fields) == 0 {
+		w.Line("let _ = baseline;")
+		w.Line("[]")
+	} else {
+		w.Line("[")
+		w.Indent()
+		for _, field := range fields {
+			name := fieldName(field.ExportedName)
+			w.Line("%v,", f.changed(field.Codec, "self."+name, "baseline."+name, 0))
+		}
+		w.Dedent()
+		w.Line("]")
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("impl Delta for %v {", name)
+	w.Indent()
+	w.Line("fn differs(&self, baseline: &Self) -> bool {")
+	w.Indent()
+	w.Line("self.changed_fields(baseline).iter().any(|c| *c)")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("fn encode_delta(&self, w: &mut BitWriter, baseline: &Self) {")
+	w.Indent()
+	w.Line("let changed = self.changed_fields(baseline);")
+	w.Line("for c in changed.iter() {")
+	w.Indent()
+	w.Line("w.write_bool(*c);")
+	w.Dedent()
+	w.Line("}")
+	for i, field := range fields {
+		name := fieldName(field.ExportedName)
+		w.Line("if changed[%v] {", i)
+		w.Indent()
+		if c := field.Codec; c.IsDelta() && c.Struct.IsClass {
+			def := fmt.Sprintf("&%v::default()", f.classType(c.Struct))
+			w.Line("self.%v.as_deref().unwrap_or(%v).encode_delta(w, baseline.%v.as_deref().unwrap_or(%v));", name, def, name, def)
+		} else if c.IsDelta() {
+			w.Line("self.%v.encode_delta(w, &baseline.%v);", name, name)
+		} else {
+			f.encode(w, c, "self."+name, 0)
+		}
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("fn decode_delta(&mut self, r: &mut BitReader) {")
+	w.Indent()
+	w.Line("let mut changed = [false; %v];", len(fields))
+	w.Line("for c in changed.iter_mut() {")
+	w.Indent()
+	w.Line("*c = r.read_bool();")
+	w.Dedent()
+	w.Line("}")
+	for i, field := range fields {
+		name := fieldName(field.ExportedName)
+		w.Line("if changed[%v] {", i)
+		w.Indent()
+		if c := field.Codec; c.IsDelta() && c.Struct.IsClass {
+			w.Line("self.%v.get_or_insert_with(Default::default).decode_delta(r);", name)
+		} else if c.IsDelta() {
+			w.Line("self.%v.decode_delta(r);", name)
+		} else {
+			w.Line("self.%v = %v;", name, f.decodeExpr(c))
+		}
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if s.IsDelta {
+		w.Line("impl DeltaMessage for %v {}", name)
+		w.Line("")
+	}
+}
+
+// changed returns expression telling whether value a is changed against b, as defined for delta
+// encoding. References to polymorphic classes are always changed.
+func (f *packageFile) changed(c *gen.Codec, a, b string, depth int) string {
+	switch c.Kind {
+	case gen.StructCodec:
+		if c.IsPolymorphic() {
+			return "true"
+		}
+		if c.Struct.IsClass {
+			def := fmt.Sprintf("&%v::default()", f.classType(c.Struct))
+			return fmt.Sprintf("%v.as_deref().unwrap_or(%v).differs(%v.as_deref().unwrap_or(%v))", a, def, b, def)
+		}
+		return fmt.Sprintf("%v.differs(&%v)", a, b)
+	case gen.ArrayCodec:
+		if c.Elem.Kind == gen.StructCodec && c.Elem.IsPolymorphic() {
+			return fmt.Sprintf("%v.len() != %v.len() || !%v.is_empty()", a, b, a)
+		}
+		x, y := fmt.Sprintf("x%v", depth), fmt.Sprintf("y%v", depth)
+		return fmt.Sprintf("%v.len() != %v.len() || %v.iter().zip(%v.iter()).any(|(%v, %v)| %v)", a, b, a, b, x, y, f.changed(c.Elem, x, y, depth+1))
+	}
+	return fmt.Sprintf("%v != %v", a, b)
+}
+
+// writeKind writes enum over class and all classes derived from it, variants are ordered by subtype tag
+func (f *packageFile) writeKind(w *gen.CodeWriter, s *gen.Struct) {
+	name := kindName(s)
+	classes := s.Subtypes()
+	variants := variantNames(classes)
+	bits := s.SubtypeBits()
+
+	w.Line("/// %v holds %v or any class derived from it", name, typeName(s.ExportedName))
+	w.Line("#[derive(Debug, Clone, PartialEq)]")
+	w.Line("pub enum %v {", name)
+	w.Indent()
+	for i, class := range classes {
+		w.Line("%v(%v),", variants[i], f.typeName(class.Package, typeName(class.ExportedName)))
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("impl Default for %v {", name)
+	w.Indent()
+	w.Line("fn default() -> Self {")
+	w.Indent()
+	w.Line("%v::%v(%v::default())", name, variants[0], typeName(s.ExportedName))
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	for i, class := range classes {
+		w.Line("impl From<%v> for %v {", f.typeName(class.Package, typeName(class.ExportedName)), name)
+		w.Indent()
+		w.Line("fn from(value: %v) -> Self {", f.typeName(class.Package, typeName(class.ExportedName)))
+		w.Indent()
+		w.Line("%v::%v(value)", name, variants[i])
+		w.Dedent()
+		w.Line("}")
+		w.Dedent()
+		w.Line("}")
+		w.Line("")
+	}
+
+	w.Line("impl Encode for %v {", name)
+	w.Indent()
+	w.Line("fn encode(&self, w: &mut BitWriter) {")
+	w.Indent()
+	w.Line("match self {")
+	w.Indent()
+	for tag, variant := range variants {
+		w.Line("%v::%v(value) => {", name, variant)
+		w.Indent()
+		w.Line("w.write_bits(%v, %v);", tag, bits)
+		w.Line("value.encode(w);")
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	// messages aren't tagged, so kind enum is never a message, even if its class is
+
+	w.Line("impl Decode for %v {", name)
+	w.Indent()
+	w.Line("fn decode(r: &mut BitReader) -> Self {")
+	w.Indent()
+	w.Line("match r.read_bits(%v) {", bits)
+	w.Indent()
+	for tag, class := range classes {
+		w.Line("%v => %v::%v(%v::decode(r)),", tag, name, variants[tag], f.typeName(class.Package, typeName(class.ExportedName)))
+	}
+	w.Line("_ => {")
+	w.Indent()
+	w.Line("r.fail(Error::InvalidValue);")
+	w.Line("%v::default()", name)
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+}
+
+func (f *packageFile) encode(w *gen.CodeWriter, c *gen.Codec, expr string, depth int) {
+	switch c.Kind {
+	case gen.BoolCodec:
+		w.Line("w.write_bool(%v);", expr)
+	case gen.IntCodec:
+		if c.Huffman != nil && c.Signed {
+			w.Line("w.write_signed_range_symbol(%v as i64, %v, %v, &HUFFMAN%v);", expr, c.MinString(), c.MaxString(), f.huffman.Index(c.Huffman))
+		} else if c.Huffman != nil {
+			w.Line("w.write_unsigned_range_symbol(%v as u64, %v, %v, &HUFFMAN%v);", expr, c.MinString(), c.MaxString(), f.huffman.Index(c.Huffman))
+		} else if c.Range != nil && c.Signed {
+			w.Line("w.write_signed_range(%v as i64, %v, %v, %v);", expr, c.MinString(), c.MaxString(), c.Bits)
+		} else if c.Range != nil {
+			w.Line("w.write_unsigned_range(%v as u64, %v, %v, %v);", expr, c.MinString(), c.MaxString(), c.Bits)
+		} else if c.Encoding == gen.VarintEncoding {
+			w.Line("w.write_varint(%v as u64, %v);", expr, c.Bits)
+		} else if c.Encoding == gen.ZigZagEncoding {
+			w.Line("w.write_zigzag(%v as i64);", expr)
+		} else {
+			w.Line("w.write_bits(%v as u64, %v);", expr, c.Bits)
+		}
+	case gen.CharCodec:
+		if c.IsASCII() {
+			w.Line("w.write_ascii_char(%v);", expr)
+		} else {
+			w.Line("w.write_char(%v);", expr)
+		}
+	case gen.FloatCodec:
+		if q := c.Quantization; q != nil {
+			w.Line("w.write_quantized(%v, %v, %v, %v, %v);", expr, q.MinString(), q.MaxString(), q.Steps, c.Bits)
+		} else {
+			w.Line("w.write_f%v(%v);", c.Bits, expr)
+		}
+	case gen.StringCodec:
+		if c.IsASCII() {
+			w.Line("w.write_ascii(&%v, %v, %v);", expr, c.MaxLength, c.CountBits)
+		} else {
+			w.Line("w.write_str(&%v, %v, %v);", expr, c.MaxLength, c.CountBits)
+		}
+	case gen.EnumCodec:
+		if c.Huffman != nil {
+			w.Line("w.write_symbol(%v as u64, &HUFFMAN%v);", expr, f.huffman.Index(c.Huffman))
+		} else {
+			w.Line("%v.encode(w);", expr)
+		}
+	case gen.StructCodec:
+		if c.Struct.IsClass {
+			// missing references are encoded as default values
+			w.Line("match &%v {", expr)
+			w.Indent()
+			w.Line("Some(value) => value.encode(w),")
+			w.Line("None => %v::default().encode(w),", f.classType(c.Struct))
+			w.Dedent()
+			w.Line("}")
+		} else {
+			w.Line("%v.encode(w);", expr)
+		}
+	case gen.ArrayCodec:
+		i := fmt.Sprintf("i%v", depth)
+		if c.IsDynamic() {
+			w.Line("w.write_count(%v.len(), %v, %v);", expr, c.MaxCount, c.CountBits)
+		}
+		w.Line("for %v in 0..%v.len() {", i, expr)
+		w.Indent()
+		f.encode(w, c.Elem, fmt.Sprintf("%v[%v]", expr, i), depth+1)
+		w.Dedent()
+		w.Line("}")
+	}
+}
+
+func (f *packageFile) decodeExpr(c *gen.Codec) string {
+	switch c.Kind {
+	case gen.BoolCodec:
+		return "r.read_bool()"
+	case gen.IntCodec:
+		if c.Huffman != nil && c.Signed {
+			return fmt.Sprintf("r.read_signed_range_symbol(%v, &HUFFMAN%v) as %v", c.MinString(), f.huffman.Index(c.Huffman), f.rustType(c))
+		} else if c.Huffman != nil {
+			return fmt.Sprintf("r.read_unsigned_range_symbol(%v, &HUFFMAN%v) as %v", c.MinString(), f.huffman.Index(c.Huffman), f.rustType(c))
+		} else if c.Range != nil && c.Signed {
+			return fmt.Sprintf("r.read_signed_range(%v, %v, %v) as %v", c.MinString(), c.MaxString(), c.Bits, f.rustType(c))
+		} else if c.Range != nil {
+			return fmt.Sprintf("r.read_unsigned_range(%v, %v, %v) as %v", c.MinString(), c.MaxString(), c.Bits, f.rustType(c))
+		} else if c.Encoding == gen.VarintEncoding {
+			return fmt.Sprintf("r.read_varint(%v) as %v", c.Bits, f.rustType(c))
+		} else if c.Encoding == gen.ZigZagEncoding {
+			return fmt.Sprintf("r.read_zigzag(%v) as %v", c.Bits, f.rustType(c))
+		}
+		return fmt.Sprintf("r.read_bits(%v) as %v", c.Bits, f.rustType(c))
+	case gen.CharCodec:
+		if c.IsASCII() {
+			return "r.read_ascii_char()"
+		}
+		return "r.read_char()"
+	case gen.FloatCodec:
+		if q := c.Quantization; q != nil {
+			return fmt.Sprintf("r.read_quantized(%v, %v, %v, %v)", q.MinString(), q.MaxString(), q.Steps, c.Bits)
+		}
+		return fmt.Sprintf("r.read_f%v()", c.Bits)
+	case gen.StringCodec:
+		if c.IsASCII() {
+			return fmt.Sprintf("r.read_ascii(%v, %v)", c.MaxLength, c.CountBits)
+		}
+		return fmt.Sprintf("r.read_string(%v, %v)", c.MaxLength, c.CountBits)
+	case gen.EnumCodec:
+		if c.Huffman != nil {
+			// every symbol of code is enumeral
+			return fmt.Sprintf("%v::from_index(r.read_symbol(&HUFFMAN%v)).unwrap_or_default()", f.rustType(c), f.huffman.Index(c.Huffman))
+		}
+		return f.rustType(c) + "::decode(r)"
+	case gen.StructCodec:
+		if c.Struct.IsClass {
+			return fmt.Sprintf("Some(alloc::boxed::Box::new(%v::decode(r)))", f.classType(c.Struct))
+		}
+		return f.rustType(c) + "::decode(r)"
+	case gen.ArrayCodec:
+		if c.IsDynamic() {
+			return fmt.Sprintf("r.read_vec(%v, %v, |r| %v)", c.MaxCount, c.CountBits, f.decodeExpr(c.Elem))
+		}
+		return fmt.Sprintf("core::array::from_fn(|_| %v)", f.decodeExpr(c.Elem))
+	}
+	return ""
+}
+
+func (f *packageFile) rustType(c *gen.Codec) string {
+	switch c.Kind {
+	case gen.ArrayCodec:
+		if c.IsDynamic() {
+			return fmt.Sprintf("alloc::vec::Vec<%v>", f.rustType(c.Elem))
+		}
+		return fmt.Sprintf("[%v; %v]", f.rustType(c.Elem), c.Size)
+	case gen.StructCodec:
+		if c.Struct.IsClass {
+			return fmt.Sprintf("Option<alloc::boxed::Box<%v>>", f.classType(c.Struct))
+		}
+		return f.typeName(c.Struct.Package, typeName(c.Struct.ExportedName))
+	case gen.EnumCodec:
+		return f.typeName(c.Enum.Package, typeName(c.Enum.ExportedName))
+	}
+
+	switch c.Type.GenericType {
+	case ast.Integer32:
+		return "i32"
+	case ast.Integer64:
+		return "i64"
+	case ast.Short:
+		return "i16"
+	case ast.UnsignedInteger32:
+		return "u32"
+	case ast.UnsignedInteger64:
+		return "u64"
+	case ast.UnsignedShort:
+		return "u16"
+	case ast.Byte:
+		return "u8"
+	case ast.Bool:
+		return "bool"
+	case ast.String:
+		return "alloc::string::String"
+	case ast.Char:
+		return "char"
+	case ast.Float:
+		return "f32"
+	case ast.Double:
+		return "f64"
+	}
+
+	f.err = fmt.Errorf("Rust backend doesn't support type %v", c.Type.GenericType.String())
+	return ""
+}
+
+func (f *packageFile) zeroValue(c *gen.Codec) string {
+	switch c.Kind {
+	case gen.ArrayCodec:
+		if c.IsDynamic() {
+			return "alloc::vec::Vec::new()"
+		}
+		return fmt.Sprintf("core::array::from_fn(|_| %v)", f.zeroValue(c.Elem))
+	case gen.StructCodec:
+		if c.Struct.IsClass {
+			return "None"
+		}
+		return f.rustType(c) + "::default()"
+	case gen.EnumCodec:
+		return f.rustType(c) + "::default()"
+	case gen.BoolCodec:
+		return "false"
+	case gen.StringCodec:
+		return "alloc::string::String::new()"
+	case gen.CharCodec:
+		return "'\\0'"
+	case gen.FloatCodec:
+		return "0.0"
+	}
+	return "0"
+}
+
+// classType returns type which holds class, which is kind enum if class is extended
+func (f *packageFile) classType(s *gen.Struct) string {
+	if len(s.Derived) > 0 {
+		return f.typeName(s.Package, kindName(s))
+	}
+	return f.typeName(s.Package, typeName(s.ExportedName))
+}
+
+// typeName returns name qualified by module path if it's declared in other package
+func (f *packageFile) typeName(pkg *gen.Package, name string) string {
+	if pkg == f.pkg {
+		return name
+	}
+	return "super::" + moduleName(pkg) + "::" + name
+}
+
+func kindName(s *gen.Struct) string {
+	return typeName(s.ExportedName) + "Kind"
+}
+
+// variantNames returns names of kind enum variants, which are qualified by package name
+// only when classes from different packages share the same name
+func variantNames(classes []*gen.Struct) []string {
+	count := make(map[string]int)
+	for _, class := range classes {
+		count[typeName(class.ExportedName)]++
+	}
+
+	names := make([]string, len(classes))
+	for i, class := range classes {
+		name := typeName(class.ExportedName)
+		if count[name] > 1 {
+			name = ""
+			for _, part := range strings.Split(class.Package.ExportedName, ".") {
+				name += gen.UpperFirst(part)
+			}
+			name += typeName(class.ExportedName)
+		}
+		names[i] = name
+	}
+	return names
+}
+
+// moduleName returns name of module and its file, which can't be raw identifier
+func moduleName(pkg *gen.Package) string {
+	name := strings.ToLower(strings.Replace(pkg.ExportedName, ".", "_", -1))
+	if keywords[name] || name == "self" || name == "super" || name == "crate" || name == "shrinken" {
+		return name + "_"
+	}
+	return name
+}
+
+func typeName(name string) string {
+	return identifier(gen.UpperFirst(name))
+}
+
+func fieldName(name string) string {
+	return identifier(gen.SnakeCase(name))
+}
+
+// identifier escapes names which are reserved in Rust. Most keywords can be used as raw
+// identifiers, but those which can't get underscore appended.
+func identifier(name string) string {
+	switch {
+	case name == "self" || name == "Self" || name == "super" || name == "crate":
+		return name + "_"
+	case keywords[name]:
+		return "r#" + name
+	}
+	return name
+}
+
+var keywords = map[string]bool{
+	"as": true, "async": true, "await": true, "break": true, "const": true, "continue": true,
+	"dyn": true, "else": true, "enum": true, "extern": true, "false": true, "fn": true, "for": true,
+	"if": true, "impl": true, "in": true, "let": true, "loop": true, "match": true, "mod": true,
+	"move": true, "mut": true, "pub": true, "ref": true, "return": true, "static": true,
+	"struct": true, "trait": true, "true": true, "type": true, "unsafe": true, "use": true,
+	"where": true, "while": true, "abstract": true, "become": true, "box": true, "do": true,
+	"final": true, "macro": true, "override": true, "priv": true, "typeof": true, "unsized": true,
+	"virtual": true, "yield": true, "try": true,
+}
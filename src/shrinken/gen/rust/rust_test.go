@@ -0,0 +1,228 @@
+package rust
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"shrinken/gen"
+	"shrinken/wire/wiretest"
+	"strings"
+	"testing"
+)
+
+func generate(t *testing.T, filename string) string {
+	tree := wiretest.Parse(t, filename)
+
+	dir, err := ioutil.TempDir("", "shrinken")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = gen.Generate(tree, "rust", dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal("Rust code couldn't be generated!", err)
+	}
+
+	return dir
+}
+
+func readGenerated(t *testing.T, filename string) string {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal("Generated file is missing!", err)
+	}
+	return string(b)
+}
+
+func TestBasic(t *testing.T) {
+	dir := generate(t, "../../sddl/test_data/single_file/basic.sddl")
+	defer os.RemoveAll(dir)
+
+	readGenerated(t, filepath.Join(dir, "shrinken.rs"))
+
+	mod := readGenerated(t, filepath.Join(dir, "mod.rs"))
+	if !strings.Contains(mod, "pub mod com_github_namespace;") {
+		t.Fatal("Module of package is not declared in mod.rs")
+	}
+
+	code := readGenerated(t, filepath.Join(dir, "com_github_namespace.rs"))
+
+	expected := []string{
+		"#[derive(Debug, Clone, PartialEq)]\npub struct Player {",
+		"pub enum EntityKind {",
+		"Player(Player),",
+		"impl Message for Player {\n    const MESSAGE_ID: u16 = ",
+		"fn handle_player(&mut self, message: Player) -> Result<(), Error>;",
+		"pub pos: Vector3,",
+		"pub rot: Quaternion,",
+	}
+	for _, e := range expected {
+		if !strings.Contains(code, e) {
+			t.Fatalf("Generated code doesn't contain %q", e)
+		}
+	}
+
+	// Player isn't extended, so it doesn't need kind enum
+	if strings.Contains(code, "PlayerKind") {
+		t.Fatal("Kind enum is generated for class which isn't extended")
+	}
+}
+
+func TestMultiplePackages(t *testing.T) {
+	dir := generate(t, "../../sddl/test_data/multipkg/same_name/")
+	defer os.RemoveAll(dir)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.rs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// runtime, mod.rs and one module per package
+	if len(files) < 4 {
+		t.Fatalf("Expected modules for multiple packages, got %v", files)
+	}
+}
+
+// noStdLib is crate root which includes generated modules without std
+const noStdLib = `#![no_std]
+
+extern crate alloc;
+
+#[path = "mod.rs"]
+pub mod generated;
+`
+
+func TestNoStd(t *testing.T) {
+	rustc := wiretest.Tool(t, "rustc")
+
+	// conformance schema has quaternion and normalized structs, whose runtime functions need square root
+	dir := generate(t, wiretest.Schema)
+	defer os.RemoveAll(dir)
+
+	err := gen.WriteFile(filepath.Join(dir, "lib.rs"), []byte(noStdLib))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lib := filepath.Join(dir, "libgenerated.rlib")
+	wiretest.Build(t, exec.Command(rustc, "--edition", "2018", "--crate-type", "rlib", "-D", "warnings", "-o", lib, filepath.Join(dir, "lib.rs")))
+}
+
+const conformanceMain = `extern crate alloc;
+
+#[path = "mod.rs"]
+mod generated;
+
+use generated::conformance::{Aligned, Bulk, Coded, Handler, Sample};
+use generated::shrinken::{DeltaMessage, Error, Message};
+
+/// Printer prints every handled message serialized with its ID
+struct Printer;
+
+impl Handler for Printer {
+    fn handle_sample(&mut self, message: Sample) -> Result<(), Error> {
+        print_hex(&message.serialize_any()?);
+        Ok(())
+    }
+
+    fn handle_aligned(&mut self, message: Aligned) -> Result<(), Error> {
+        print_hex(&message.serialize_any()?);
+        Ok(())
+    }
+
+    fn handle_coded(&mut self, message: Coded) -> Result<(), Error> {
+        print_hex(&message.serialize_any()?);
+        Ok(())
+    }
+
+    fn handle_bulk(&mut self, message: Bulk) -> Result<(), Error> {
+        print_hex(&message.serialize_any()?);
+        Ok(())
+    }
+}
+
+fn read_hex() -> Vec<u8> {
+    let mut input = String::new();
+    std::io::stdin().read_line(&mut input).unwrap();
+    let input = input.trim();
+
+    (0..input.len())
+        .step_by(2)
+        .map(|i| u8::from_str_radix(&input[i..i + 2], 16).unwrap())
+        .collect()
+}
+
+fn print_hex(data: &[u8]) {
+    for b in data {
+        print!("{:02x}", b);
+    }
+    println!();
+}
+
+fn main() {
+    let data = read_hex();
+    if std::env::args().nth(1).as_deref() == Some("any") {
+        let mut data = data;
+        while !data.is_empty() {
+            generated::conformance::decode_any(&data, &mut Printer).expect("decode_any failed");
+            data = read_hex();
+        }
+        return;
+    }
+
+    if std::env::args().nth(1).as_deref() == Some("truncated") {
+        match Bulk::deserialize(&data) {
+            Ok(_) => println!("ok"),
+            Err(e) => println!("{}", e),
+        }
+        return;
+    }
+
+    if std::env::args().nth(1).as_deref() == Some("aligned") {
+        let aligned = generated::conformance::Aligned::deserialize(&data).expect("deserialize failed");
+        print_hex(&aligned.serialize().expect("serialize failed"));
+        return;
+    }
+
+    if std::env::args().nth(1).as_deref() == Some("coded") {
+        let coded = generated::conformance::Coded::deserialize(&data).expect("deserialize failed");
+        print_hex(&coded.serialize().expect("serialize failed"));
+        return;
+    }
+
+    let mut sample = generated::conformance::Sample::deserialize(&data).expect("deserialize failed");
+
+    if std::env::args().nth(1).as_deref() == Some("delta") {
+        let baseline = sample.clone();
+        sample.deserialize_delta(&read_hex()).expect("deserialize_delta failed");
+        print_hex(&sample.serialize().expect("serialize failed"));
+        print_hex(&sample.serialize_delta(&baseline).expect("serialize_delta failed"));
+        return;
+    }
+
+    print_hex(&sample.serialize().expect("serialize failed"));
+}
+`
+
+func TestConformance(t *testing.T) {
+	rustc := wiretest.Tool(t, "rustc")
+
+	dir := generate(t, wiretest.Schema)
+	defer os.RemoveAll(dir)
+
+	err := gen.WriteFile(filepath.Join(dir, "main.rs"), []byte(conformanceMain))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	program := filepath.Join(dir, "conformance")
+	wiretest.Build(t, exec.Command(rustc, "--edition", "2018", "-D", "warnings", "-o", program, filepath.Join(dir, "main.rs")))
+	wiretest.Run(t, exec.Command(program))
+	wiretest.RunDelta(t, exec.Command(program))
+	wiretest.RunAligned(t, exec.Command(program))
+	wiretest.RunCoded(t, exec.Command(program))
+	wiretest.RunAny(t, exec.Command(program))
+	wiretest.RunTruncated(t, exec.Command(program))
+}
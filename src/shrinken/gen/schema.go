@@ -0,0 +1,495 @@
+package gen
+
+import (
+	"fmt"
+	"math/bits"
+	"shrinken/sddl"
+	"shrinken/sddl/ast"
+	"shrinken/sddl/ast/attributes"
+	"sort"
+)
+
+// Schema is language neutral view of analyzed SDDL tree which backends generate code from.
+// It resolves exported names, links structs to their base structs and decides how each
+// variable is laid out on the wire, so backends only have to translate it to target language.
+
+type Schema struct {
+	Packages []*Package
+
+	structs map[*ast.StructDef]*Struct
+	enums   map[*ast.EnumDef]*Enum
+}
+
+type Package struct {
+	Def          *ast.PackageDef
+	Name         string
+	ExportedName string
+	Structs      []*Struct
+	Enums        []*Enum
+}
+
+type Struct struct {
+	Def          *ast.StructDef
+	Package      *Package
+	Name         string
+	ExportedName string
+	IsClass      bool
+	IsMessage    bool
+	Id           uint16 // ID of message among messages of all packages
+	IsDelta      bool   // message which can be written as delta against baseline instance
+	HasDelta     bool   // delta functions are generated, for delta messages and structs compared by them
+	ByteAligned  bool   // message which pads every bit field to whole bytes
+	IsEntropy    bool   // message which can write its enum and small ranged integer fields as Huffman codes
+	Quaternion   *Codec // codec of three smallest components of quaternion struct, nil for other structs
+	Normalized   *Codec // codec of both octahedral coordinates of normalized vector struct, nil for other structs
+	Base         *Struct
+	Derived      []*Struct // structs which directly extend this one, from all packages
+	Fields       []*Field  // only fields declared in this struct, see AllFields
+}
+
+type Enum struct {
+	Def          *ast.EnumDef
+	Package      *Package
+	Name         string
+	ExportedName string
+	IsMessage    bool
+	Id           uint16 // ID of message among messages of all packages
+	ByteAligned  bool   // message which pads every bit field to whole bytes
+	Values       []string
+}
+
+// Message is struct, class or enum with message attribute, exactly one of Struct and Enum is set
+type Message struct {
+	Name         string
+	ExportedName string
+	Id           uint16
+	Struct       *Struct
+	Enum         *Enum
+}
+
+type Field struct {
+	Def          *ast.Variable
+	Name         string
+	ExportedName string
+	Type         *ast.VariableType
+	Codec        *Codec
+}
+
+func NewSchema(parsed *sddl.SDDLTree) (*Schema, error) {
+	schema := &Schema{
+		Packages: make([]*Package, 0, len(parsed.Packages)),
+		structs:  make(map[*ast.StructDef]*Struct),
+		enums:    make(map[*ast.EnumDef]*Enum),
+	}
+
+	// first pass creates all types, so that second pass can link them regardless of declaration order
+
+	for _, pkgDef := range parsed.Packages {
+		pkg := &Package{
+			Def:          pkgDef,
+			Name:         pkgDef.Name,
+			ExportedName: ExportedName(pkgDef.AttributesList, pkgDef.Name),
+			Structs:      make([]*Struct, 0),
+			Enums:        make([]*Enum, 0),
+		}
+		schema.Packages = append(schema.Packages, pkg)
+
+		for _, elem := range pkgDef.Body.Elements {
+			switch def := elem.(type) {
+			case *ast.StructDef:
+				s := &Struct{
+					Def:          def,
+					Package:      pkg,
+					Name:         def.Name,
+					ExportedName: ExportedName(def.AttributesList, def.Name),
+					IsClass:      def.IsClass,
+					IsMessage:    IsMessage(def.AttributesList),
+					Id:           attributes.MessageId(pkg.Name+"."+def.Name, def.AttributesList),
+					IsDelta:      IsDelta(def.AttributesList),
+					ByteAligned:  IsByteAligned(def.AttributesList, pkgDef.AttributesList),
+					IsEntropy:    IsEntropy(def.AttributesList),
+					Quaternion:   QuaternionCodec(def.AttributesList),
+					Normalized:   NormalizedCodec(def.AttributesList),
+				}
+				if s.IsEntropy && s.ByteAligned {
+					return nil, fmt.Errorf("Message %v.%v on %v can't be both byte aligned and entropy coded", pkg.Name, s.Name,
+						def.Position.String())
+				}
+				pkg.Structs = append(pkg.Structs, s)
+				schema.structs[def] = s
+			case *ast.EnumDef:
+				if len(def.Body.Enumerals) == 0 {
+					return nil, fmt.Errorf("Enum %v.%v on %v has no enumerals", pkg.Name, def.Name, def.Position.String())
+				}
+
+				e := &Enum{
+					Def:          def,
+					Package:      pkg,
+					Name:         def.Name,
+					ExportedName: ExportedName(def.AttributesList, def.Name),
+					IsMessage:    IsMessage(def.AttributesList),
+					Id:           attributes.MessageId(pkg.Name+"."+def.Name, def.AttributesList),
+					ByteAligned:  IsByteAligned(def.AttributesList, pkgDef.AttributesList),
+					Values:       make([]string, len(def.Body.Enumerals)),
+				}
+				for i, enumeral := range def.Body.Enumerals {
+					e.Values[i] = enumeral.Name
+				}
+				pkg.Enums = append(pkg.Enums, e)
+				schema.enums[def] = e
+			}
+		}
+	}
+
+	// codecs of class references depend on derived classes, so all of them have to be linked first
+
+	for _, pkg := range schema.Packages {
+		for _, s := range pkg.Structs {
+			if s.Def.OverridesTypeDef != nil {
+				s.Base = schema.structs[s.Def.OverridesTypeDef.(*ast.StructDef)]
+				if s.Base.Quaternion != nil || s.Base.Normalized != nil {
+					return nil, fmt.Errorf("Struct %v.%v on %v extends quaternion or normalized struct %v", pkg.Name, s.Name,
+						s.Def.Position.String(), s.Base.QualifiedName())
+				}
+				s.Base.Derived = append(s.Base.Derived, s)
+			}
+		}
+	}
+
+	// messages inherit serializers of messages they extend in some languages
+	for _, pkg := range schema.Packages {
+		for _, s := range pkg.Structs {
+			for base := s.Base; base != nil && s.IsMessage; base = base.Base {
+				if base.IsMessage && base.ByteAligned != s.ByteAligned {
+					return nil, fmt.Errorf("Message %v.%v on %v has different alignment than message %v it extends", pkg.Name, s.Name,
+						s.Def.Position.String(), base.QualifiedName())
+				}
+			}
+		}
+	}
+
+	for _, pkg := range schema.Packages {
+		for _, s := range pkg.Structs {
+			s.Fields = make([]*Field, len(s.Def.Body.Variables))
+			for i, variable := range s.Def.Body.Variables {
+				codec, err := schema.newCodec(variable.Type, variable.AttributesList)
+				if err != nil {
+					return nil, fmt.Errorf("%v (variable %v of %v.%v on %v)", err, variable.Name, pkg.Name, s.Name, variable.Position.String())
+				}
+
+				s.Fields[i] = &Field{
+					Def:          variable,
+					Name:         variable.Name,
+					ExportedName: ExportedName(variable.AttributesList, variable.Name),
+					Type:         variable.Type,
+					Codec:        codec,
+				}
+			}
+
+			if s.IsEntropy {
+				err := s.applyCodeLengths()
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	// sizes of fields are known once all structs have their fields
+	for _, pkg := range schema.Packages {
+		for _, s := range pkg.Structs {
+			for _, field := range s.Fields {
+				err := checkEmptyElements(field.Codec)
+				if err != nil {
+					return nil, fmt.Errorf("%v (variable %v of %v.%v on %v)", err, field.Name, pkg.Name, s.Name, field.Def.Position.String())
+				}
+			}
+		}
+	}
+
+	for _, pkg := range schema.Packages {
+		for _, s := range pkg.Structs {
+			if s.IsDelta {
+				s.markDelta()
+			}
+		}
+	}
+
+	return schema, nil
+}
+
+// MaxEmptyElements limits maxCount of dynamic array of elements which take no bits, decoders can't reject
+// its length by remaining data, so only maxCount keeps them from allocating billions of elements
+const MaxEmptyElements = 1 << 16
+
+// checkEmptyElements checks that dynamic arrays of elements which take no bits have small enough maxCount
+func checkEmptyElements(c *Codec) error {
+	for ; c.Kind == ArrayCodec; c = c.Elem {
+		if c.IsDynamic() && c.Elem.MinBits() == 0 && c.MaxCount > MaxEmptyElements {
+			return fmt.Errorf("Dynamic array of elements which take no bits needs maxCount of at most %v", MaxEmptyElements)
+		}
+	}
+	return nil
+}
+
+// markDelta marks struct and all structs which its fields hold as having delta functions, references to
+// polymorphic classes are always written whole, so their classes aren't marked
+func (s *Struct) markDelta() {
+	if s.HasDelta {
+		return
+	}
+	s.HasDelta = true
+
+	for _, field := range s.AllFields() {
+		c := field.Codec
+		for c.Kind == ArrayCodec {
+			c = c.Elem
+		}
+		if c.Kind == StructCodec && !c.IsPolymorphic() {
+			c.Struct.markDelta()
+		}
+	}
+}
+
+// applyCodeLengths sets code lengths from entropy attribute to codecs of fields of message
+func (s *Struct) applyCodeLengths() error {
+	names := make([]string, 0)
+	for name := range entropyAttribute(s).CodeLengths {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		var field *Field
+		for _, f := range s.Fields {
+			if f.Name == name {
+				field = f
+			}
+		}
+		if field == nil || field.Codec.EntropySymbols() == 0 {
+			return fmt.Errorf("Message %v on %v has no field %v which can be entropy coded", s.QualifiedName(), s.Def.Position.String(), name)
+		}
+
+		lengths := entropyAttribute(s).CodeLengths[name]
+		err := checkCodeLengths(lengths, field.Codec.EntropySymbols())
+		if err != nil {
+			return fmt.Errorf("%v (variable %v of %v on %v)", err, name, s.QualifiedName(), field.Def.Position.String())
+		}
+		field.Codec.Huffman = NewHuffmanTable(s.QualifiedName()+"."+name, lengths)
+	}
+	return nil
+}
+
+// EntropyFields returns fields of message which can be entropy coded, only enum and ranged integer fields
+// declared in message itself can be
+func (s *Struct) EntropyFields() []*Field {
+	fields := make([]*Field, 0)
+	if !s.IsEntropy {
+		return fields
+	}
+	for _, field := range s.Fields {
+		if field.Codec.EntropySymbols() > 0 {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// Messages returns messages of package, enums first and then structs, both in order of their declarations
+func (pkg *Package) Messages() []*Message {
+	messages := make([]*Message, 0)
+	for _, e := range pkg.Enums {
+		if e.IsMessage {
+			messages = append(messages, &Message{Name: e.Name, ExportedName: e.ExportedName, Id: e.Id, Enum: e})
+		}
+	}
+	for _, s := range pkg.Structs {
+		if s.IsMessage {
+			messages = append(messages, &Message{Name: s.Name, ExportedName: s.ExportedName, Id: s.Id, Struct: s})
+		}
+	}
+	return messages
+}
+
+// StructOf returns schema struct for struct definition linked by analyzer
+func (schema *Schema) StructOf(def ast.TypeDefinition) *Struct {
+	s, _ := def.(*ast.StructDef)
+	return schema.structs[s]
+}
+
+// EnumOf returns schema enum for enum definition linked by analyzer
+func (schema *Schema) EnumOf(def ast.TypeDefinition) *Enum {
+	e, _ := def.(*ast.EnumDef)
+	return schema.enums[e]
+}
+
+// AllFields returns fields of struct including inherited ones, in wire order (base struct fields first)
+func (s *Struct) AllFields() []*Field {
+	if s.Base == nil {
+		return s.Fields
+	}
+
+	return append(append(make([]*Field, 0), s.Base.AllFields()...), s.Fields...)
+}
+
+// Descendants returns all structs which directly or indirectly extend this one
+func (s *Struct) Descendants() []*Struct {
+	descendants := make([]*Struct, 0)
+	for _, derived := range s.Derived {
+		descendants = append(descendants, derived)
+		descendants = append(descendants, derived.Descendants()...)
+	}
+	return descendants
+}
+
+// Subtypes returns class followed by all classes derived from it, ordered by their qualified names.
+// Index in this list is subtype tag, which is written before value of class reference.
+func (s *Struct) Subtypes() []*Struct {
+	descendants := s.Descendants()
+	sort.Slice(descendants, func(i, j int) bool {
+		return descendants[i].QualifiedName() < descendants[j].QualifiedName()
+	})
+	return append([]*Struct{s}, descendants...)
+}
+
+// SubtypeBits returns width of subtype tag of references to class
+func (s *Struct) SubtypeBits() int {
+	return bits.Len(uint(len(s.Subtypes()) - 1))
+}
+
+// QualifiedName returns name of struct in SDDL, prefixed by its package (game.net.Player)
+func (s *Struct) QualifiedName() string {
+	return s.Package.Name + "." + s.Name
+}
+
+// Depth returns number of base structs of struct
+func (s *Struct) Depth() int {
+	depth := 0
+	for b := s.Base; b != nil; b = b.Base {
+		depth++
+	}
+	return depth
+}
+
+// DerivedFirst returns indices of subtypes ordered so that every class comes before its base
+// classes, which is order of instance checks finding the most derived subtype of value
+func DerivedFirst(subtypes []*Struct) []int {
+	indices := make([]int, len(subtypes))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return subtypes[indices[i]].Depth() > subtypes[indices[j]].Depth()
+	})
+	return indices
+}
+
+// Root returns first struct in inheritance chain
+func (s *Struct) Root() *Struct {
+	for s.Base != nil {
+		s = s.Base
+	}
+	return s
+}
+
+// ExportedName returns name set by exportAs attribute, or default name if there is none
+func ExportedName(attributesList []ast.Attribute, name string) string {
+	for _, attb := range attributesList {
+		if exportAs, ok := attb.(*attributes.ExportAsAttribute); ok {
+			return exportAs.ExportedName
+		}
+	}
+	return name
+}
+
+func IsDelta(attributesList []ast.Attribute) bool {
+	for _, attb := range attributesList {
+		if _, ok := attb.(*attributes.DeltaAttribute); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func IsEntropy(attributesList []ast.Attribute) bool {
+	for _, attb := range attributesList {
+		if _, ok := attb.(*attributes.EntropyAttribute); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// IsByteAligned reports whether message pads every bit field to whole bytes, by its own alignment attribute
+// or by the one of its package
+func IsByteAligned(attributesList []ast.Attribute, pkgAttributesList []ast.Attribute) bool {
+	if !IsMessage(attributesList) {
+		return false
+	}
+	for _, list := range [][]ast.Attribute{attributesList, pkgAttributesList} {
+		for _, attb := range list {
+			if alignment, ok := attb.(*attributes.AlignmentAttribute); ok {
+				return alignment.Alignment == "byte"
+			}
+		}
+	}
+	return false
+}
+
+// QuaternionCodec returns codec of quaternion components written on the wire, if quaternion attribute is set
+func QuaternionCodec(attributesList []ast.Attribute) *Codec {
+	var quaternion *attributes.QuaternionAttribute
+	var precision *attributes.PrecisionAttribute
+	for _, attb := range attributesList {
+		switch a := attb.(type) {
+		case *attributes.QuaternionAttribute:
+			quaternion = a
+		case *attributes.PrecisionAttribute:
+			precision = a
+		}
+	}
+	if quaternion == nil || precision == nil {
+		return nil
+	}
+
+	codec := &Codec{
+		Kind: FloatCodec,
+		Type: &ast.VariableType{IsGeneric: true, GenericType: ast.Float},
+		Quantization: &Quantization{
+			Min:   attributes.QuaternionRange.LowerBound,
+			Max:   attributes.QuaternionRange.UpperBound,
+			Steps: precision.Steps(attributes.QuaternionRange),
+		},
+	}
+	codec.Bits = bits.Len64(codec.Quantization.Steps)
+	return codec
+}
+
+// NormalizedCodec returns codec of octahedral coordinates written on the wire, if normalized attribute is set
+func NormalizedCodec(attributesList []ast.Attribute) *Codec {
+	for _, attb := range attributesList {
+		if n, ok := attb.(*attributes.NormalizedAttribute); ok {
+			// even number of steps keeps zero exact
+			return &Codec{
+				Kind: FloatCodec,
+				Type: &ast.VariableType{IsGeneric: true, GenericType: ast.Float},
+				Bits: int(n.Bits),
+				Quantization: &Quantization{
+					Min:   -1,
+					Max:   1,
+					Steps: 1<<uint(n.Bits) - 2,
+				},
+			}
+		}
+	}
+	return nil
+}
+
+func IsMessage(attributesList []ast.Attribute) bool {
+	for _, attb := range attributesList {
+		if _, ok := attb.(*attributes.MessageAttribute); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,542 @@
+package typescript
+
+// runtime is written to shrinken.ts next to generated files
+
+const runtime = `// Code generated by shrinken. DO NOT EDIT.
+
+export class ShrinkenError extends Error {
+    constructor(message: string) {
+        super(message);
+        this.name = "ShrinkenError";
+    }
+}
+
+const scratch = new DataView(new ArrayBuffer(8));
+const utf8Encoder = new TextEncoder();
+const utf8Decoder = new TextDecoder("utf-8");
+
+// signNotZero returns -1 for negative values and 1 for others, including negative zero
+function signNotZero(value: number): number {
+    return value < 0 ? -1 : 1;
+}
+
+// HuffmanCode is canonical Huffman code of values 0 to codes.length-1. Code of value is written as single
+// lengths[value] wide field codes[value], first bit of code lowest. counts holds number of codes of each length
+// up to the longest one and symbols values ordered by their codes, for reading codes bit by bit.
+export interface HuffmanCode {
+    codes: number[];
+    lengths: number[];
+    counts: number[];
+    symbols: number[];
+}
+
+// BitWriter writes values as little-endian bit fields, starting from least significant bit of first byte
+export class BitWriter {
+    // byteAligned pads every following bit field with zero bits to whole bytes
+    byteAligned: boolean = false;
+    private buf: Uint8Array;
+    private pos: number = 0;
+
+    constructor(capacity: number = 64) {
+        this.buf = new Uint8Array(Math.max(capacity, 1));
+    }
+
+    // writeBits writes lowest bits (up to 32) of value
+    writeBits(value: number, bits: number): void {
+        this.grow(bits);
+        while (bits > 0) {
+            const used = this.pos & 7;
+            let n = 8 - used;
+            if (bits < n) {
+                n = bits;
+            }
+
+            this.buf[this.pos >>> 3] |= (value & ((1 << n) - 1)) << used;
+            value = value >>> n;
+            bits -= n;
+            this.pos += n;
+        }
+
+        if (this.byteAligned) {
+            this.pos = (this.pos + 7) & ~7;
+        }
+    }
+
+    // writeBigBits writes lowest bits (up to 64) of value
+    writeBigBits(value: bigint, bits: number): void {
+        const v = BigInt.asUintN(64, value);
+        if (bits <= 32) {
+            this.writeBits(Number(v & 0xFFFFFFFFn), bits);
+            return;
+        }
+        this.writeBits(Number(v & 0xFFFFFFFFn), 32);
+        this.writeBits(Number((v >> 32n) & 0xFFFFFFFFn), bits - 32);
+    }
+
+    // writeRange writes value clamped to [min, max] as offset from min
+    writeRange(value: number, min: number, max: number, bits: number): void {
+        this.writeBits(Math.min(Math.max(value, min), max) - min, bits);
+    }
+
+    // writeBigRange writes value clamped to [min, max] as offset from min
+    writeBigRange(value: bigint, min: bigint, max: bigint, bits: number): void {
+        const clamped = value < min ? min : value > max ? max : value;
+        this.writeBigBits(clamped - min, bits);
+    }
+
+    // writeSymbol writes Huffman code of value, value without code is invalid
+    writeSymbol(value: number, code: HuffmanCode): void {
+        if (!Number.isInteger(value) || value < 0 || value >= code.codes.length) {
+            throw new ShrinkenError("shrinken: " + value + " has no Huffman code");
+        }
+        this.writeBits(code.codes[value], code.lengths[value]);
+    }
+
+    // writeRangeSymbol writes value clamped to [min, max] as Huffman code of offset from min
+    writeRangeSymbol(value: number, min: number, max: number, code: HuffmanCode): void {
+        this.writeSymbol(Math.min(Math.max(value, min), max) - min, code);
+    }
+
+    // writeBigRangeSymbol writes value clamped to [min, max] as Huffman code of offset from min
+    writeBigRangeSymbol(value: bigint, min: bigint, max: bigint, code: HuffmanCode): void {
+        const clamped = value < min ? min : value > max ? max : value;
+        this.writeSymbol(Number(clamped - min), code);
+    }
+
+    // writeVarint writes lowest bits (up to 32) of value in groups of 7 bits, starting from the lowest one,
+    // each one as 8 bit field with highest bit set if another group follows
+    writeVarint(value: number, bits: number): void {
+        let v = bits < 32 ? value & ((1 << bits) - 1) : value >>> 0;
+        while (v >= 0x80) {
+            this.writeBits((v & 0x7F) | 0x80, 8);
+            v >>>= 7;
+        }
+        this.writeBits(v, 8);
+    }
+
+    // writeBigVarint writes lowest bits (up to 64) of value as varint
+    writeBigVarint(value: bigint, bits: number): void {
+        let v = BigInt.asUintN(bits, value);
+        while (v >= 0x80n) {
+            this.writeBits(Number(v & 0x7Fn) | 0x80, 8);
+            v >>= 7n;
+        }
+        this.writeBits(Number(v), 8);
+    }
+
+    // writeZigZag maps 0, -1, 1, -2... to 0, 1, 2, 3... and writes it as varint
+    writeZigZag(value: number): void {
+        this.writeVarint((value << 1) ^ (value >> 31), 32);
+    }
+
+    writeBigZigZag(value: bigint): void {
+        this.writeBigVarint((value << 1n) ^ (value >> 63n), 64);
+    }
+
+    // writeQuantized writes float value clamped to [min, max] as the nearest of steps equal steps from min
+    writeQuantized(value: number, min: number, max: number, steps: number, bits: number): void {
+        let v = Math.fround(value);
+        // NaN is written as min
+        if (!(v >= min)) {
+            v = min;
+        } else if (v > max) {
+            v = max;
+        }
+        this.writeBits(Math.floor((v - min) * steps / (max - min) + 0.5), bits);
+    }
+
+    // writeQuaternion writes 2 bit index of the largest component of normalized quaternion, followed by
+    // the other three components quantized to [min, max], see specification
+    writeQuaternion(value: number[], min: number, max: number, steps: number, bits: number): void {
+        let q = value.map(Math.fround);
+        const n = Math.sqrt(q[0] * q[0] + q[1] * q[1] + q[2] * q[2] + q[3] * q[3]);
+        if (n > 0 && n !== Infinity) {
+            q = q.map((c) => c / n);
+        }
+        let largest = 0;
+        for (let c = 1; c < 4; c++) {
+            if (Math.abs(q[c]) > Math.abs(q[largest])) {
+                largest = c;
+            }
+        }
+        // q and -q are the same rotation
+        if (q[largest] < 0) {
+            q = q.map((c) => -c);
+        }
+
+        this.writeBits(largest, 2);
+        for (let c = 0; c < 4; c++) {
+            if (c !== largest) {
+                this.writeQuantized(q[c], min, max, steps, bits);
+            }
+        }
+    }
+
+    // writeNormalized writes unit vector as two octahedral coordinates quantized to [-1, 1], see specification
+    writeNormalized(value: number[], steps: number, bits: number): void {
+        let v = value.map(Math.fround);
+        const n = Math.abs(v[0]) + Math.abs(v[1]) + Math.abs(v[2]);
+        if (n > 0 && n !== Infinity) {
+            v = v.map((c) => c / n);
+        } else {
+            v = [0, 0, 1];
+        }
+
+        let u = v[0];
+        let t = v[1];
+        if (v[2] < 0) {
+            u = (1 - Math.abs(v[1])) * signNotZero(v[0]);
+            t = (1 - Math.abs(v[0])) * signNotZero(v[1]);
+        }
+        this.writeQuantized(u, -1, 1, steps, bits);
+        this.writeQuantized(t, -1, 1, steps, bits);
+    }
+
+    writeBool(value: boolean): void {
+        this.writeBits(value ? 1 : 0, 1);
+    }
+
+    writeFloat32(value: number): void {
+        scratch.setFloat32(0, value, true);
+        this.writeBits(scratch.getUint32(0, true), 32);
+    }
+
+    writeFloat64(value: number): void {
+        scratch.setFloat64(0, value, true);
+        this.writeBits(scratch.getUint32(0, true), 32);
+        this.writeBits(scratch.getUint32(4, true), 32);
+    }
+
+    // writeString writes UTF-8 length of value as countBits wide integer, followed by its bytes
+    writeString(value: string, maxLength: number, countBits: number): void {
+        this.writeChars(utf8Encoder.encode(value), maxLength, countBits, 8);
+    }
+
+    // writeAscii writes length of value as countBits wide integer, followed by its characters, 7 bits each
+    writeAscii(value: string, maxLength: number, countBits: number): void {
+        const bytes = new Uint8Array(value.length);
+        for (let i = 0; i < value.length; i++) {
+            const c = value.charCodeAt(i);
+            if (c >= 0x80) {
+                throw new ShrinkenError("shrinken: character is not ASCII");
+            }
+            bytes[i] = c;
+        }
+        this.writeChars(bytes, maxLength, countBits, 7);
+    }
+
+    // writeCount writes length of dynamic array as countBits wide integer
+    writeCount(count: number, maxCount: number, countBits: number): void {
+        if (count > maxCount) {
+            throw new ShrinkenError("shrinken: array of " + count + " elements is longer than " + maxCount);
+        }
+        this.writeBits(count, countBits);
+    }
+
+    // writeEnum writes index of enumeral, index not smaller than count is invalid
+    writeEnum(value: number, count: number, bits: number): void {
+        if (!Number.isInteger(value) || value < 0 || value >= count) {
+            throw new ShrinkenError("shrinken: " + value + " is not index of enumeral");
+        }
+        this.writeBits(value, bits);
+    }
+
+    writeChar(value: string): void {
+        this.writeBits(value.length > 0 ? value.codePointAt(0)! : 0, 32);
+    }
+
+    writeAsciiChar(value: string): void {
+        const c = value.length > 0 ? value.charCodeAt(0) : 0;
+        if (c >= 0x80) {
+            throw new ShrinkenError("shrinken: character is not ASCII");
+        }
+        this.writeBits(c, 7);
+    }
+
+    // number of written bits
+    get bitLength(): number {
+        return this.pos;
+    }
+
+    bytes(): Uint8Array {
+        return this.buf.slice(0, (this.pos + 7) >>> 3);
+    }
+
+    private writeChars(bytes: Uint8Array, maxLength: number, countBits: number, charBits: number): void {
+        if (bytes.length > maxLength) {
+            throw new ShrinkenError("shrinken: string of " + bytes.length + " bytes is longer than " + maxLength);
+        }
+
+        this.writeBits(bytes.length, countBits);
+        for (let i = 0; i < bytes.length; i++) {
+            this.writeBits(bytes[i], charBits);
+        }
+    }
+
+    private grow(bits: number): void {
+        const needed = (this.pos + bits + 7) >>> 3;
+        if (needed <= this.buf.length) {
+            return;
+        }
+
+        const buf = new Uint8Array(Math.max(needed, this.buf.length * 2));
+        buf.set(this.buf);
+        this.buf = buf;
+    }
+}
+
+// BitReader reads values written by BitWriter
+export class BitReader {
+    // byteAligned skips padding after every following bit field, up to whole bytes
+    byteAligned: boolean = false;
+    private buf: Uint8Array;
+    private pos: number = 0;
+
+    constructor(data: Uint8Array) {
+        this.buf = data;
+    }
+
+    // number of bits left to read
+    get remaining(): number {
+        return this.buf.length * 8 - this.pos;
+    }
+
+    // readBits reads unsigned value of up to 32 bits
+    readBits(bits: number): number {
+        if (bits > this.remaining) {
+            throw new ShrinkenError("shrinken: unexpected end of data");
+        }
+
+        let value = 0;
+        let shift = 0;
+        while (bits > 0) {
+            const used = this.pos & 7;
+            let n = 8 - used;
+            if (bits < n) {
+                n = bits;
+            }
+
+            value += ((this.buf[this.pos >>> 3] >>> used) & ((1 << n) - 1)) * Math.pow(2, shift);
+            shift += n;
+            bits -= n;
+            this.pos += n;
+        }
+
+        if (this.byteAligned) {
+            this.pos = (this.pos + 7) & ~7;
+        }
+        return value;
+    }
+
+    // readBigBits reads unsigned value of up to 64 bits
+    readBigBits(bits: number): bigint {
+        if (bits <= 32) {
+            return BigInt(this.readBits(bits));
+        }
+        const low = BigInt(this.readBits(32));
+        const high = BigInt(this.readBits(bits - 32));
+        return (high << 32n) | low;
+    }
+
+    readRange(min: number, max: number, bits: number): number {
+        const offset = this.readBits(bits);
+        if (offset > max - min) {
+            throw new ShrinkenError("shrinken: value is out of range");
+        }
+        return min + offset;
+    }
+
+    readBigRange(min: bigint, max: bigint, bits: number): bigint {
+        const offset = this.readBigBits(bits);
+        if (offset > max - min) {
+            throw new ShrinkenError("shrinken: value is out of range");
+        }
+        return min + offset;
+    }
+
+    // readSymbol reads Huffman code bit by bit, padding of byte aligned reader is skipped only after the whole code
+    readSymbol(code: HuffmanCode): number {
+        const aligned = this.byteAligned;
+        this.byteAligned = false;
+        try {
+            // codes of each length follow all shorter ones, counting up from first
+            let c = 0;
+            let first = 0;
+            let index = 0;
+            for (let length = 1; length < code.counts.length; length++) {
+                c |= this.readBits(1);
+                if (c - first < code.counts[length]) {
+                    return code.symbols[index + c - first];
+                }
+                index += code.counts[length];
+                first = (first + code.counts[length]) << 1;
+                c <<= 1;
+            }
+            throw new ShrinkenError("shrinken: incomplete Huffman code");
+        } finally {
+            this.byteAligned = aligned;
+            if (aligned) {
+                this.pos = (this.pos + 7) & ~7;
+            }
+        }
+    }
+
+    readRangeSymbol(min: number, code: HuffmanCode): number {
+        return min + this.readSymbol(code);
+    }
+
+    readBigRangeSymbol(min: bigint, code: HuffmanCode): bigint {
+        return min + BigInt(this.readSymbol(code));
+    }
+
+    // readVarint reads unsigned value of up to 32 bits written by writeVarint
+    readVarint(bits: number): number {
+        let value = 0;
+        for (let shift = 0; shift < bits; shift += 7) {
+            const group = this.readBits(8);
+            const data = group & 0x7F;
+            if (shift + 7 > bits && data >>> (bits - shift) !== 0) {
+                throw new ShrinkenError("shrinken: varint doesn't fit its type");
+            }
+            value += data * 2 ** shift;
+
+            if ((group & 0x80) === 0) {
+                return value;
+            }
+        }
+        throw new ShrinkenError("shrinken: varint doesn't fit its type");
+    }
+
+    readBigVarint(bits: number): bigint {
+        let value = 0n;
+        for (let shift = 0; shift < bits; shift += 7) {
+            const group = this.readBits(8);
+            const data = group & 0x7F;
+            if (shift + 7 > bits && data >>> (bits - shift) !== 0) {
+                throw new ShrinkenError("shrinken: varint doesn't fit its type");
+            }
+            value |= BigInt(data) << BigInt(shift);
+
+            if ((group & 0x80) === 0) {
+                return value;
+            }
+        }
+        throw new ShrinkenError("shrinken: varint doesn't fit its type");
+    }
+
+    readZigZag(bits: number): number {
+        const value = this.readVarint(bits);
+        return (value >>> 1) ^ -(value & 1);
+    }
+
+    readBigZigZag(bits: number): bigint {
+        const value = this.readBigVarint(bits);
+        return (value >> 1n) ^ -(value & 1n);
+    }
+
+    readQuantized(min: number, max: number, steps: number, bits: number): number {
+        const q = this.readBits(bits);
+        if (q > steps) {
+            throw new ShrinkenError("shrinken: value is out of range");
+        }
+        return Math.fround(min + (max - min) * q / steps);
+    }
+
+    // readQuaternion reads quaternion written by writeQuaternion and returns its four components
+    readQuaternion(min: number, max: number, steps: number, bits: number): number[] {
+        const largest = this.readBits(2);
+        const q = [0, 0, 0, 0];
+        let sum = 0;
+        for (let c = 0; c < 4; c++) {
+            if (c !== largest) {
+                q[c] = this.readQuantized(min, max, steps, bits);
+                sum += q[c] * q[c];
+            }
+        }
+        q[largest] = Math.fround(Math.sqrt(Math.max(0, 1 - sum)));
+        return q;
+    }
+
+    // readNormalized reads vector written by writeNormalized and returns its three components with unit length
+    readNormalized(steps: number, bits: number): number[] {
+        let u = this.readQuantized(-1, 1, steps, bits);
+        let t = this.readQuantized(-1, 1, steps, bits);
+        const z = 1 - Math.abs(u) - Math.abs(t);
+        if (z < 0) {
+            [u, t] = [(1 - Math.abs(t)) * signNotZero(u), (1 - Math.abs(u)) * signNotZero(t)];
+        }
+        const n = Math.sqrt(u * u + t * t + z * z);
+        return [Math.fround(u / n), Math.fround(t / n), Math.fround(z / n)];
+    }
+
+    readBool(): boolean {
+        return this.readBits(1) === 1;
+    }
+
+    readFloat32(): number {
+        scratch.setUint32(0, this.readBits(32), true);
+        return scratch.getFloat32(0, true);
+    }
+
+    readFloat64(): number {
+        const low = this.readBits(32);
+        const high = this.readBits(32);
+        scratch.setUint32(0, low, true);
+        scratch.setUint32(4, high, true);
+        return scratch.getFloat64(0, true);
+    }
+
+    // readString reads string written by writeString, longer strings are invalid
+    readString(maxLength: number, countBits: number): string {
+        return utf8Decoder.decode(this.readChars(maxLength, countBits, 8));
+    }
+
+    // readAscii reads string written by writeAscii, longer strings are invalid
+    readAscii(maxLength: number, countBits: number): string {
+        // 7 bit characters are valid UTF-8
+        return utf8Decoder.decode(this.readChars(maxLength, countBits, 7));
+    }
+
+    // readCount reads length of dynamic array, larger than maxCount is invalid
+    readCount(maxCount: number, countBits: number): number {
+        const count = this.readBits(countBits);
+        if (count > maxCount) {
+            throw new ShrinkenError("shrinken: array of " + count + " elements is longer than " + maxCount);
+        }
+        return count;
+    }
+
+    // readEnum reads index of enumeral, index not smaller than count is invalid
+    readEnum(count: number, bits: number): number {
+        const value = this.readBits(bits);
+        if (value >= count) {
+            throw new ShrinkenError("shrinken: " + value + " is not index of enumeral");
+        }
+        return value;
+    }
+
+    readChar(): string {
+        return String.fromCodePoint(this.readBits(32));
+    }
+
+    readAsciiChar(): string {
+        return String.fromCharCode(this.readBits(7));
+    }
+
+    private readChars(maxLength: number, countBits: number, charBits: number): Uint8Array {
+        const n = this.readBits(countBits);
+        if (n > maxLength) {
+            throw new ShrinkenError("shrinken: string of " + n + " bytes is longer than " + maxLength);
+        }
+        if (n * charBits > this.remaining) {
+            throw new ShrinkenError("shrinken: unexpected end of data");
+        }
+
+        const bytes = new Uint8Array(n);
+        for (let i = 0; i < n; i++) {
+            bytes[i] = this.readBits(charBits);
+        }
+        return bytes;
+    }
+}
+`
@@ -0,0 +1,786 @@
+package typescript
+
+import (
+	"fmt"
+	"path/filepath"
+	"shrinken/gen"
+	"shrinken/sddl"
+	"shrinken/sddl/ast"
+	"strings"
+)
+
+// TypeScript backend generates one module per SDDL package. Structs and classes become interfaces
+// (derived types extend their base interface), enums become TypeScript enums and every type gets
+// new, encode and decode functions. Types marked with message attribute also get serialize and
+// deserialize functions working on Uint8Array, ones marked with delta attribute also get serialize<Type>Delta
+// and deserialize<Type>Delta, which encode only fields changed against baseline. Messages are also written with
+// their IDs by serialize<Type>Any, which decodeAny of their module dispatches to methods of MessageHandler.
+// 64 bit integers are represented with bigint.
+// Objects of classes with base or derived classes hold qualified name of their class in $type property,
+// references to classes with derived classes are encoded by encode<Class>Subtype with subtype tag.
+
+type generator struct{}
+
+func init() {
+	gen.Register("typescript", &generator{})
+}
+
+func (g *generator) Generate(parsed *sddl.SDDLTree, outputPath string) error {
+	schema, err := gen.NewSchema(parsed)
+	if err != nil {
+		return err
+	}
+
+	err = gen.WriteFile(filepath.Join(outputPath, "shrinken.ts"), []byte(runtime))
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range schema.Packages {
+		f := &packageFile{
+			schema:  schema,
+			pkg:     pkg,
+			imports: make(map[*gen.Package]bool),
+		}
+
+		content, err := f.generate()
+		if err != nil {
+			return err
+		}
+
+		err = gen.WriteFile(filepath.Join(outputPath, moduleName(pkg)+".ts"), content)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type packageFile struct {
+	schema  *gen.Schema
+	pkg     *gen.Package
+	imports map[*gen.Package]bool
+	errors  bool // whether ShrinkenError is used
+	huffman gen.HuffmanTables
+	err     error
+}
+
+func (f *packageFile) generate() ([]byte, error) {
+	body := gen.NewCodeWriter("    ")
+
+	for _, e := range f.pkg.Enums {
+		f.writeEnum(body, e)
+	}
+
+	for _, s := range f.pkg.Structs {
+		f.writeStruct(body, s)
+	}
+
+	if messages := f.pkg.Messages(); len(messages) > 0 {
+		f.writeDispatcher(body, messages)
+	}
+
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	w := gen.NewCodeWriter("    ")
+	w.Line("// Code generated by shrinken. DO NOT EDIT.")
+	w.Line("")
+	runtimeNames := "BitReader, BitWriter"
+	if len(f.huffman.Tables) > 0 {
+		runtimeNames += ", HuffmanCode"
+	}
+	if f.errors {
+		runtimeNames += ", ShrinkenError"
+	}
+	w.Line("import { %v } from \"./shrinken\";", runtimeNames)
+	for _, pkg := range f.schema.Packages {
+		if f.imports[pkg] {
+			w.Line("import * as %v from \"./%v\";", alias(pkg), moduleName(pkg))
+		}
+	}
+	w.Line("")
+	for i, code := range f.huffman.Tables {
+		w.Line("// huffman%v is Huffman code of %v", i, code.Name)
+		w.Line("const huffman%v: HuffmanCode = {", i)
+		w.Indent()
+		w.Line("codes: [%v],", gen.JoinInts(code.Codes))
+		w.Line("lengths: [%v],", gen.JoinInts(code.Lengths))
+		w.Line("counts: [%v],", gen.JoinInts(code.Counts))
+		w.Line("symbols: [%v],", gen.JoinInts(code.Symbols))
+		w.Dedent()
+		w.Line("};")
+		w.Line("")
+	}
+	w.Raw(string(body.Bytes()))
+
+	return w.Bytes(), nil
+}
+
+func (f *packageFile) writeEnum(w *gen.CodeWriter, e *gen.Enum) {
+	name := gen.UpperFirst(e.ExportedName)
+
+	w.Line("export enum %v {", name)
+	w.Indent()
+	for i, value := range e.Values {
+		w.Line("%v = %v,", value, i)
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if e.IsMessage {
+		f.writeMessageFunctions(w, name, e.Id, e.Codec())
+	}
+}
+
+func (f *packageFile) writeStruct(w *gen.CodeWriter, s *gen.Struct) {
+	name := gen.UpperFirst(s.ExportedName)
+
+	if s.Base != nil {
+		w.Line("export interface %v extends %v {", name, f.typeName(s.Base.Package, gen.UpperFirst(s.Base.ExportedName)))
+	} else {
+		w.Line("export interface %v {", name)
+	}
+	w.Indent()
+	if s.Base == nil && inHierarchy(s) {
+		w.Line("// qualified name of class of object, which is written as subtype tag")
+		w.Line("$type: string;")
+	}
+	for _, field := range s.Fields {
+		w.Line("%v: %v;", field.ExportedName, f.tsType(field.Codec))
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	// new function returns zero value, which is also used when encoding null class references
+
+	w.Line("export function new%v(): %v {", name, name)
+	w.Indent()
+	w.Line("return {")
+	w.Indent()
+	if inHierarchy(s) {
+		w.Line("$type: %q,", s.QualifiedName())
+	}
+	for _, field := range s.AllFields() {
+		w.Line("%v: %v,", field.ExportedName, f.zeroValue(field.Codec))
+	}
+	w.Dedent()
+	w.Line("};")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("export function encode%v(w: BitWriter, v: %v): void {", name, name)
+	w.Indent()
+	if s.Base != nil {
+		w.Line("%v(w, v);", f.typeName(s.Base.Package, "encode"+gen.UpperFirst(s.Base.ExportedName)))
+	}
+	if c := s.Quaternion; c != nil {
+		q := c.Quantization
+		w.Line("w.writeQuaternion([%v], %v, %v, %v, %v);", componentFields(s), q.MinString(), q.MaxString(), q.Steps, c.Bits)
+	} else if c := s.Normalized; c != nil {
+		w.Line("w.writeNormalized([%v], %v, %v);", componentFields(s), c.Quantization.Steps, c.Bits)
+	} else {
+		for _, field := range s.Fields {
+			f.encode(w, field.Codec, "v."+field.ExportedName, 0)
+		}
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	// decode fills given object, so that derived types can reuse decode functions of base types
+
+	if inHierarchy(s) {
+		w.Line("export function decode%v(r: BitReader, v: %v = { $type: %q } as %v): %v {", name, name, s.QualifiedName(), name, name)
+	} else {
+		w.Line("export function decode%v(r: BitReader, v: %v = {} as %v): %v {", name, name, name, name)
+	}
+	w.Indent()
+	if s.Base != nil {
+		w.Line("%v(r, v);", f.typeName(s.Base.Package, "decode"+gen.UpperFirst(s.Base.ExportedName)))
+	}
+	if c := s.Quaternion; c != nil {
+		q := c.Quantization
+		w.Line("[%v] = r.readQuaternion(%v, %v, %v, %v);", componentFields(s), q.MinString(), q.MaxString(), q.Steps, c.Bits)
+	} else if c := s.Normalized; c != nil {
+		w.Line("[%v] = r.readNormalized(%v, %v);", componentFields(s), c.Quantization.Steps, c.Bits)
+	} else {
+		for _, field := range s.Fields {
+			f.decode(w, field.Codec, "v."+field.ExportedName, 0)
+		}
+	}
+	w.Line("return v;")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if s.IsMessage {
+		f.writeMessageFunctions(w, name, s.Id, &gen.Codec{Kind: gen.StructCodec, Struct: s})
+	}
+
+	if s.HasDelta {
+		f.writeDeltaFunctions(w, s)
+	}
+
+	if s.IsClass && len(s.Derived) > 0 {
+		f.writeSubtypeFunctions(w, s)
+	}
+}
+
+// componentFields returns comma separated components of quaternion or normalized vector struct
+func componentFields(s *gen.Struct) string {
+	names := make([]string, len(s.Fields))
+	for i, field := range s.Fields {
+		names[i] = "v." + field.ExportedName
+	}
+	return strings.Join(names, ", ")
+}
+
+// writeSubtypeFunctions writes functions encoding and decoding references to class, which start with subtype tag
+func (f *packageFile) writeSubtypeFunctions(w *gen.CodeWriter, s *gen.Struct) {
+	name := gen.UpperFirst(s.ExportedName)
+	subtypes := s.Subtypes()
+	f.errors = true
+
+	w.Line("export function encode%vSubtype(w: BitWriter, v: %v | null): void {", name, name)
+	w.Indent()
+	w.Line("v = v ?? new%v();", name)
+	w.Line("switch (v.$type) {")
+	w.Indent()
+	for tag, subtype := range subtypes {
+		subtypeName := gen.UpperFirst(subtype.ExportedName)
+		w.Line("case %q:", subtype.QualifiedName())
+		w.Indent()
+		w.Line("w.writeBits(%v, %v);", tag, s.SubtypeBits())
+		if tag == 0 {
+			w.Line("encode%v(w, v);", name)
+		} else {
+			w.Line("%v(w, v as %v);", f.typeName(subtype.Package, "encode"+subtypeName), f.typeName(subtype.Package, subtypeName))
+		}
+		w.Line("break;")
+		w.Dedent()
+	}
+	w.Line("default:")
+	w.Indent()
+	w.Line("throw new ShrinkenError(\"shrinken: \" + v.$type + \" is not %v or class derived from it\");", s.QualifiedName())
+	w.Dedent()
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("export function decode%vSubtype(r: BitReader): %v {", name, name)
+	w.Indent()
+	w.Line("switch (r.readBits(%v)) {", s.SubtypeBits())
+	w.Indent()
+	for tag, subtype := range subtypes {
+		w.Line("case %v:", tag)
+		w.Indent()
+		w.Line("return %v(r);", f.typeName(subtype.Package, "decode"+gen.UpperFirst(subtype.ExportedName)))
+		w.Dedent()
+	}
+	w.Line("default:")
+	w.Indent()
+	w.Line("throw new ShrinkenError(\"shrinken: unknown subtype of %v\");", s.QualifiedName())
+	w.Dedent()
+	w.Dedent()
+	w.Line("}")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+}
+
+func (f *packageFile) writeMessageFunctions(w *gen.CodeWriter, name string, id uint16, c *gen.Codec) {
+	w.Line("export const messageId%v = %v;", name, id)
+	w.Line("")
+
+	w.Line("export function serialize%v(v: %v): Uint8Array {", name, name)
+	w.Indent()
+	w.Line("const w = new BitWriter();")
+	f.writeMessageEncode(w, name, c)
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("// serialize%vAny writes messageId%v followed by v", name, name)
+	w.Line("export function serialize%vAny(v: %v): Uint8Array {", name, name)
+	w.Indent()
+	w.Line("const w = new BitWriter();")
+	w.Line("w.writeVarint(messageId%v, 16);", name)
+	f.writeMessageEncode(w, name, c)
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("export function deserialize%v(data: Uint8Array): %v {", name, name)
+	w.Indent()
+	w.Line("const r = new BitReader(data);")
+	if c.IsByteAligned() {
+		w.Line("r.byteAligned = true;")
+	}
+	if c.Kind == gen.StructCodec {
+		w.Line("return decode%v(r);", name)
+	} else {
+		w.Line("let v: %v;", name)
+		f.decode(w, c, "v", 0)
+		w.Line("return v;")
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+}
+
+// writeMessageEncode writes rest of serializer of message, after writer w is created
+func (f *packageFile) writeMessageEncode(w *gen.CodeWriter, name string, c *gen.Codec) {
+	if c.IsByteAligned() {
+		w.Line("w.byteAligned = true;")
+	}
+	if c.Kind == gen.StructCodec {
+		w.Line("encode%v(w, v);", name)
+	} else {
+		f.encode(w, c, "v", 0)
+	}
+	w.Line("return w.bytes();")
+}
+
+// writeDispatcher writes MessageHandler with method for each message of package and decodeAny, which calls
+// it with decoded message
+func (f *packageFile) writeDispatcher(w *gen.CodeWriter, messages []*gen.Message) {
+	f.errors = true
+
+	w.Line("export interface MessageHandler {")
+	w.Indent()
+	for _, m := range messages {
+		name := gen.UpperFirst(m.ExportedName)
+		w.Line("handle%v(message: %v): void;", name, name)
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("// decodeAny deserializes message written by serialize<Type>Any and passes it to handler, ID of message")
+	w.Line("// of another package throws ShrinkenError")
+	w.Line("export function decodeAny(data: Uint8Array, handler: MessageHandler): void {")
+	w.Indent()
+	w.Line("const r = new BitReader(data);")
+	w.Line("const id = r.readVarint(16);")
+	w.Line("switch (id) {")
+	w.Indent()
+	for _, m := range messages {
+		name := gen.UpperFirst(m.ExportedName)
+		w.Line("case messageId%v: {", name)
+		w.Indent()
+		if m.Struct != nil {
+			if m.Struct.ByteAligned {
+				w.Line("r.byteAligned = true;")
+			}
+			w.Line("handler.handle%v(decode%v(r));", name, name)
+		} else {
+			if m.Enum.ByteAligned {
+				w.Line("r.byteAligned = true;")
+			}
+			w.Line("let message: %v;", name)
+			f.decode(w, m.Enum.Codec(), "message", 0)
+			w.Line("handler.handle%v(message);", name)
+		}
+		w.Line("return;")
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("throw new ShrinkenError(\"shrinken: unknown message \" + id);")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+}
+
+// writeDeltaFunctions writes functions comparing object with baseline and encoding it as delta against it
+func (f *packageFile) writeDeltaFunctions(w *gen.CodeWriter, s *gen.Struct) {
+	name := gen.UpperFirst(s.ExportedName)
+	fields := s.AllFields()
+
+	w.Line("// changedFields%v reports which fields of v are changed against baseline", name)
+	w.Line("export function changedFields%v(v: %v, baseline: %v): boolean[] {", name, name, name)
+	w.Indent()
+	w.Line("return [")
+	w.Indent()
+	for _, field := range fields {
+		w.Line("%v,", f.changed(field.Codec, "v."+field.ExportedName, "baseline."+field.ExportedName, 0))
+	}
+	w.Dedent()
+	w.Line("];")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("export function differs%v(v: %v, baseline: %v): boolean {", name, name, name)
+	w.Indent()
+	w.Line("return changedFields%v(v, baseline).some((c) => c);", name)
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("// encode%vDelta writes mask of fields changed against baseline, followed by changed fields", name)
+	w.Line("export function encode%vDelta(w: BitWriter, v: %v, baseline: %v): void {", name, name, name)
+	w.Indent()
+	w.Line("const changed = changedFields%v(v, baseline);", name)
+	w.Line("for (const c of changed) {")
+	w.Indent()
+	w.Line("w.writeBool(c);")
+	w.Dedent()
+	w.Line("}")
+	for i, field := range fields {
+		expr := "v." + field.ExportedName
+		w.Line("if (changed[%v]) {", i)
+		w.Indent()
+		if c := field.Codec; c.IsDelta() && c.Struct.IsClass {
+			newFunc := f.typeName(c.Struct.Package, "new"+gen.UpperFirst(c.Struct.ExportedName))
+			w.Line("%v(w, %v ?? %v(), baseline.%v ?? %v());", f.typeName(c.Struct.Package, "encode"+gen.UpperFirst(c.Struct.ExportedName)+"Delta"), expr, newFunc, field.ExportedName, newFunc)
+		} else if c.IsDelta() {
+			w.Line("%v(w, %v, baseline.%v);", f.typeName(c.Struct.Package, "encode"+gen.UpperFirst(c.Struct.ExportedName)+"Delta"), expr, field.ExportedName)
+		} else {
+			f.encode(w, c, expr, 0)
+		}
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	w.Line("// decode%vDelta reads delta written by encode%vDelta and replaces changed fields of v, which holds baseline", name, name)
+	w.Line("export function decode%vDelta(r: BitReader, v: %v): %v {", name, name, name)
+	w.Indent()
+	w.Line("const changed: boolean[] = [];")
+	w.Line("for (let i = 0; i < %v; i++) {", len(fields))
+	w.Indent()
+	w.Line("changed.push(r.readBool());")
+	w.Dedent()
+	w.Line("}")
+	for i, field := range fields {
+		expr := "v." + field.ExportedName
+		w.Line("if (changed[%v]) {", i)
+		w.Indent()
+		if c := field.Codec; c.IsDelta() {
+			if c.Struct.IsClass {
+				w.Line("if (%v === null) {", expr)
+				w.Indent()
+				w.Line("%v = %v();", expr, f.typeName(c.Struct.Package, "new"+gen.UpperFirst(c.Struct.ExportedName)))
+				w.Dedent()
+				w.Line("}")
+			}
+			w.Line("%v(r, %v);", f.typeName(c.Struct.Package, "decode"+gen.UpperFirst(c.Struct.ExportedName)+"Delta"), expr)
+		} else {
+			f.decode(w, c, expr, 0)
+		}
+		w.Dedent()
+		w.Line("}")
+	}
+	w.Line("return v;")
+	w.Dedent()
+	w.Line("}")
+	w.Line("")
+
+	if s.IsDelta {
+		w.Line("export function serialize%vDelta(v: %v, baseline: %v): Uint8Array {", name, name, name)
+		w.Indent()
+		w.Line("const w = new BitWriter();")
+		if s.ByteAligned {
+			w.Line("w.byteAligned = true;")
+		}
+		w.Line("encode%vDelta(w, v, baseline);", name)
+		w.Line("return w.bytes();")
+		w.Dedent()
+		w.Line("}")
+		w.Line("")
+
+		w.Line("// deserialize%vDelta applies delta to v, which has to hold baseline the delta was written against", name)
+		w.Line("export function deserialize%vDelta(data: Uint8Array, v: %v): %v {", name, name, name)
+		w.Indent()
+		w.Line("const r = new BitReader(data);")
+		if s.ByteAligned {
+			w.Line("r.byteAligned = true;")
+		}
+		w.Line("return decode%vDelta(r, v);", name)
+		w.Dedent()
+		w.Line("}")
+		w.Line("")
+	}
+}
+
+// changed returns expression telling whether value a is changed against b, as defined for delta
+// encoding. References to polymorphic classes are always changed.
+func (f *packageFile) changed(c *gen.Codec, a, b string, depth int) string {
+	switch c.Kind {
+	case gen.StructCodec:
+		name := gen.UpperFirst(c.Struct.ExportedName)
+		if c.IsPolymorphic() {
+			return "true"
+		}
+		differs := f.typeName(c.Struct.Package, "differs"+name)
+		if c.Struct.IsClass {
+			newFunc := f.typeName(c.Struct.Package, "new"+name)
+			return fmt.Sprintf("%v(%v ?? %v(), %v ?? %v())", differs, a, newFunc, b, newFunc)
+		}
+		return fmt.Sprintf("%v(%v, %v)", differs, a, b)
+	case gen.ArrayCodec:
+		if c.Elem.Kind == gen.StructCodec && c.Elem.IsPolymorphic() {
+			return fmt.Sprintf("%v.length !== %v.length || %v.length > 0", a, b, a)
+		}
+		x, i := fmt.Sprintf("x%v", depth), fmt.Sprintf("i%v", depth)
+		return fmt.Sprintf("%v.length !== %v.length || %v.some((%v, %v) => %v)", a, b, a, x, i, f.changed(c.Elem, x, fmt.Sprintf("%v[%v]", b, i), depth+1))
+	}
+	return fmt.Sprintf("%v !== %v", a, b)
+}
+
+func (f *packageFile) encode(w *gen.CodeWriter, c *gen.Codec, expr string, depth int) {
+	switch c.Kind {
+	case gen.BoolCodec:
+		w.Line("w.writeBool(%v);", expr)
+	case gen.EnumCodec:
+		if c.Huffman != nil {
+			w.Line("w.writeSymbol(%v, huffman%v);", expr, f.huffman.Index(c.Huffman))
+		} else {
+			w.Line("w.writeEnum(%v, %v, %v);", expr, len(c.Enum.Values), c.Bits)
+		}
+	case gen.IntCodec:
+		if c.Huffman != nil && isBig(c) {
+			w.Line("w.writeBigRangeSymbol(%v, %vn, %vn, huffman%v);", expr, c.MinString(), c.MaxString(), f.huffman.Index(c.Huffman))
+		} else if c.Huffman != nil {
+			w.Line("w.writeRangeSymbol(%v, %v, %v, huffman%v);", expr, c.MinString(), c.MaxString(), f.huffman.Index(c.Huffman))
+		} else if c.Range != nil && isBig(c) {
+			w.Line("w.writeBigRange(%v, %vn, %vn, %v);", expr, c.MinString(), c.MaxString(), c.Bits)
+		} else if c.Range != nil {
+			w.Line("w.writeRange(%v, %v, %v, %v);", expr, c.MinString(), c.MaxString(), c.Bits)
+		} else if c.Encoding == gen.VarintEncoding && isBig(c) {
+			w.Line("w.writeBigVarint(%v, %v);", expr, c.Bits)
+		} else if c.Encoding == gen.VarintEncoding {
+			w.Line("w.writeVarint(%v, %v);", expr, c.Bits)
+		} else if c.Encoding == gen.ZigZagEncoding && isBig(c) {
+			w.Line("w.writeBigZigZag(%v);", expr)
+		} else if c.Encoding == gen.ZigZagEncoding {
+			w.Line("w.writeZigZag(%v);", expr)
+		} else if c.Bits > 32 {
+			w.Line("w.writeBigBits(%v, %v);", expr, c.Bits)
+		} else {
+			w.Line("w.writeBits(%v, %v);", expr, c.Bits)
+		}
+	case gen.CharCodec:
+		if c.IsASCII() {
+			w.Line("w.writeAsciiChar(%v);", expr)
+		} else {
+			w.Line("w.writeChar(%v);", expr)
+		}
+	case gen.FloatCodec:
+		if q := c.Quantization; q != nil {
+			w.Line("w.writeQuantized(%v, %v, %v, %v, %v);", expr, q.MinString(), q.MaxString(), q.Steps, c.Bits)
+		} else {
+			w.Line("w.writeFloat%v(%v);", c.Bits, expr)
+		}
+	case gen.StringCodec:
+		if c.IsASCII() {
+			w.Line("w.writeAscii(%v, %v, %v);", expr, c.MaxLength, c.CountBits)
+		} else {
+			w.Line("w.writeString(%v, %v, %v);", expr, c.MaxLength, c.CountBits)
+		}
+	case gen.StructCodec:
+		name := gen.UpperFirst(c.Struct.ExportedName)
+		encode := f.typeName(c.Struct.Package, "encode"+name)
+		if c.IsPolymorphic() {
+			w.Line("%v(w, %v);", f.typeName(c.Struct.Package, "encode"+name+"Subtype"), expr)
+		} else if c.Struct.IsClass {
+			w.Line("%v(w, %v ?? %v());", encode, expr, f.typeName(c.Struct.Package, "new"+name))
+		} else {
+			w.Line("%v(w, %v);", encode, expr)
+		}
+	case gen.ArrayCodec:
+		i := fmt.Sprintf("i%v", depth)
+		if c.IsDynamic() {
+			w.Line("w.writeCount(%v.length, %v, %v);", expr, c.MaxCount, c.CountBits)
+			w.Line("for (let %v = 0; %v < %v.length; %v++) {", i, i, expr, i)
+		} else {
+			w.Line("for (let %v = 0; %v < %v; %v++) {", i, i, c.Size, i)
+		}
+		w.Indent()
+		f.encode(w, c.Elem, fmt.Sprintf("%v[%v]", expr, i), depth+1)
+		w.Dedent()
+		w.Line("}")
+	}
+}
+
+func (f *packageFile) decode(w *gen.CodeWriter, c *gen.Codec, expr string, depth int) {
+	switch c.Kind {
+	case gen.BoolCodec:
+		w.Line("%v = r.readBool();", expr)
+	case gen.IntCodec:
+		if c.Huffman != nil && isBig(c) {
+			w.Line("%v = r.readBigRangeSymbol(%vn, huffman%v);", expr, c.MinString(), f.huffman.Index(c.Huffman))
+		} else if c.Huffman != nil {
+			w.Line("%v = r.readRangeSymbol(%v, huffman%v);", expr, c.MinString(), f.huffman.Index(c.Huffman))
+		} else if c.Range != nil && isBig(c) {
+			w.Line("%v = r.readBigRange(%vn, %vn, %v);", expr, c.MinString(), c.MaxString(), c.Bits)
+		} else if c.Range != nil {
+			w.Line("%v = r.readRange(%v, %v, %v);", expr, c.MinString(), c.MaxString(), c.Bits)
+		} else if c.Encoding == gen.VarintEncoding && isBig(c) {
+			if c.Signed {
+				w.Line("%v = BigInt.asIntN(%v, r.readBigVarint(%v));", expr, c.Bits, c.Bits)
+			} else {
+				w.Line("%v = r.readBigVarint(%v);", expr, c.Bits)
+			}
+		} else if c.Encoding == gen.VarintEncoding && c.Signed {
+			w.Line("%v = (r.readVarint(%v) << %v) >> %v;", expr, c.Bits, 32-c.Bits, 32-c.Bits)
+		} else if c.Encoding == gen.VarintEncoding {
+			w.Line("%v = r.readVarint(%v);", expr, c.Bits)
+		} else if c.Encoding == gen.ZigZagEncoding && isBig(c) {
+			w.Line("%v = r.readBigZigZag(%v);", expr, c.Bits)
+		} else if c.Encoding == gen.ZigZagEncoding {
+			w.Line("%v = r.readZigZag(%v);", expr, c.Bits)
+		} else if c.Bits > 32 {
+			if c.Signed {
+				w.Line("%v = BigInt.asIntN(%v, r.readBigBits(%v));", expr, c.Bits, c.Bits)
+			} else {
+				w.Line("%v = r.readBigBits(%v);", expr, c.Bits)
+			}
+		} else if c.Signed {
+			// shifting left and back right extends sign bit
+			w.Line("%v = (r.readBits(%v) << %v) >> %v;", expr, c.Bits, 32-c.Bits, 32-c.Bits)
+		} else {
+			w.Line("%v = r.readBits(%v);", expr, c.Bits)
+		}
+	case gen.EnumCodec:
+		if c.Huffman != nil {
+			w.Line("%v = r.readSymbol(huffman%v) as %v;", expr, f.huffman.Index(c.Huffman), f.tsType(c))
+		} else {
+			w.Line("%v = r.readEnum(%v, %v) as %v;", expr, len(c.Enum.Values), c.Bits, f.tsType(c))
+		}
+	case gen.CharCodec:
+		if c.IsASCII() {
+			w.Line("%v = r.readAsciiChar();", expr)
+		} else {
+			w.Line("%v = r.readChar();", expr)
+		}
+	case gen.FloatCodec:
+		if q := c.Quantization; q != nil {
+			w.Line("%v = r.readQuantized(%v, %v, %v, %v);", expr, q.MinString(), q.MaxString(), q.Steps, c.Bits)
+		} else {
+			w.Line("%v = r.readFloat%v();", expr, c.Bits)
+		}
+	case gen.StringCodec:
+		if c.IsASCII() {
+			w.Line("%v = r.readAscii(%v, %v);", expr, c.MaxLength, c.CountBits)
+		} else {
+			w.Line("%v = r.readString(%v, %v);", expr, c.MaxLength, c.CountBits)
+		}
+	case gen.StructCodec:
+		if c.IsPolymorphic() {
+			w.Line("%v = %v(r);", expr, f.typeName(c.Struct.Package, "decode"+gen.UpperFirst(c.Struct.ExportedName)+"Subtype"))
+		} else {
+			w.Line("%v = %v(r);", expr, f.typeName(c.Struct.Package, "decode"+gen.UpperFirst(c.Struct.ExportedName)))
+		}
+	case gen.ArrayCodec:
+		i := fmt.Sprintf("i%v", depth)
+		w.Line("%v = [];", expr)
+		if c.IsDynamic() {
+			n := fmt.Sprintf("n%v", depth)
+			w.Line("for (let %v = 0, %v = r.readCount(%v, %v); %v < %v; %v++) {", i, n, c.MaxCount, c.CountBits, i, n, i)
+		} else {
+			w.Line("for (let %v = 0; %v < %v; %v++) {", i, i, c.Size, i)
+		}
+		w.Indent()
+		f.decode(w, c.Elem, fmt.Sprintf("%v[%v]", expr, i), depth+1)
+		w.Dedent()
+		w.Line("}")
+	}
+}
+
+func (f *packageFile) tsType(c *gen.Codec) string {
+	switch c.Kind {
+	case gen.ArrayCodec:
+		return f.tsType(c.Elem) + "[]"
+	case gen.StructCodec:
+		name := f.typeName(c.Struct.Package, gen.UpperFirst(c.Struct.ExportedName))
+		if c.Struct.IsClass {
+			return "(" + name + " | null)"
+		}
+		return name
+	case gen.EnumCodec:
+		return f.typeName(c.Enum.Package, gen.UpperFirst(c.Enum.ExportedName))
+	}
+
+	switch c.Type.GenericType {
+	case ast.Integer64, ast.UnsignedInteger64:
+		return "bigint"
+	case ast.Integer32, ast.Short, ast.UnsignedInteger32, ast.UnsignedShort, ast.Byte, ast.Float, ast.Double:
+		return "number"
+	case ast.Bool:
+		return "boolean"
+	case ast.String, ast.Char:
+		return "string"
+	}
+
+	f.err = fmt.Errorf("TypeScript backend doesn't support type %v", c.Type.GenericType.String())
+	return ""
+}
+
+func (f *packageFile) zeroValue(c *gen.Codec) string {
+	switch c.Kind {
+	case gen.ArrayCodec:
+		if c.IsDynamic() {
+			return "[]"
+		}
+		return fmt.Sprintf("Array.from({ length: %v }, () => %v)", c.Size, f.zeroValue(c.Elem))
+	case gen.StructCodec:
+		if c.Struct.IsClass {
+			return "null"
+		}
+		return f.typeName(c.Struct.Package, "new"+gen.UpperFirst(c.Struct.ExportedName)) + "()"
+	case gen.EnumCodec:
+		return "0"
+	case gen.BoolCodec:
+		return "false"
+	case gen.StringCodec:
+		return "\"\""
+	case gen.CharCodec:
+		return "\"\\0\""
+	}
+
+	if isBig(c) {
+		return "0n"
+	}
+	return "0"
+}
+
+// typeName returns name qualified by module alias if it's declared in other package
+func (f *packageFile) typeName(pkg *gen.Package, name string) string {
+	if pkg == f.pkg {
+		return name
+	}
+
+	f.imports[pkg] = true
+	return alias(pkg) + "." + name
+}
+
+// isBig reports whether integer values are represented with bigint
+// inHierarchy reports whether class has base or derived classes, so its objects hold $type
+func inHierarchy(s *gen.Struct) bool {
+	return s.IsClass && len(s.Root().Derived) > 0
+}
+
+func isBig(c *gen.Codec) bool {
+	return c.Kind == gen.IntCodec && (c.Type.GenericType == ast.Integer64 || c.Type.GenericType == ast.UnsignedInteger64)
+}
+
+func moduleName(pkg *gen.Package) string {
+	return pkg.ExportedName
+}
+
+func alias(pkg *gen.Package) string {
+	return strings.Replace(pkg.ExportedName, ".", "_", -1)
+}
@@ -0,0 +1,361 @@
+package typescript
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"shrinken/gen"
+	"shrinken/wire/wiretest"
+	"strings"
+	"testing"
+)
+
+func generate(t *testing.T, filename string) string {
+	tree := wiretest.Parse(t, filename)
+
+	dir, err := ioutil.TempDir("", "shrinken")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = gen.Generate(tree, "typescript", dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal("TypeScript code couldn't be generated!", err)
+	}
+
+	return dir
+}
+
+func readGenerated(t *testing.T, filename string) string {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal("Generated file is missing!", err)
+	}
+	return string(b)
+}
+
+func TestBasic(t *testing.T) {
+	dir := generate(t, "../../sddl/test_data/single_file/basic.sddl")
+	defer os.RemoveAll(dir)
+
+	readGenerated(t, filepath.Join(dir, "shrinken.ts"))
+	code := readGenerated(t, filepath.Join(dir, "com.github.namespace.ts"))
+
+	expected := []string{
+		"export interface Player extends Entity {",
+		"export function encodePlayer(w: BitWriter, v: Player): void {",
+		"export function serializePlayer(v: Player): Uint8Array {",
+		"export function deserializePlayer(data: Uint8Array): Player {",
+		"pos: Vector3;",
+	}
+	for _, e := range expected {
+		if !strings.Contains(code, e) {
+			t.Fatalf("Generated code doesn't contain %q", e)
+		}
+	}
+
+	if strings.Contains(code, "serializeVector3") {
+		t.Fatal("Struct Vector3 is not a message, but got serialize function")
+	}
+}
+
+func TestQuaternion(t *testing.T) {
+	dir := generate(t, "../../sddl/test_data/single_file/quaternion.sddl")
+	defer os.RemoveAll(dir)
+
+	code := readGenerated(t, filepath.Join(dir, "com.github.namespace.ts"))
+
+	expected := []string{
+		"w.writeQuaternion([v.i, v.j, v.k, v.w], ",
+		"[v.i, v.j, v.k, v.w] = r.readQuaternion(",
+	}
+	for _, e := range expected {
+		if !strings.Contains(code, e) {
+			t.Fatalf("Generated code doesn't contain %q", e)
+		}
+	}
+}
+
+func TestNormalized(t *testing.T) {
+	dir := generate(t, "../../sddl/test_data/single_file/normalized.sddl")
+	defer os.RemoveAll(dir)
+
+	code := readGenerated(t, filepath.Join(dir, "com.github.namespace.ts"))
+
+	expected := []string{
+		"w.writeNormalized([v.x, v.y, v.z], 65534, 16);",
+		"[v.x, v.y, v.z] = r.readNormalized(65534, 16);",
+	}
+	for _, e := range expected {
+		if !strings.Contains(code, e) {
+			t.Fatalf("Generated code doesn't contain %q", e)
+		}
+	}
+}
+
+func TestBigInt(t *testing.T) {
+	dir, err := ioutil.TempDir("", "shrinken")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "big.sddl")
+	err = ioutil.WriteFile(filename, []byte(`package big
+
+struct Big {
+	@encoding: "fixed"
+	long signed
+	ulong unsigned
+	int small
+}
+`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := generate(t, filename)
+	defer os.RemoveAll(out)
+
+	code := readGenerated(t, filepath.Join(out, "big.ts"))
+
+	expected := []string{
+		"signed: bigint;",
+		"unsigned: bigint;",
+		"small: number;",
+		"v.signed = BigInt.asIntN(64, r.readBigBits(64));",
+		"w.writeBigVarint(v.unsigned, 64);",
+		"v.small = r.readZigZag(32);",
+	}
+	for _, e := range expected {
+		if !strings.Contains(code, e) {
+			t.Fatalf("Generated code doesn't contain %q", e)
+		}
+	}
+}
+
+func TestSubtypes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "shrinken")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "zoo.sddl")
+	err = ioutil.WriteFile(filename, []byte(`package zoo
+
+class Animal {
+	int legs
+}
+
+class Cat : Animal {
+	bool purrs
+}
+
+@message
+class Zoo {
+	Animal[] animals
+}
+`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := generate(t, filename)
+	defer os.RemoveAll(out)
+
+	code := readGenerated(t, filepath.Join(out, "zoo.ts"))
+
+	expected := []string{
+		"import { BitReader, BitWriter, ShrinkenError } from \"./shrinken\";",
+		"$type: string;",
+		"$type: \"zoo.Cat\",",
+		"export function encodeAnimalSubtype(w: BitWriter, v: Animal | null): void {",
+		"encodeCat(w, v as Cat);",
+		"v.animals[i0] = decodeAnimalSubtype(r);",
+	}
+	for _, e := range expected {
+		if !strings.Contains(code, e) {
+			t.Fatalf("Generated code doesn't contain %q", e)
+		}
+	}
+}
+
+func TestDelta(t *testing.T) {
+	dir := generate(t, wiretest.Schema)
+	defer os.RemoveAll(dir)
+
+	code := readGenerated(t, filepath.Join(dir, "conformance.ts"))
+
+	expected := []string{
+		"export function changedFieldsSample(v: Sample, baseline: Sample): boolean[] {",
+		"v.flag !== baseline.flag,",
+		"differsVec(v.pos, baseline.pos),",
+		"v.shapes.length !== baseline.shapes.length || v.shapes.length > 0,",
+		"v.bigs.length !== baseline.bigs.length || v.bigs.some((x0, i0) => x0 !== baseline.bigs[i0]),",
+		"decodeVecDelta(r, v.pos);",
+		"export function deserializeSampleDelta(data: Uint8Array, v: Sample): Sample {",
+	}
+	for _, e := range expected {
+		if !strings.Contains(code, e) {
+			t.Fatalf("Generated code doesn't contain %q", e)
+		}
+	}
+}
+
+func TestAlignment(t *testing.T) {
+	dir := generate(t, wiretest.Schema)
+	defer os.RemoveAll(dir)
+
+	code := readGenerated(t, filepath.Join(dir, "conformance.ts"))
+
+	expected := []string{
+		"const w = new BitWriter();\n    w.byteAligned = true;\n    encodeAligned(w, v);",
+		"const r = new BitReader(data);\n    r.byteAligned = true;\n    return decodeAligned(r);",
+		"const w = new BitWriter();\n    w.byteAligned = true;\n    encodeAlignedDelta(w, v, baseline);",
+		"const r = new BitReader(data);\n    r.byteAligned = true;\n    return decodeAlignedDelta(r, v);",
+		"const w = new BitWriter();\n    encodeSample(w, v);",
+	}
+	for _, e := range expected {
+		if !strings.Contains(code, e) {
+			t.Fatalf("Generated code doesn't contain %q", e)
+		}
+	}
+}
+
+func TestEntropy(t *testing.T) {
+	dir := generate(t, wiretest.Schema)
+	defer os.RemoveAll(dir)
+
+	code := readGenerated(t, filepath.Join(dir, "conformance.ts"))
+
+	expected := []string{
+		"import { BitReader, BitWriter, HuffmanCode, ShrinkenError } from \"./shrinken\";",
+		"const huffman0: HuffmanCode = {\n    codes: [0, 1, 3],",
+		"w.writeSymbol(v.color, huffman0);",
+		"v.color = r.readSymbol(huffman0) as Color;",
+		"w.writeRangeSymbol(v.offset, -10, 10, huffman2);",
+		"v.offset = r.readRangeSymbol(-10, huffman2);",
+	}
+	for _, e := range expected {
+		if !strings.Contains(code, e) {
+			t.Fatalf("Generated code doesn't contain %q", e)
+		}
+	}
+}
+
+func TestMessageIds(t *testing.T) {
+	dir := generate(t, wiretest.Schema)
+	defer os.RemoveAll(dir)
+
+	code := readGenerated(t, filepath.Join(dir, "conformance.ts"))
+
+	expected := []string{
+		"export const messageIdAligned = 300;",
+		"export function serializeAlignedAny(v: Aligned): Uint8Array {\n    const w = new BitWriter();\n    w.writeVarint(messageIdAligned, 16);\n    w.byteAligned = true;",
+		"handleCoded(message: Coded): void;",
+		"case messageIdAligned: {\n            r.byteAligned = true;\n            handler.handleAligned(decodeAligned(r));",
+		"throw new ShrinkenError(\"shrinken: unknown message \" + id);",
+	}
+	for _, e := range expected {
+		if !strings.Contains(code, e) {
+			t.Fatalf("Generated code doesn't contain %q", e)
+		}
+	}
+}
+
+// conformanceMain is compiled with generated code, node typings aren't needed for the little it uses
+const conformanceMain = `
+import * as conformance from "./conformance";
+
+declare const process: { argv: string[] };
+declare function require(name: string): any;
+
+function fromHex(hex: string): Uint8Array {
+    const data = new Uint8Array(hex.length / 2);
+    for (let i = 0; i < data.length; i++) {
+        data[i] = parseInt(hex.slice(i * 2, i * 2 + 2), 16);
+    }
+    return data;
+}
+
+function toHex(data: Uint8Array): string {
+    return Array.from(data, (b) => b.toString(16).padStart(2, "0")).join("");
+}
+
+class Printer implements conformance.MessageHandler {
+    handleSample(message: conformance.Sample): void {
+        console.log(toHex(conformance.serializeSampleAny(message)));
+    }
+
+    handleAligned(message: conformance.Aligned): void {
+        console.log(toHex(conformance.serializeAlignedAny(message)));
+    }
+
+    handleCoded(message: conformance.Coded): void {
+        console.log(toHex(conformance.serializeCodedAny(message)));
+    }
+
+    handleBulk(message: conformance.Bulk): void {
+        console.log(toHex(conformance.serializeBulkAny(message)));
+    }
+}
+
+const lines: string[] = require("fs").readFileSync(0, "utf8").split("\n").map((line: string) => line.trim());
+const data = fromHex(lines[0]);
+const mode = process.argv[2];
+
+if (mode === "any") {
+    for (const line of lines.filter((line) => line !== "")) {
+        conformance.decodeAny(fromHex(line), new Printer());
+    }
+} else if (mode === "truncated") {
+    try {
+        conformance.deserializeBulk(data);
+        console.log("ok");
+    } catch (e) {
+        console.log((e as Error).message);
+    }
+} else if (mode === "aligned") {
+    console.log(toHex(conformance.serializeAligned(conformance.deserializeAligned(data))));
+} else if (mode === "coded") {
+    console.log(toHex(conformance.serializeCoded(conformance.deserializeCoded(data))));
+} else if (mode === "delta") {
+    const sample = conformance.deserializeSample(data);
+    const baseline = conformance.deserializeSample(data);
+    conformance.deserializeSampleDelta(fromHex(lines[1]), sample);
+    console.log(toHex(conformance.serializeSample(sample)));
+    console.log(toHex(conformance.serializeSampleDelta(sample, baseline)));
+} else {
+    console.log(toHex(conformance.serializeSample(conformance.deserializeSample(data))));
+}
+`
+
+func TestConformance(t *testing.T) {
+	tsc := wiretest.Tool(t, "tsc")
+	node := wiretest.Tool(t, "node")
+
+	dir := generate(t, wiretest.Schema)
+	defer os.RemoveAll(dir)
+
+	err := ioutil.WriteFile(filepath.Join(dir, "main.ts"), []byte(conformanceMain), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := filepath.Join(dir, "out")
+	cmd := exec.Command(tsc, "--strict", "--target", "es2020", "--module", "commonjs", "--outDir", out,
+		"main.ts", "conformance.ts", "shrinken.ts")
+	cmd.Dir = dir
+	wiretest.Build(t, cmd)
+
+	program := filepath.Join(out, "main.js")
+	wiretest.Run(t, exec.Command(node, program))
+	wiretest.RunDelta(t, exec.Command(node, program))
+	wiretest.RunAligned(t, exec.Command(node, program))
+	wiretest.RunCoded(t, exec.Command(node, program))
+	wiretest.RunAny(t, exec.Command(node, program))
+	wiretest.RunTruncated(t, exec.Command(node, program))
+}
@@ -0,0 +1,66 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// CodeWriter builds source code line by line, keeping track of indentation
+
+type CodeWriter struct {
+	buf    bytes.Buffer
+	indent string
+	level  int
+}
+
+func NewCodeWriter(indent string) *CodeWriter {
+	return &CodeWriter{
+		indent: indent,
+	}
+}
+
+// Line writes single indented line. Line is used as format string only if any args are given.
+func (w *CodeWriter) Line(line string, args ...interface{}) {
+	if len(args) > 0 {
+		line = fmt.Sprintf(line, args...)
+	}
+
+	if line != "" {
+		w.buf.WriteString(strings.Repeat(w.indent, w.level))
+		w.buf.WriteString(line)
+	}
+	w.buf.WriteByte('\n')
+}
+
+// Raw writes text as is, without indentation
+func (w *CodeWriter) Raw(text string) {
+	w.buf.WriteString(text)
+}
+
+func (w *CodeWriter) Indent() {
+	w.level++
+}
+
+func (w *CodeWriter) Dedent() {
+	if w.level > 0 {
+		w.level--
+	}
+}
+
+func (w *CodeWriter) Bytes() []byte {
+	return w.buf.Bytes()
+}
+
+// WriteFile writes content to file, creating parent directories if needed
+func WriteFile(filename string, content []byte) error {
+	err := os.MkdirAll(filepath.Dir(filename), 0755)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filename, content, 0644)
+}
@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"shrinken/sddl/analyzer"
 	"shrinken/sddl/ast"
+	"shrinken/sddl/ast/attributes"
 	"shrinken/sddl/lexer"
 	"shrinken/sddl/parser"
 	"testing"
@@ -57,6 +58,14 @@ func TestBasic(t *testing.T) {
 	testFileForAnalyzerErrors(t, "test_data/single_file/basic.sddl", true)
 }
 
+func TestQuaternion(t *testing.T) {
+	testFileForAnalyzerErrors(t, "test_data/single_file/quaternion.sddl", true)
+}
+
+func TestNormalized(t *testing.T) {
+	testFileForAnalyzerErrors(t, "test_data/single_file/normalized.sddl", true)
+}
+
 func TestUnknownType(t *testing.T) {
 	testFileForAnalyzerErrors(t, "test_data/single_file/unknown_type.sddl", false)
 }
@@ -89,24 +98,824 @@ class Test {
 	float variable
 }
 `, true)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ range: [0, 300]
+	byte variable
 }
+`, false)
 
-func TestCircularInheritance(t *testing.T) {
-	testFileForAnalyzerErrors(t, "test_data/single_file/circular_inheritance.sddl", false)
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ range: <0, 1>
+	int variable
 }
+`, false)
 
-func TestCircularInheritanceChain(t *testing.T) {
-	testFileForAnalyzerErrors(t, "test_data/single_file/circular_inheritance_chain.sddl", false)
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ range: [0, 2^64>
+	ulong variable
+	@ range: <-10, 10>
+	short other
+}
+`, true)
 }
 
-func TestClassExtendingStruct(t *testing.T) {
-	testFileForAnalyzerErrors(t, "test_data/single_file/class_extending_struct.sddl", false)
+func TestPrecisionAttribute(t *testing.T) {
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ range: [-1000, 1000]
+	@ precision: 1/2^20
+	float variable
 }
+`, true)
 
-func TestStructExtendingClass(t *testing.T) {
-	testFileForAnalyzerErrors(t, "test_data/single_file/struct_extending_class.sddl", false)
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ range: [-1000, 1000]
+	@ precision: 1/2^22
+	float variable
 }
+`, false)
 
-func TestSameNameClasses(t *testing.T) {
-	testFolderForAnalyzerErrors(t, "test_data/multipkg/same_name/", true)
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ range: [1, 1]
+	@ precision: 0.1
+	float variable
+}
+`, false)
+
+	// steps are rounded up, so range of 2^32-1 steps fits 32 bits, but a bit longer range doesn't
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ range: [0, 4294967295]
+	@ precision: 1
+	float variable
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ range: [0, 4294967295.5]
+	@ precision: 1
+	float variable
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ precision: -0.1
+	float variable
+}
+`, false)
+}
+
+func TestPrecisionBits(t *testing.T) {
+	tree, err := ParseMergeAndAnalyze("test_data/wire/conformance.sddl")
+	if err != nil {
+		t.Fatal("SDDL couldn't be parsed!", err)
+	}
+
+	expected := map[string]int{"x": 15, "ratio": 10, "coarse": 4}
+	found := 0
+	for _, elem := range tree.Packages[0].Body.Elements {
+		s, ok := elem.(*ast.StructDef)
+		if !ok || s.Name != "Sample" {
+			continue
+		}
+		for _, variable := range s.Body.Variables {
+			for _, attb := range variable.AttributesList {
+				if p, ok := attb.(*attributes.PrecisionAttribute); ok {
+					if p.Bits != expected[variable.Name] {
+						t.Fatalf("Variable %v is quantized to %v bits, expected %v", variable.Name, p.Bits, expected[variable.Name])
+					}
+					found++
+				}
+			}
+		}
+	}
+
+	if found != len(expected) {
+		t.Fatalf("Found %v quantized variables, expected %v", found, len(expected))
+	}
+}
+
+func TestEncodingAttribute(t *testing.T) {
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ encoding: "varint"
+	int variable
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ encoding: "zigzag"
+	long variable
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ encoding: "fixed"
+	ulong variable
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ encoding: "zigzag"
+	uint variable
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ encoding: "varint"
+	float variable
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ encoding: "compact"
+	int variable
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ encoding: "varint"
+	@ range: [0, 10]
+	int variable
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ encoding: "varint"
+	int[] variable
+}
+`, false)
+}
+
+func TestMaxLengthAttribute(t *testing.T) {
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ maxLength: 16
+	string variable
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ maxLength: 2^32 - 1
+	string variable
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ maxLength: 0
+	string variable
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ maxLength: 2.5
+	string variable
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ maxLength: 16
+	int variable
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ maxLength: 16
+	string[] variable
+}
+`, false)
+}
+
+func TestCharsetAttribute(t *testing.T) {
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ charset: "ascii"
+	string variable
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ charset: "utf8"
+	char variable
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ charset: "latin1"
+	string variable
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ charset: "ascii"
+	int variable
+}
+`, false)
+}
+
+func TestMaxCountAttribute(t *testing.T) {
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ maxCount: 16
+	int[] variable
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ maxCount: 4
+	int[][4] variable
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ maxCount: 4
+	int[4][] variable
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ maxCount: 2^32 - 1
+	string[] variable
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ maxCount: 4
+	int[4] variable
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ maxCount: 4
+	int[4][2] variable
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ maxCount: 4
+	int variable
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ maxCount: 0
+	int[] variable
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@ maxCount: 2.5
+	int[] variable
+}
+`, false)
+}
+
+func TestDeltaAttribute(t *testing.T) {
+	testForAnalyzerErrors(t, `package test
+
+@message
+@delta
+class Test {
+	int variable
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+@delta
+@message
+struct Test {
+	int variable
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+@delta
+class Test {
+	int variable
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+@message
+@delta
+enum Test {
+	A,
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@delta
+	int variable
+}
+`, false)
+}
+
+func TestQuaternionAttribute(t *testing.T) {
+	testForAnalyzerErrors(t, `package test
+
+@quaternion
+@precision: 1/1000
+struct Test {
+	float i, j, k, w
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+@quaternion
+struct Test {
+	float i, j, k, w
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+@quaternion
+@precision: 1/2^40
+struct Test {
+	float i, j, k, w
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+@quaternion
+@precision: sqrt(2)/(2^32 - 1)
+struct Test {
+	float i, j, k, w
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+@quaternion
+@precision: sqrt(2)/(2^32 - 1/2)
+struct Test {
+	float i, j, k, w
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+@quaternion
+@precision: 1/1000
+class Test {
+	float i, j, k, w
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+@quaternion
+@precision: 1/1000
+struct Test {
+	float i, j, k
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+@quaternion
+@precision: 1/1000
+struct Test {
+	float i, j, k
+	double w
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+@quaternion
+@precision: 1/1000
+struct Test {
+	float i, j, k
+	float[1] w
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+@quaternion
+@precision: 1/1000
+struct Test {
+	float i, j, k
+	@range: [-1, 1]
+	float w
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+struct Base {
+}
+
+@quaternion
+@precision: 1/1000
+struct Test : Base {
+	float i, j, k, w
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+@precision: 1/1000
+struct Test {
+	float i, j, k, w
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@quaternion
+	float variable
+}
+`, false)
+}
+
+func TestNormalizedAttribute(t *testing.T) {
+	testForAnalyzerErrors(t, `package test
+
+@normalized: 12
+struct Test {
+	float x, y, z
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+@normalized: 1
+struct Test {
+	float x, y, z
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+@normalized: 33
+struct Test {
+	float x, y, z
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+@normalized: 7.5
+struct Test {
+	float x, y, z
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+@normalized: 12
+class Test {
+	float x, y, z
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+@normalized: 12
+struct Test {
+	float x, y
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+@normalized: 12
+struct Test {
+	float x, y
+	double z
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+@normalized: 12
+struct Test {
+	float x, y
+	@range: [-1, 1]
+	@precision: 0.01
+	float z
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+@normalized: 12
+@precision: 0.01
+struct Test {
+	float x, y, z
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@normalized: 12
+	float variable
+}
+`, false)
+}
+
+func TestAlignmentAttribute(t *testing.T) {
+	testForAnalyzerErrors(t, `@alignment: "byte"
+package test
+
+@message
+@alignment: "bit"
+struct Test {
+	bool flag
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+@message
+@alignment: "byte"
+enum Test {
+	A,
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+@message
+@alignment: "nibble"
+struct Test {
+	bool flag
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+@alignment: "byte"
+struct Test {
+	bool flag
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@alignment: "byte"
+	bool flag
+}
+`, false)
+}
+
+func TestEntropyAttribute(t *testing.T) {
+	testForAnalyzerErrors(t, `package test
+
+@message
+@entropy
+struct Test {
+	@range: [0, 3]
+	int variable
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+@entropy
+struct Test {
+	@range: [0, 3]
+	int variable
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+@message
+@entropy
+enum Test {
+	A,
+	B,
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+class Test {
+	@entropy
+	int variable
+}
+`, false)
+}
+
+func TestIdAttribute(t *testing.T) {
+	testForAnalyzerErrors(t, `package test
+
+@message
+@id: 300
+struct Test {
+	int id
+}
+
+@message
+@id: 0
+enum Kind {
+	A,
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+@id: 1
+struct Test {
+	int variable
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+@message
+@id: 65536
+struct Test {
+	int variable
+}
+`, false)
+
+	testForAnalyzerErrors(t, `package test
+
+@message
+@id: 1.5
+struct Test {
+	int variable
+}
+`, false)
+
+	// implicit IDs of messages differ
+	testForAnalyzerErrors(t, `package test
+
+@message
+struct First {
+	int variable
+}
+
+@message
+struct Second {
+	int variable
+}
+`, true)
+
+	testForAnalyzerErrors(t, `package test
+
+@message
+@id: 2
+struct First {
+	int variable
+}
+
+@message
+@id: 2
+struct Second {
+	int variable
+}
+`, false)
+}
+
+func TestSameIdPackages(t *testing.T) {
+	testFolderForAnalyzerErrors(t, "test_data/multipkg/same_id/", false)
+}
+
+func TestCircularInheritance(t *testing.T) {
+	testFileForAnalyzerErrors(t, "test_data/single_file/circular_inheritance.sddl", false)
+}
+
+func TestCircularInheritanceChain(t *testing.T) {
+	testFileForAnalyzerErrors(t, "test_data/single_file/circular_inheritance_chain.sddl", false)
+}
+
+func TestClassExtendingStruct(t *testing.T) {
+	testFileForAnalyzerErrors(t, "test_data/single_file/class_extending_struct.sddl", false)
+}
+
+func TestStructExtendingClass(t *testing.T) {
+	testFileForAnalyzerErrors(t, "test_data/single_file/struct_extending_class.sddl", false)
+}
+
+func TestSameNameClasses(t *testing.T) {
+	testFolderForAnalyzerErrors(t, "test_data/multipkg/same_name/", true)
+}
+
+func TestSelfReference(t *testing.T) {
+	testFileForAnalyzerErrors(t, "test_data/single_file/self_reference.sddl", false)
+}
+
+func TestRecursiveTypes(t *testing.T) {
+	// reference through base class
+	testForAnalyzerErrors(t, `package test.package
+class Base {
+	Derived d
+}
+
+class Derived : Base {
+	int v
+}
+`, false)
+
+	// chain of classes and fixed size array
+	testForAnalyzerErrors(t, `package test.package
+class A {
+	B[2] b
+}
+
+struct B {
+	A a
+}
+`, false)
+
+	// dynamic array can be empty
+	testForAnalyzerErrors(t, `package test.package
+class Tree {
+	int v
+	Tree[] children
+}
+`, true)
+
+	// default of base class doesn't contain derived class
+	testForAnalyzerErrors(t, `package test.package
+class Shape {
+	int id
+}
+
+class Group : Shape {
+	Shape first
+}
+`, true)
 }
@@ -105,3 +105,41 @@ func TestMath4(t *testing.T) {
 func TestMath5(t *testing.T) {
 	testForParserMathEvalErrors(t, "2.175", 2.175)
 }
+
+func TestAttributeNamesAsVariables(t *testing.T) {
+	names := []string{"encoding", "maxLength", "charset", "maxCount", "delta", "quaternion", "normalized",
+		"alignment", "entropy", "id"}
+
+	SDDL := "package test\nstruct Test {\n"
+	for _, name := range names {
+		SDDL += fmt.Sprintf("\t@delta\n\tfloat %v\n", name)
+	}
+	SDDL += "}\n"
+
+	lex := lexer.NewLexer([]byte(SDDL))
+	r, err := parser.NewParser().Parse(lex)
+	if err != nil {
+		t.Fatal("SDDL couldn't be parsed!", err)
+	}
+
+	variables := r.(*ast.PackageDef).Body.Elements[0].(*ast.StructDef).Body.Variables
+	for i, name := range names {
+		if variables[i].Name != name {
+			t.Fatalf("Variable %v was parsed as %v", name, variables[i].Name)
+		}
+	}
+
+	testForParserErrors(t, `package test
+struct Test {
+	float x, delta, entropy
+	int encoding, maxCount
+}
+`, true)
+}
+
+func TestStringAttributeValues(t *testing.T) {
+	for _, name := range []string{"encoding", "charset", "alignment"} {
+		testForParserErrors(t, fmt.Sprintf("package test\nstruct Test {\n\t@%v: \"value\"\n\tint x\n}\n", name), true)
+		testForParserErrors(t, fmt.Sprintf("package test\nstruct Test {\n\t@%v: value\n\tint x\n}\n", name), false)
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"reflect"
 	"shrinken/sddl/ast"
+	"shrinken/sddl/ast/attributes"
 	"shrinken/sddl/token"
+	"strings"
 )
 
 // semantic analysis of parsed AST(s)
@@ -47,6 +49,124 @@ func (a *staticAnalyzer) Analyze(packages []*ast.PackageDef) error {
 		}
 	}
 
+	// null class reference is written as instance with default values, so types can't contain
+	// themselves other than through dynamic arrays, which can be empty
+
+	err = checkRecursiveTypes(packages)
+	if err != nil {
+		return err
+	}
+
+	// finally message IDs have to be unique across all packages
+
+	return checkMessageIds(packages)
+}
+
+func checkRecursiveTypes(packages []*ast.PackageDef) error {
+	visiting := make(map[*ast.StructDef]bool)
+	done := make(map[*ast.StructDef]bool)
+	for _, pkg := range packages {
+		for _, elem := range pkg.Body.Elements {
+			if def, ok := elem.(*ast.StructDef); ok {
+				err := checkContainedTypes(def, nil, visiting, done)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// fieldLink is field of struct or class which contains value of another one
+type fieldLink struct {
+	def  *ast.StructDef
+	name string
+}
+
+// checkContainedTypes walks types of fields of def and of its base classes, chain holds fields which lead to def
+func checkContainedTypes(def *ast.StructDef, chain []fieldLink, visiting, done map[*ast.StructDef]bool) error {
+	if done[def] {
+		return nil
+	}
+	if visiting[def] {
+		kind := "Struct"
+		if def.IsClass {
+			kind = "Class"
+		}
+		var names []string
+		for i := len(chain) - 1; i >= 0; i-- {
+			names = append([]string{chain[i].name}, names...)
+			if chain[i].def == def {
+				break
+			}
+		}
+		return fmt.Errorf("%v %v contains itself through fields (%v), only dynamic array can hold it on %v",
+			kind, def.Name, strings.Join(names, ", "), def.Position.String())
+	}
+
+	visiting[def] = true
+	for s := def; s != nil; {
+		for _, variable := range s.Body.Variables {
+			t := variable.Type
+			for t != nil && t.IsArray {
+				if t.ArraySize <= 0 {
+					t = nil
+				} else {
+					t = t.ArrayChildType
+				}
+			}
+			if t == nil || t.IsGeneric {
+				continue
+			}
+
+			if field, ok := t.TypeDefinition.(*ast.StructDef); ok {
+				err := checkContainedTypes(field, append(chain, fieldLink{def, s.Name + "." + variable.Name}), visiting, done)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		s, _ = s.OverridesTypeDef.(*ast.StructDef)
+	}
+	visiting[def] = false
+	done[def] = true
+	return nil
+}
+
+func checkMessageIds(packages []*ast.PackageDef) error {
+	names := make(map[uint16]string)
+	for _, pkg := range packages {
+		for _, elem := range pkg.Body.Elements {
+			var name string
+			var attributesList []ast.Attribute
+			var position token.Pos
+			switch def := elem.(type) {
+			case *ast.StructDef:
+				name, attributesList, position = def.Name, def.AttributesList, def.Position
+			case *ast.EnumDef:
+				name, attributesList, position = def.Name, def.AttributesList, def.Position
+			}
+
+			isMessage := false
+			for _, attb := range attributesList {
+				if _, ok := attb.(*attributes.MessageAttribute); ok {
+					isMessage = true
+				}
+			}
+			if !isMessage {
+				continue
+			}
+
+			qualifiedName := pkg.Name + "." + name
+			id := attributes.MessageId(qualifiedName, attributesList)
+			if other, ok := names[id]; ok {
+				return fmt.Errorf("Messages %v and %v have the same id %v, set different one with id attribute on %v",
+					other, qualifiedName, id, position.String())
+			}
+			names[id] = qualifiedName
+		}
+	}
 	return nil
 }
 
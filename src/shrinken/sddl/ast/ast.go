@@ -254,7 +254,7 @@ func NewArrayOfTypeWithSize(typeDef interface{}, size interface{}) *VariableType
 	return &VariableType{
 		IsArray:        true,
 		ArrayChildType: typeDef.(*VariableType),
-		ArraySize:      size.(int),
+		ArraySize:      int(ToInt64(size)),
 	}
 }
 
@@ -0,0 +1,58 @@
+package attributes
+
+import (
+	"fmt"
+	"reflect"
+	"shrinken/sddl/ast"
+)
+
+// AlignmentAttribute selects layout of message, "bit" packs its fields tightly and "byte" pads every
+// bit field to whole bytes. Attribute of package is the default for all its messages.
+type AlignmentAttribute struct {
+	ast.Attribute
+	Alignment string
+}
+
+func NewAlignmentAttribute(alignment interface{}) *AlignmentAttribute {
+	return &AlignmentAttribute{
+		Alignment: ast.ToStrUnquote(alignment),
+	}
+}
+
+func (attb *AlignmentAttribute) Accept(visitor ast.Visitor) {
+	visitor.VisitAttribute(attb)
+}
+
+func (attb *AlignmentAttribute) String() string {
+	return fmt.Sprint("Alignment ", attb.Alignment)
+}
+
+func (attb *AlignmentAttribute) IsApplicable(t reflect.Type, node ast.ASTNode) (bool, error) {
+	if attb.Alignment != "bit" && attb.Alignment != "byte" {
+		return false, fmt.Errorf("Unknown alignment %q, expected \"bit\" or \"byte\"", attb.Alignment)
+	}
+
+	var attributesList []ast.Attribute
+	switch t {
+	case reflect.TypeOf(&ast.PackageDef{}):
+		// packages declared in several files are merged together with their attributes
+		for _, a := range node.(*ast.PackageDef).AttributesList {
+			if other, ok := a.(*AlignmentAttribute); ok && other.Alignment != attb.Alignment {
+				return false, fmt.Errorf("Package %v has conflicting alignment attributes", node.(*ast.PackageDef).Name)
+			}
+		}
+		return true, nil
+	case reflect.TypeOf(&ast.StructDef{}):
+		attributesList = node.(*ast.StructDef).AttributesList
+	case reflect.TypeOf(&ast.EnumDef{}):
+		attributesList = node.(*ast.EnumDef).AttributesList
+	}
+
+	for _, a := range attributesList {
+		if _, ok := a.(*MessageAttribute); ok {
+			return true, nil
+		}
+	}
+
+	return false, fmt.Errorf("Alignment attribute can only be applied to packages and messages")
+}
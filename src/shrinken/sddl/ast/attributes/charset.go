@@ -0,0 +1,44 @@
+package attributes
+
+import (
+	"fmt"
+	"reflect"
+	"shrinken/sddl/ast"
+)
+
+// CharsetAttribute selects characters which string or char variable can hold, it's ascii or utf8
+type CharsetAttribute struct {
+	ast.Attribute
+	Charset string
+}
+
+func NewCharsetAttribute(charset interface{}) *CharsetAttribute {
+	return &CharsetAttribute{
+		Charset: ast.ToStrUnquote(charset),
+	}
+}
+
+func (attb *CharsetAttribute) Accept(visitor ast.Visitor) {
+	visitor.VisitAttribute(attb)
+}
+
+func (attb *CharsetAttribute) String() string {
+	return fmt.Sprint("Charset ", attb.Charset)
+}
+
+func (attb *CharsetAttribute) IsApplicable(t reflect.Type, node ast.ASTNode) (bool, error) {
+	if attb.Charset != "ascii" && attb.Charset != "utf8" {
+		return false, fmt.Errorf("Unknown charset %q, expected \"ascii\" or \"utf8\"", attb.Charset)
+	}
+
+	if t == reflect.TypeOf(&ast.Variable{}) {
+		if node.(*ast.Variable).Type.IsGeneric &&
+			(node.(*ast.Variable).Type.GenericType == ast.String ||
+				node.(*ast.Variable).Type.GenericType == ast.Char) {
+
+			return true, nil
+		}
+	}
+
+	return false, fmt.Errorf("Charset attribute can only be applied to string or char variables")
+}
@@ -0,0 +1,37 @@
+package attributes
+
+import (
+	"fmt"
+	"reflect"
+	"shrinken/sddl/ast"
+)
+
+// DeltaAttribute makes message class or struct serializable as delta against baseline instance,
+// holding only fields which differ from it
+type DeltaAttribute struct {
+	ast.Attribute
+}
+
+func NewDeltaAttribute() *DeltaAttribute {
+	return &DeltaAttribute{}
+}
+
+func (attb *DeltaAttribute) Accept(visitor ast.Visitor) {
+	visitor.VisitAttribute(attb)
+}
+
+func (attb *DeltaAttribute) String() string {
+	return "Delta"
+}
+
+func (attb *DeltaAttribute) IsApplicable(t reflect.Type, node ast.ASTNode) (bool, error) {
+	if t == reflect.TypeOf(&ast.StructDef{}) {
+		for _, a := range node.(*ast.StructDef).AttributesList {
+			if _, ok := a.(*MessageAttribute); ok {
+				return true, nil
+			}
+		}
+	}
+
+	return false, fmt.Errorf("Delta attribute can only be applied to message classes and structs")
+}
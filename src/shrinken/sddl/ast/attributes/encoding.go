@@ -0,0 +1,52 @@
+package attributes
+
+import (
+	"fmt"
+	"reflect"
+	"shrinken/sddl/ast"
+)
+
+// EncodingAttribute selects how integer variable is written, it's one of "fixed", "varint" or "zigzag"
+type EncodingAttribute struct {
+	ast.Attribute
+	Encoding string
+}
+
+func NewEncodingAttribute(encoding interface{}) *EncodingAttribute {
+	return &EncodingAttribute{
+		Encoding: ast.ToStrUnquote(encoding),
+	}
+}
+
+func (attb *EncodingAttribute) Accept(visitor ast.Visitor) {
+	visitor.VisitAttribute(attb)
+}
+
+func (attb *EncodingAttribute) String() string {
+	return fmt.Sprint("Encoding ", attb.Encoding)
+}
+
+func (attb *EncodingAttribute) IsApplicable(t reflect.Type, node ast.ASTNode) (bool, error) {
+	if attb.Encoding != "fixed" && attb.Encoding != "varint" && attb.Encoding != "zigzag" {
+		return false, fmt.Errorf("Unknown encoding %q, expected \"fixed\", \"varint\" or \"zigzag\"", attb.Encoding)
+	}
+
+	if t == reflect.TypeOf(&ast.Variable{}) {
+		variable := node.(*ast.Variable)
+		if limits, ok := integerLimits[variable.Type.GenericType]; ok && variable.Type.IsGeneric {
+			for _, a := range variable.AttributesList {
+				if _, ok := a.(*RangeAttribute); ok {
+					return false, fmt.Errorf("Encoding attribute of variable %v can't be combined with range attribute", variable.Name)
+				}
+			}
+
+			if attb.Encoding == "zigzag" && limits.min.Sign() == 0 {
+				return false, fmt.Errorf("Zigzag encoding of variable %v can only be applied to signed integer", variable.Name)
+			}
+
+			return true, nil
+		}
+	}
+
+	return false, fmt.Errorf("Encoding attribute can only be applied to integer variables")
+}
@@ -0,0 +1,39 @@
+package attributes
+
+import (
+	"fmt"
+	"reflect"
+	"shrinken/sddl/ast"
+)
+
+// EntropyAttribute makes fields of message class or struct holding enums and small ranged integers written
+// as Huffman codes, by tables trained from sample messages. CodeLengths holds trained lengths of codes of
+// field values by field name, message without them is written as if it had no entropy attribute.
+type EntropyAttribute struct {
+	ast.Attribute
+	CodeLengths map[string][]int
+}
+
+func NewEntropyAttribute() *EntropyAttribute {
+	return &EntropyAttribute{}
+}
+
+func (attb *EntropyAttribute) Accept(visitor ast.Visitor) {
+	visitor.VisitAttribute(attb)
+}
+
+func (attb *EntropyAttribute) String() string {
+	return "Entropy"
+}
+
+func (attb *EntropyAttribute) IsApplicable(t reflect.Type, node ast.ASTNode) (bool, error) {
+	if t == reflect.TypeOf(&ast.StructDef{}) {
+		for _, a := range node.(*ast.StructDef).AttributesList {
+			if _, ok := a.(*MessageAttribute); ok {
+				return true, nil
+			}
+		}
+	}
+
+	return false, fmt.Errorf("Entropy attribute can only be applied to message classes and structs")
+}
@@ -0,0 +1,68 @@
+package attributes
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"reflect"
+	"shrinken/sddl/ast"
+)
+
+const MaxMessageId = math.MaxUint16
+
+// IdAttribute sets ID of message, which identifies it among messages of all packages. Messages
+// without the attribute get ID derived from their qualified name.
+type IdAttribute struct {
+	ast.Attribute
+	Id float64
+}
+
+func NewIdAttribute(id interface{}) *IdAttribute {
+	return &IdAttribute{
+		Id: id.(float64),
+	}
+}
+
+func (attb *IdAttribute) Accept(visitor ast.Visitor) {
+	visitor.VisitAttribute(attb)
+}
+
+func (attb *IdAttribute) String() string {
+	return fmt.Sprint("Id ", attb.Id)
+}
+
+func (attb *IdAttribute) IsApplicable(t reflect.Type, node ast.ASTNode) (bool, error) {
+	var attributesList []ast.Attribute
+	switch t {
+	case reflect.TypeOf(&ast.StructDef{}):
+		attributesList = node.(*ast.StructDef).AttributesList
+	case reflect.TypeOf(&ast.EnumDef{}):
+		attributesList = node.(*ast.EnumDef).AttributesList
+	}
+
+	for _, a := range attributesList {
+		if _, ok := a.(*MessageAttribute); ok {
+			if math.Trunc(attb.Id) != attb.Id || attb.Id < 0 || attb.Id > MaxMessageId {
+				return false, fmt.Errorf("Id %v must be integer between 0 and %v", attb.Id, MaxMessageId)
+			}
+			return true, nil
+		}
+	}
+
+	return false, fmt.Errorf("Id attribute can only be applied to messages")
+}
+
+// MessageId returns ID of message with qualified name (game.Move) and attributes, set by id attribute or
+// derived from the name as 32 bit FNV-1a hash with its upper and lower half xored together
+func MessageId(qualifiedName string, attributesList []ast.Attribute) uint16 {
+	for _, a := range attributesList {
+		if id, ok := a.(*IdAttribute); ok {
+			return uint16(id.Id)
+		}
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(qualifiedName))
+	sum := h.Sum32()
+	return uint16(sum>>16 ^ sum)
+}
@@ -0,0 +1,50 @@
+package attributes
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"shrinken/sddl/ast"
+)
+
+// MaxCountAttribute limits number of elements of every dynamic array in type of variable
+type MaxCountAttribute struct {
+	ast.Attribute
+	MaxCount float64
+}
+
+func NewMaxCountAttribute(c interface{}) *MaxCountAttribute {
+	return &MaxCountAttribute{
+		MaxCount: c.(float64),
+	}
+}
+
+func (attb *MaxCountAttribute) Accept(visitor ast.Visitor) {
+	visitor.VisitAttribute(attb)
+}
+
+func (attb *MaxCountAttribute) String() string {
+	return fmt.Sprint("MaxCount ", attb.MaxCount)
+}
+
+func (attb *MaxCountAttribute) IsApplicable(t reflect.Type, node ast.ASTNode) (bool, error) {
+	if t == reflect.TypeOf(&ast.Variable{}) && hasDynamicArray(node.(*ast.Variable).Type) {
+		if math.Trunc(attb.MaxCount) != attb.MaxCount || attb.MaxCount < 1 || attb.MaxCount > math.MaxUint32 {
+			return false, fmt.Errorf("MaxCount %v must be integer between 1 and %v", attb.MaxCount, uint64(math.MaxUint32))
+		}
+
+		return true, nil
+	}
+
+	return false, fmt.Errorf("MaxCount attribute can only be applied to variables containing dynamic arrays")
+}
+
+// hasDynamicArray reports whether type is dynamic array, or array of arrays with dynamic one at any level
+func hasDynamicArray(t *ast.VariableType) bool {
+	for ; t != nil && t.IsArray; t = t.ArrayChildType {
+		if t.ArraySize == -1 {
+			return true
+		}
+	}
+	return false
+}
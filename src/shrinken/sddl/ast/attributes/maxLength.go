@@ -0,0 +1,44 @@
+package attributes
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"shrinken/sddl/ast"
+)
+
+// MaxLengthAttribute limits length of string variable, in bytes of its charset
+type MaxLengthAttribute struct {
+	ast.Attribute
+	MaxLength float64
+}
+
+func NewMaxLengthAttribute(l interface{}) *MaxLengthAttribute {
+	return &MaxLengthAttribute{
+		MaxLength: l.(float64),
+	}
+}
+
+func (attb *MaxLengthAttribute) Accept(visitor ast.Visitor) {
+	visitor.VisitAttribute(attb)
+}
+
+func (attb *MaxLengthAttribute) String() string {
+	return fmt.Sprint("MaxLength ", attb.MaxLength)
+}
+
+func (attb *MaxLengthAttribute) IsApplicable(t reflect.Type, node ast.ASTNode) (bool, error) {
+	if t == reflect.TypeOf(&ast.Variable{}) {
+		if node.(*ast.Variable).Type.IsGeneric &&
+			node.(*ast.Variable).Type.GenericType == ast.String {
+
+			if math.Trunc(attb.MaxLength) != attb.MaxLength || attb.MaxLength < 1 || attb.MaxLength > math.MaxUint32 {
+				return false, fmt.Errorf("MaxLength %v must be integer between 1 and %v", attb.MaxLength, uint64(math.MaxUint32))
+			}
+
+			return true, nil
+		}
+	}
+
+	return false, fmt.Errorf("MaxLength attribute can only be applied to string variables")
+}
@@ -0,0 +1,52 @@
+package attributes
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"shrinken/sddl/ast"
+)
+
+// NormalizedAttribute makes struct of three floats written as unit vector with octahedral encoding, which
+// projects it to two coordinates quantized to Bits bits each
+type NormalizedAttribute struct {
+	ast.Attribute
+	Bits float64
+}
+
+func NewNormalizedAttribute(b interface{}) *NormalizedAttribute {
+	return &NormalizedAttribute{
+		Bits: b.(float64),
+	}
+}
+
+func (attb *NormalizedAttribute) Accept(visitor ast.Visitor) {
+	visitor.VisitAttribute(attb)
+}
+
+func (attb *NormalizedAttribute) String() string {
+	return fmt.Sprint("Normalized ", attb.Bits)
+}
+
+func (attb *NormalizedAttribute) IsApplicable(t reflect.Type, node ast.ASTNode) (bool, error) {
+	if t != reflect.TypeOf(&ast.StructDef{}) || node.(*ast.StructDef).IsClass {
+		return false, fmt.Errorf("Normalized attribute can only be applied to structs")
+	}
+
+	s := node.(*ast.StructDef)
+	if math.Trunc(attb.Bits) != attb.Bits || attb.Bits < 2 || attb.Bits > 32 {
+		return false, fmt.Errorf("Normalized %v of struct %v must be integer between 2 and 32", attb.Bits, s.Name)
+	}
+
+	if err := checkComponents(s, "Normalized", 3); err != nil {
+		return false, err
+	}
+
+	for _, a := range s.AttributesList {
+		if _, ok := a.(*DeltaAttribute); ok {
+			return false, fmt.Errorf("Normalized struct %v can't be delta message", s.Name)
+		}
+	}
+
+	return true, nil
+}
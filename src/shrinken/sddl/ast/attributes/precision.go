@@ -2,14 +2,19 @@ package attributes
 
 import (
 	"fmt"
+	"math"
+	"math/bits"
 	"reflect"
 	"shrinken/sddl/ast"
 )
 
-// PrecisionAttribute sets precision of floating variable
+// PrecisionAttribute sets precision of floating variable. Together with RangeAttribute it makes
+// variable quantized, that is written as fixed-point number of Bits bits. On struct with QuaternionAttribute
+// it sets precision of its components.
 type PrecisionAttribute struct {
 	ast.Attribute
 	Precision float64
+	Bits      int
 }
 
 func NewPrecisionAttribute(p interface{}) *PrecisionAttribute {
@@ -23,17 +28,81 @@ func (attb *PrecisionAttribute) Accept(visitor ast.Visitor) {
 }
 
 func (attb *PrecisionAttribute) String() string {
+	if attb.Bits > 0 {
+		return fmt.Sprint("Precision ", attb.Precision, " (", attb.Bits, " bits)")
+	}
 	return fmt.Sprint("Precision ", attb.Precision)
 }
 
 func (attb *PrecisionAttribute) IsApplicable(t reflect.Type, node ast.ASTNode) (bool, error) {
 	if t == reflect.TypeOf(&ast.Variable{}) {
-		if node.(*ast.Variable).Type.IsGeneric &&
-			node.(*ast.Variable).Type.GenericType == ast.Float {
+		variable := node.(*ast.Variable)
+		if variable.Type.IsGeneric &&
+			variable.Type.GenericType == ast.Float {
+
+			if !(attb.Precision > 0) || math.IsInf(attb.Precision, 0) {
+				return false, fmt.Errorf("Precision %v of variable %v must be positive number", attb.Precision, variable.Name)
+			}
+
+			for _, a := range variable.AttributesList {
+				if r, ok := a.(*RangeAttribute); ok {
+					return attb.quantize(variable, r.Range)
+				}
+			}
 
 			return true, nil
 		}
 	}
 
-	return false, fmt.Errorf("Precision attribute can only be applied to float variables")
+	if t == reflect.TypeOf(&ast.StructDef{}) {
+		s := node.(*ast.StructDef)
+		for _, a := range s.AttributesList {
+			if _, ok := a.(*QuaternionAttribute); ok {
+				if !(attb.Precision > 0) || math.IsInf(attb.Precision, 0) {
+					return false, fmt.Errorf("Precision %v of quaternion %v must be positive number", attb.Precision, s.Name)
+				}
+				if !attb.fits32Bits(QuaternionRange) {
+					return false, fmt.Errorf("Quaternion %v with precision %v needs more than 32 bits per component",
+						s.Name, attb.Precision)
+				}
+
+				attb.Bits = bits.Len64(attb.Steps(QuaternionRange))
+				return true, nil
+			}
+		}
+	}
+
+	return false, fmt.Errorf("Precision attribute can only be applied to float variables and quaternion structs")
+}
+
+// quantize checks that range of variable can be quantized with precision and records number of bits
+func (attb *PrecisionAttribute) quantize(variable *ast.Variable, r *ast.Range) (bool, error) {
+	if math.IsInf(r.LowerBound, 0) || math.IsInf(r.UpperBound, 0) {
+		return false, fmt.Errorf("Range %v of quantized variable %v must be finite", ast.RangeToString(r), variable.Name)
+	}
+
+	if !(r.LowerBound < r.UpperBound) {
+		return false, fmt.Errorf("Range %v of quantized variable %v is empty", ast.RangeToString(r), variable.Name)
+	}
+
+	if !attb.fits32Bits(r) {
+		return false, fmt.Errorf("Variable %v quantized to range %v with precision %v needs more than 32 bits",
+			variable.Name, ast.RangeToString(r), attb.Precision)
+	}
+
+	attb.Bits = bits.Len64(attb.Steps(r))
+	return true, nil
+}
+
+// fits32Bits checks that number of steps of range, which is written on the wire, takes at most 32 bits
+func (attb *PrecisionAttribute) fits32Bits(r *ast.Range) bool {
+	// ratio too large for uint64 doesn't fit either
+	return (r.UpperBound-r.LowerBound)/attb.Precision < 1<<33 && bits.Len64(attb.Steps(r)) <= 32
+}
+
+// Steps returns the smallest number of equal steps between bounds of range which are not larger than precision
+func (attb *PrecisionAttribute) Steps(r *ast.Range) uint64 {
+	n := (r.UpperBound - r.LowerBound) / attb.Precision
+	// range [-0.2, 2.2] with precision 0.1 shouldn't take 25 steps just because of rounding error
+	return uint64(math.Ceil(n - n*1e-12))
 }
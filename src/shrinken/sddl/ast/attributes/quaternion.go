@@ -0,0 +1,87 @@
+package attributes
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"shrinken/sddl/ast"
+	"strings"
+)
+
+// QuaternionRange holds bounds of three smallest components of unit quaternion
+var QuaternionRange = &ast.Range{
+	LowerBound:     -math.Sqrt2 / 2,
+	UpperBound:     math.Sqrt2 / 2,
+	LowerInclusive: true,
+	UpperInclusive: true,
+}
+
+// QuaternionAttribute makes struct of four floats written as rotation quaternion with smallest-three
+// encoding, which drops its largest component and quantizes the other three with PrecisionAttribute
+// of the struct
+type QuaternionAttribute struct {
+	ast.Attribute
+}
+
+func NewQuaternionAttribute() *QuaternionAttribute {
+	return &QuaternionAttribute{}
+}
+
+func (attb *QuaternionAttribute) Accept(visitor ast.Visitor) {
+	visitor.VisitAttribute(attb)
+}
+
+func (attb *QuaternionAttribute) String() string {
+	return "Quaternion"
+}
+
+func (attb *QuaternionAttribute) IsApplicable(t reflect.Type, node ast.ASTNode) (bool, error) {
+	if t != reflect.TypeOf(&ast.StructDef{}) || node.(*ast.StructDef).IsClass {
+		return false, fmt.Errorf("Quaternion attribute can only be applied to structs")
+	}
+
+	s := node.(*ast.StructDef)
+	if err := checkComponents(s, "Quaternion", 4); err != nil {
+		return false, err
+	}
+
+	precision := false
+	for _, a := range s.AttributesList {
+		switch a.(type) {
+		case *PrecisionAttribute:
+			precision = true
+		case *DeltaAttribute:
+			return false, fmt.Errorf("Quaternion struct %v can't be delta message", s.Name)
+		}
+	}
+	if !precision {
+		return false, fmt.Errorf("Quaternion struct %v needs precision attribute", s.Name)
+	}
+
+	return true, nil
+}
+
+// checkComponents checks that struct is made of count float variables, without base struct and without
+// attributes of their own which would change how they are written
+func checkComponents(s *ast.StructDef, kind string, count int) error {
+	if s.Overrides != "" {
+		return fmt.Errorf("%v struct %v can't extend other struct", kind, s.Name)
+	}
+
+	if len(s.Body.Variables) != count {
+		return fmt.Errorf("%v struct %v must have exactly %v float fields", kind, s.Name, count)
+	}
+	for _, variable := range s.Body.Variables {
+		if !variable.Type.IsGeneric || variable.Type.GenericType != ast.Float {
+			return fmt.Errorf("%v struct %v must have exactly %v float fields", kind, s.Name, count)
+		}
+		for _, a := range variable.AttributesList {
+			switch a.(type) {
+			case *RangeAttribute, *PrecisionAttribute:
+				return fmt.Errorf("Components of %v struct %v can't have range or precision", strings.ToLower(kind), s.Name)
+			}
+		}
+	}
+
+	return nil
+}
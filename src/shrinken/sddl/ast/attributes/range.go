@@ -2,6 +2,8 @@ package attributes
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 	"reflect"
 	"shrinken/sddl/ast"
 )
@@ -32,13 +34,26 @@ func (attb *RangeAttribute) IsApplicable(t reflect.Type, node ast.ASTNode) (bool
 			genericType := node.(*ast.Variable).Type.GenericType
 			if genericType == ast.Float {
 				return true, nil
-			} else if genericType == ast.Integer32 || genericType == ast.Integer64 {
-				if float64(int64(attb.Range.LowerBound)) != attb.Range.LowerBound ||
-					float64(int64(attb.Range.UpperBound)) != attb.Range.UpperBound {
+			} else if limits, ok := integerLimits[genericType]; ok {
+				if math.Trunc(attb.Range.LowerBound) != attb.Range.LowerBound ||
+					math.Trunc(attb.Range.UpperBound) != attb.Range.UpperBound {
 
 					return false, fmt.Errorf("Range attribute applied to integer types must be limited by integers")
 				}
 
+				if math.IsInf(attb.Range.LowerBound, 0) || math.IsInf(attb.Range.UpperBound, 0) {
+					return false, fmt.Errorf("Range %v doesn't fit %v bit %v", ast.RangeToString(attb.Range), limits.bits, genericType.String())
+				}
+
+				min, max := attb.IntegerBounds()
+				if min.Cmp(max) > 0 {
+					return false, fmt.Errorf("Range %v doesn't contain any integer", ast.RangeToString(attb.Range))
+				}
+
+				if min.Cmp(limits.min) < 0 || max.Cmp(limits.max) > 0 {
+					return false, fmt.Errorf("Range %v doesn't fit %v bit %v", ast.RangeToString(attb.Range), limits.bits, genericType.String())
+				}
+
 				return true, nil
 			}
 		}
@@ -46,3 +61,50 @@ func (attb *RangeAttribute) IsApplicable(t reflect.Type, node ast.ASTNode) (bool
 
 	return false, fmt.Errorf("Range attribute cannot be applied to non-numeric types")
 }
+
+// IntegerBounds returns the smallest and the largest integer inside of range limited by integers
+func (attb *RangeAttribute) IntegerBounds() (*big.Int, *big.Int) {
+	min, _ := big.NewFloat(attb.Range.LowerBound).Int(nil)
+	max, _ := big.NewFloat(attb.Range.UpperBound).Int(nil)
+	if !attb.Range.LowerInclusive {
+		min.Add(min, big.NewInt(1))
+	}
+	if !attb.Range.UpperInclusive {
+		max.Sub(max, big.NewInt(1))
+	}
+	return min, max
+}
+
+type integerLimit struct {
+	min, max *big.Int
+	bits     int
+}
+
+func newIntegerLimit(bits uint, signed bool) integerLimit {
+	one := big.NewInt(1)
+	if signed {
+		max := new(big.Int).Lsh(one, bits-1)
+		return integerLimit{
+			min:  new(big.Int).Neg(max),
+			max:  max.Sub(max, one),
+			bits: int(bits),
+		}
+	}
+
+	max := new(big.Int).Lsh(one, bits)
+	return integerLimit{
+		min:  big.NewInt(0),
+		max:  max.Sub(max, one),
+		bits: int(bits),
+	}
+}
+
+var integerLimits = map[ast.GenericType]integerLimit{
+	ast.Byte:              newIntegerLimit(8, false),
+	ast.Short:             newIntegerLimit(16, true),
+	ast.UnsignedShort:     newIntegerLimit(16, false),
+	ast.Integer32:         newIntegerLimit(32, true),
+	ast.UnsignedInteger32: newIntegerLimit(32, false),
+	ast.Integer64:         newIntegerLimit(64, true),
+	ast.UnsignedInteger64: newIntegerLimit(64, false),
+}
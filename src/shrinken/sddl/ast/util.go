@@ -9,6 +9,10 @@ func toStr(str interface{}) string {
 	return string(str.(*token.Token).Lit)
 }
 
+func ToStr(str interface{}) string {
+	return toStr(str)
+}
+
 func getTokenPos(tok interface{}) token.Pos {
 	return tok.(*token.Token).Pos
 }
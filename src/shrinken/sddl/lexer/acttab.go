@@ -33,19 +33,19 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S3
-		Accept: 53,
+		Accept: 63,
 		Ignore: "",
 	},
 	ActionRow{ // S4
-		Accept: 52,
+		Accept: 62,
 		Ignore: "",
 	},
 	ActionRow{ // S5
-		Accept: 48,
+		Accept: 58,
 		Ignore: "",
 	},
 	ActionRow{ // S6
-		Accept: 47,
+		Accept: 57,
 		Ignore: "",
 	},
 	ActionRow{ // S7
@@ -53,11 +53,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S8
-		Accept: 45,
+		Accept: 55,
 		Ignore: "",
 	},
 	ActionRow{ // S9
-		Accept: 49,
+		Accept: 59,
 		Ignore: "",
 	},
 	ActionRow{ // S10
@@ -73,15 +73,15 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S13
-		Accept: 41,
+		Accept: 51,
 		Ignore: "",
 	},
 	ActionRow{ // S14
-		Accept: 40,
+		Accept: 50,
 		Ignore: "",
 	},
 	ActionRow{ // S15
-		Accept: 35,
+		Accept: 45,
 		Ignore: "",
 	},
 	ActionRow{ // S16
@@ -97,7 +97,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S19
-		Accept: 50,
+		Accept: 60,
 		Ignore: "",
 	},
 	ActionRow{ // S20
@@ -113,11 +113,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S23
-		Accept: 44,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S24
-		Accept: 4,
+		Accept: 54,
 		Ignore: "",
 	},
 	ActionRow{ // S25
@@ -149,27 +149,27 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S32
-		Accept: 9,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S33
-		Accept: 10,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S34
-		Accept: 7,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S35
-		Accept: 0,
+		Accept: 9,
 		Ignore: "",
 	},
 	ActionRow{ // S36
-		Accept: 32,
+		Accept: 10,
 		Ignore: "",
 	},
 	ActionRow{ // S37
-		Accept: 0,
+		Accept: 7,
 		Ignore: "",
 	},
 	ActionRow{ // S38
@@ -177,7 +177,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S39
-		Accept: 0,
+		Accept: 32,
 		Ignore: "",
 	},
 	ActionRow{ // S40
@@ -189,15 +189,15 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S42
-		Accept: 4,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S43
-		Accept: 4,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S44
-		Accept: 30,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S45
@@ -209,7 +209,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S47
-		Accept: 4,
+		Accept: 30,
 		Ignore: "",
 	},
 	ActionRow{ // S48
@@ -249,11 +249,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S57
-		Accept: 43,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S58
-		Accept: 4,
+		Accept: 44,
 		Ignore: "",
 	},
 	ActionRow{ // S59
@@ -281,31 +281,31 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S65
-		Accept: 4,
+		Accept: 53,
 		Ignore: "",
 	},
 	ActionRow{ // S66
-		Accept: 0,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S67
-		Accept: 0,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S68
-		Accept: -1,
-		Ignore: "!comment",
+		Accept: 4,
+		Ignore: "",
 	},
 	ActionRow{ // S69
-		Accept: 42,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S70
-		Accept: 3,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S71
-		Accept: 3,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S72
@@ -321,31 +321,31 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S75
-		Accept: 4,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S76
-		Accept: 4,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S77
-		Accept: 4,
-		Ignore: "",
+		Accept: -1,
+		Ignore: "!comment",
 	},
 	ActionRow{ // S78
-		Accept: 4,
+		Accept: 52,
 		Ignore: "",
 	},
 	ActionRow{ // S79
-		Accept: 4,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S80
-		Accept: 46,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S81
-		Accept: 14,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S82
@@ -389,31 +389,31 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S92
-		Accept: 6,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S93
-		Accept: 4,
+		Accept: 56,
 		Ignore: "",
 	},
 	ActionRow{ // S94
-		Accept: 42,
+		Accept: 14,
 		Ignore: "",
 	},
 	ActionRow{ // S95
-		Accept: -1,
-		Ignore: "!comment",
+		Accept: 4,
+		Ignore: "",
 	},
 	ActionRow{ // S96
-		Accept: 25,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S97
-		Accept: 24,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S98
-		Accept: 27,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S99
@@ -425,7 +425,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S101
-		Accept: 13,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S102
@@ -445,7 +445,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S106
-		Accept: 17,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S107
@@ -453,7 +453,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S108
-		Accept: 4,
+		Accept: 6,
 		Ignore: "",
 	},
 	ActionRow{ // S109
@@ -461,27 +461,27 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S110
-		Accept: 4,
+		Accept: 52,
 		Ignore: "",
 	},
 	ActionRow{ // S111
-		Accept: 4,
-		Ignore: "",
+		Accept: -1,
+		Ignore: "!comment",
 	},
 	ActionRow{ // S112
 		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S113
-		Accept: 4,
+		Accept: 25,
 		Ignore: "",
 	},
 	ActionRow{ // S114
-		Accept: 4,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S115
-		Accept: 19,
+		Accept: 27,
 		Ignore: "",
 	},
 	ActionRow{ // S116
@@ -493,7 +493,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S118
-		Accept: 8,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S119
@@ -505,15 +505,15 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S121
-		Accept: 28,
+		Accept: 13,
 		Ignore: "",
 	},
 	ActionRow{ // S122
-		Accept: 15,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S123
-		Accept: 16,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S124
@@ -525,19 +525,19 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S126
-		Accept: 4,
+		Accept: 17,
 		Ignore: "",
 	},
 	ActionRow{ // S127
-		Accept: 36,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S128
-		Accept: 18,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S129
-		Accept: 51,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S130
@@ -557,7 +557,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S134
-		Accept: 22,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S135
@@ -565,7 +565,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S136
-		Accept: 29,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S137
@@ -577,7 +577,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S139
-		Accept: 4,
+		Accept: 19,
 		Ignore: "",
 	},
 	ActionRow{ // S140
@@ -585,23 +585,23 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S141
-		Accept: 26,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S142
-		Accept: 12,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S143
-		Accept: 20,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S144
-		Accept: 21,
+		Accept: 8,
 		Ignore: "",
 	},
 	ActionRow{ // S145
-		Accept: 23,
+		Accept: 39,
 		Ignore: "",
 	},
 	ActionRow{ // S146
@@ -609,11 +609,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S147
-		Accept: 39,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S148
-		Accept: 2,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S149
@@ -621,15 +621,259 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S150
-		Accept: 37,
+		Accept: 28,
 		Ignore: "",
 	},
 	ActionRow{ // S151
-		Accept: 4,
+		Accept: 15,
 		Ignore: "",
 	},
 	ActionRow{ // S152
+		Accept: 16,
+		Ignore: "",
+	},
+	ActionRow{ // S153
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S154
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S155
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S156
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S157
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S158
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S159
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S160
+		Accept: 46,
+		Ignore: "",
+	},
+	ActionRow{ // S161
+		Accept: 18,
+		Ignore: "",
+	},
+	ActionRow{ // S162
+		Accept: 61,
+		Ignore: "",
+	},
+	ActionRow{ // S163
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S164
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S165
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S166
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S167
+		Accept: 22,
+		Ignore: "",
+	},
+	ActionRow{ // S168
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S169
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S170
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S171
+		Accept: 29,
+		Ignore: "",
+	},
+	ActionRow{ // S172
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S173
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S174
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S175
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S176
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S177
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S178
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S179
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S180
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S181
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S182
+		Accept: 26,
+		Ignore: "",
+	},
+	ActionRow{ // S183
+		Accept: 12,
+		Ignore: "",
+	},
+	ActionRow{ // S184
+		Accept: 20,
+		Ignore: "",
+	},
+	ActionRow{ // S185
+		Accept: 21,
+		Ignore: "",
+	},
+	ActionRow{ // S186
+		Accept: 23,
+		Ignore: "",
+	},
+	ActionRow{ // S187
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S188
+		Accept: 37,
+		Ignore: "",
+	},
+	ActionRow{ // S189
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S190
+		Accept: 43,
+		Ignore: "",
+	},
+	ActionRow{ // S191
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S192
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S193
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S194
+		Accept: 49,
+		Ignore: "",
+	},
+	ActionRow{ // S195
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S196
+		Accept: 2,
+		Ignore: "",
+	},
+	ActionRow{ // S197
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S198
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S199
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S200
+		Accept: 35,
+		Ignore: "",
+	},
+	ActionRow{ // S201
+		Accept: 47,
+		Ignore: "",
+	},
+	ActionRow{ // S202
 		Accept: 38,
 		Ignore: "",
 	},
+	ActionRow{ // S203
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S204
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S205
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S206
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S207
+		Accept: 42,
+		Ignore: "",
+	},
+	ActionRow{ // S208
+		Accept: 36,
+		Ignore: "",
+	},
+	ActionRow{ // S209
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S210
+		Accept: 48,
+		Ignore: "",
+	},
+	ActionRow{ // S211
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S212
+		Accept: 41,
+		Ignore: "",
+	},
+	ActionRow{ // S213
+		Accept: 40,
+		Ignore: "",
+	},
 }
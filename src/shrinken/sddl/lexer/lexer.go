@@ -11,8 +11,8 @@ import (
 
 const (
 	NoState    = -1
-	NumStates  = 153
-	NumSymbols = 186
+	NumStates  = 214
+	NumSymbols = 261
 )
 
 type Lexer struct {
@@ -230,73 +230,148 @@ Lexer symbols:
 114: '['
 115: ']'
 116: ','
-117: '@'
-118: 'r'
-119: 'a'
-120: 'n'
-121: 'g'
-122: 'e'
-123: 'e'
-124: 'x'
-125: 'p'
-126: 'o'
-127: 'r'
-128: 't'
-129: 'A'
-130: 's'
-131: 'p'
-132: 'r'
-133: 'e'
+117: 'e'
+118: 'n'
+119: 'c'
+120: 'o'
+121: 'd'
+122: 'i'
+123: 'n'
+124: 'g'
+125: 'm'
+126: 'a'
+127: 'x'
+128: 'L'
+129: 'e'
+130: 'n'
+131: 'g'
+132: 't'
+133: 'h'
 134: 'c'
-135: 'i'
-136: 's'
-137: 'i'
-138: 'o'
-139: 'n'
-140: 'm'
-141: 'e'
-142: 's'
-143: 's'
-144: 'a'
-145: 'g'
-146: 'e'
-147: '>'
-148: '<'
-149: 'p'
-150: 'i'
-151: 'e'
-152: '-'
-153: 'i'
-154: 'n'
-155: 'f'
-156: '+'
-157: '*'
-158: '/'
-159: '^'
-160: 's'
-161: 'q'
-162: 'r'
-163: 't'
-164: '('
-165: ')'
-166: '('
-167: '/'
-168: '/'
-169: '\n'
-170: '/'
-171: '*'
-172: '*'
-173: '*'
-174: '/'
-175: '.'
-176: '_'
-177: ' '
-178: '\t'
-179: '\n'
-180: '\r'
-181: '0'-'9'
-182: '1'-'9'
-183: 'a'-'z'
-184: 'A'-'Z'
-185: .
+135: 'h'
+136: 'a'
+137: 'r'
+138: 's'
+139: 'e'
+140: 't'
+141: 'm'
+142: 'a'
+143: 'x'
+144: 'C'
+145: 'o'
+146: 'u'
+147: 'n'
+148: 't'
+149: 'd'
+150: 'e'
+151: 'l'
+152: 't'
+153: 'a'
+154: 'q'
+155: 'u'
+156: 'a'
+157: 't'
+158: 'e'
+159: 'r'
+160: 'n'
+161: 'i'
+162: 'o'
+163: 'n'
+164: 'n'
+165: 'o'
+166: 'r'
+167: 'm'
+168: 'a'
+169: 'l'
+170: 'i'
+171: 'z'
+172: 'e'
+173: 'd'
+174: 'a'
+175: 'l'
+176: 'i'
+177: 'g'
+178: 'n'
+179: 'm'
+180: 'e'
+181: 'n'
+182: 't'
+183: 'e'
+184: 'n'
+185: 't'
+186: 'r'
+187: 'o'
+188: 'p'
+189: 'y'
+190: 'i'
+191: 'd'
+192: '@'
+193: 'r'
+194: 'a'
+195: 'n'
+196: 'g'
+197: 'e'
+198: 'e'
+199: 'x'
+200: 'p'
+201: 'o'
+202: 'r'
+203: 't'
+204: 'A'
+205: 's'
+206: 'p'
+207: 'r'
+208: 'e'
+209: 'c'
+210: 'i'
+211: 's'
+212: 'i'
+213: 'o'
+214: 'n'
+215: 'm'
+216: 'e'
+217: 's'
+218: 's'
+219: 'a'
+220: 'g'
+221: 'e'
+222: '>'
+223: '<'
+224: 'p'
+225: 'i'
+226: 'e'
+227: '-'
+228: 'i'
+229: 'n'
+230: 'f'
+231: '+'
+232: '*'
+233: '/'
+234: '^'
+235: 's'
+236: 'q'
+237: 'r'
+238: 't'
+239: '('
+240: ')'
+241: '('
+242: '/'
+243: '/'
+244: '\n'
+245: '/'
+246: '*'
+247: '*'
+248: '*'
+249: '/'
+250: '.'
+251: '_'
+252: ' '
+253: '\t'
+254: '\n'
+255: '\r'
+256: '0'-'9'
+257: '1'-'9'
+258: 'a'-'z'
+259: 'A'-'Z'
+260: .
 */
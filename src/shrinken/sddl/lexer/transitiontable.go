@@ -60,47 +60,49 @@ var TransTab = TransitionTable{
 		case r == 95: // ['_','_']
 			return 16
 		case r == 97: // ['a','a']
-			return 16
-		case r == 98: // ['b','b']
 			return 20
-		case r == 99: // ['c','c']
+		case r == 98: // ['b','b']
 			return 21
-		case r == 100: // ['d','d']
+		case r == 99: // ['c','c']
 			return 22
-		case r == 101: // ['e','e']
+		case r == 100: // ['d','d']
 			return 23
-		case r == 102: // ['f','f']
+		case r == 101: // ['e','e']
 			return 24
+		case r == 102: // ['f','f']
+			return 25
 		case 103 <= r && r <= 104: // ['g','h']
 			return 16
 		case r == 105: // ['i','i']
-			return 25
+			return 26
 		case 106 <= r && r <= 107: // ['j','k']
 			return 16
 		case r == 108: // ['l','l']
-			return 26
-		case r == 109: // ['m','m']
 			return 27
-		case 110 <= r && r <= 111: // ['n','o']
+		case r == 109: // ['m','m']
+			return 28
+		case r == 110: // ['n','n']
+			return 29
+		case r == 111: // ['o','o']
 			return 16
 		case r == 112: // ['p','p']
-			return 28
+			return 30
 		case r == 113: // ['q','q']
-			return 16
+			return 31
 		case r == 114: // ['r','r']
-			return 29
+			return 32
 		case r == 115: // ['s','s']
-			return 30
+			return 33
 		case r == 116: // ['t','t']
 			return 16
 		case r == 117: // ['u','u']
-			return 31
+			return 34
 		case 118 <= r && r <= 122: // ['v','z']
 			return 16
 		case r == 123: // ['{','{']
-			return 32
+			return 35
 		case r == 125: // ['}','}']
-			return 33
+			return 36
 		}
 		return NoState
 	},
@@ -114,7 +116,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 34: // ['"','"']
-			return 34
+			return 37
 		default:
 			return 2
 		}
@@ -153,9 +155,9 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 48: // ['0','0']
-			return 35
+			return 38
 		case 49 <= r && r <= 57: // ['1','9']
-			return 36
+			return 39
 		}
 		return NoState
 	},
@@ -163,9 +165,9 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 42: // ['*','*']
-			return 37
+			return 40
 		case r == 47: // ['/','/']
-			return 38
+			return 41
 		}
 		return NoState
 	},
@@ -173,9 +175,9 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 39
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		}
 		return NoState
 	},
@@ -183,7 +185,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 39
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
 			return 11
 		}
@@ -217,15 +219,15 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
@@ -233,7 +235,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 93: // [']',']']
-			return 44
+			return 47
 		}
 		return NoState
 	},
@@ -253,2370 +255,3566 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
+		case 97 <= r && r <= 107: // ['a','k']
+			return 46
+		case r == 108: // ['l','l']
+			return 48
+		case 109 <= r && r <= 122: // ['m','z']
+			return 46
+		}
+		return NoState
+	},
+	// S21
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
 		case 97 <= r && r <= 110: // ['a','n']
-			return 43
+			return 46
 		case r == 111: // ['o','o']
-			return 45
+			return 49
 		case 112 <= r && r <= 120: // ['p','x']
-			return 43
-		case r == 121: // ['y','y']
 			return 46
+		case r == 121: // ['y','y']
+			return 50
 		case r == 122: // ['z','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S21
+	// S22
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 103: // ['a','g']
-			return 43
+			return 46
 		case r == 104: // ['h','h']
-			return 47
+			return 51
 		case 105 <= r && r <= 107: // ['i','k']
-			return 43
+			return 46
 		case r == 108: // ['l','l']
-			return 48
+			return 52
 		case 109 <= r && r <= 122: // ['m','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S22
+	// S23
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 110: // ['a','n']
-			return 43
+			return 46
+		case 97 <= r && r <= 100: // ['a','d']
+			return 46
+		case r == 101: // ['e','e']
+			return 53
+		case 102 <= r && r <= 110: // ['f','n']
+			return 46
 		case r == 111: // ['o','o']
-			return 49
+			return 54
 		case 112 <= r && r <= 122: // ['p','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S23
+	// S24
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 109: // ['a','m']
-			return 43
+			return 46
 		case r == 110: // ['n','n']
-			return 50
+			return 55
 		case 111 <= r && r <= 119: // ['o','w']
-			return 43
+			return 46
 		case r == 120: // ['x','x']
-			return 51
+			return 56
 		case 121 <= r && r <= 122: // ['y','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S24
+	// S25
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 107: // ['a','k']
-			return 43
+			return 46
 		case r == 108: // ['l','l']
-			return 52
+			return 57
 		case 109 <= r && r <= 122: // ['m','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S25
+	// S26
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 109: // ['a','m']
-			return 43
+			return 46
+		case 97 <= r && r <= 99: // ['a','c']
+			return 46
+		case r == 100: // ['d','d']
+			return 58
+		case 101 <= r && r <= 109: // ['e','m']
+			return 46
 		case r == 110: // ['n','n']
-			return 53
+			return 59
 		case 111 <= r && r <= 122: // ['o','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S26
+	// S27
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 110: // ['a','n']
-			return 43
+			return 46
 		case r == 111: // ['o','o']
-			return 54
+			return 60
 		case 112 <= r && r <= 122: // ['p','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S27
+	// S28
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 100: // ['a','d']
-			return 43
+			return 46
+		case r == 97: // ['a','a']
+			return 61
+		case 98 <= r && r <= 100: // ['b','d']
+			return 46
 		case r == 101: // ['e','e']
-			return 55
+			return 62
 		case 102 <= r && r <= 122: // ['f','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S28
+	// S29
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case r == 97: // ['a','a']
-			return 56
-		case 98 <= r && r <= 104: // ['b','h']
-			return 43
-		case r == 105: // ['i','i']
-			return 57
-		case 106 <= r && r <= 113: // ['j','q']
-			return 43
-		case r == 114: // ['r','r']
-			return 58
-		case 115 <= r && r <= 122: // ['s','z']
-			return 43
-		}
-		return NoState
+			return 46
+		case 97 <= r && r <= 110: // ['a','n']
+			return 46
+		case r == 111: // ['o','o']
+			return 63
+		case 112 <= r && r <= 122: // ['p','z']
+			return 46
+		}
+		return NoState
 	},
-	// S29
+	// S30
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case r == 97: // ['a','a']
-			return 59
+			return 64
+		case 98 <= r && r <= 104: // ['b','h']
+			return 46
+		case r == 105: // ['i','i']
+			return 65
+		case 106 <= r && r <= 113: // ['j','q']
+			return 46
+		case r == 114: // ['r','r']
+			return 66
+		case 115 <= r && r <= 122: // ['s','z']
+			return 46
+		}
+		return NoState
+	},
+	// S31
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 116: // ['a','t']
+			return 46
+		case r == 117: // ['u','u']
+			return 67
+		case 118 <= r && r <= 122: // ['v','z']
+			return 46
+		}
+		return NoState
+	},
+	// S32
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case r == 97: // ['a','a']
+			return 68
 		case 98 <= r && r <= 122: // ['b','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S30
+	// S33
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 103: // ['a','g']
-			return 43
+			return 46
 		case r == 104: // ['h','h']
-			return 60
+			return 69
 		case 105 <= r && r <= 112: // ['i','p']
-			return 43
+			return 46
 		case r == 113: // ['q','q']
-			return 61
+			return 70
 		case 114 <= r && r <= 115: // ['r','s']
-			return 43
+			return 46
 		case r == 116: // ['t','t']
-			return 62
+			return 71
 		case 117 <= r && r <= 122: // ['u','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S31
+	// S34
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 104: // ['a','h']
-			return 43
+			return 46
 		case r == 105: // ['i','i']
-			return 63
+			return 72
 		case 106 <= r && r <= 107: // ['j','k']
-			return 43
+			return 46
 		case r == 108: // ['l','l']
-			return 64
+			return 73
 		case 109 <= r && r <= 114: // ['m','r']
-			return 43
+			return 46
 		case r == 115: // ['s','s']
-			return 65
+			return 74
 		case 116 <= r && r <= 122: // ['t','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S32
+	// S35
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S33
+	// S36
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S34
+	// S37
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S35
+	// S38
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 66
+			return 75
 		case 48 <= r && r <= 57: // ['0','9']
-			return 35
+			return 38
 		}
 		return NoState
 	},
-	// S36
+	// S39
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 66
+			return 75
 		case 48 <= r && r <= 57: // ['0','9']
-			return 36
+			return 39
 		}
 		return NoState
 	},
-	// S37
+	// S40
 	func(r rune) int {
 		switch {
 		case r == 42: // ['*','*']
-			return 67
+			return 76
 		default:
-			return 37
+			return 40
 		}
 	},
-	// S38
+	// S41
 	func(r rune) int {
 		switch {
 		case r == 10: // ['\n','\n']
-			return 68
+			return 77
 		default:
-			return 38
+			return 41
 		}
 	},
-	// S39
+	// S42
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 69
+			return 78
 		}
 		return NoState
 	},
-	// S40
+	// S43
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 39
+			return 42
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		}
 		return NoState
 	},
-	// S41
+	// S44
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 70
+			return 79
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 71
+			return 80
 		case r == 95: // ['_','_']
-			return 71
+			return 80
 		case 97 <= r && r <= 122: // ['a','z']
-			return 71
+			return 80
 		}
 		return NoState
 	},
-	// S42
+	// S45
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S43
+	// S46
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S44
+	// S47
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S45
+	// S48
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
+		case 97 <= r && r <= 104: // ['a','h']
+			return 46
+		case r == 105: // ['i','i']
+			return 81
+		case 106 <= r && r <= 122: // ['j','z']
+			return 46
+		}
+		return NoState
+	},
+	// S49
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
 		case 97 <= r && r <= 110: // ['a','n']
-			return 43
+			return 46
 		case r == 111: // ['o','o']
-			return 72
+			return 82
 		case 112 <= r && r <= 122: // ['p','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S46
+	// S50
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 115: // ['a','s']
-			return 43
+			return 46
 		case r == 116: // ['t','t']
-			return 73
+			return 83
 		case 117 <= r && r <= 122: // ['u','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S47
+	// S51
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case r == 97: // ['a','a']
-			return 74
+			return 84
 		case 98 <= r && r <= 122: // ['b','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S48
+	// S52
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case r == 97: // ['a','a']
-			return 75
+			return 85
 		case 98 <= r && r <= 122: // ['b','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S49
+	// S53
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
+		case 97 <= r && r <= 107: // ['a','k']
+			return 46
+		case r == 108: // ['l','l']
+			return 86
+		case 109 <= r && r <= 122: // ['m','z']
+			return 46
+		}
+		return NoState
+	},
+	// S54
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
 		case 97 <= r && r <= 116: // ['a','t']
-			return 43
+			return 46
 		case r == 117: // ['u','u']
-			return 76
+			return 87
 		case 118 <= r && r <= 122: // ['v','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S50
+	// S55
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 116: // ['a','t']
-			return 43
+			return 46
+		case 97 <= r && r <= 98: // ['a','b']
+			return 46
+		case r == 99: // ['c','c']
+			return 88
+		case 100 <= r && r <= 115: // ['d','s']
+			return 46
+		case r == 116: // ['t','t']
+			return 89
 		case r == 117: // ['u','u']
-			return 77
+			return 90
 		case 118 <= r && r <= 122: // ['v','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S51
+	// S56
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 111: // ['a','o']
-			return 43
+			return 46
 		case r == 112: // ['p','p']
-			return 78
+			return 91
 		case 113 <= r && r <= 122: // ['q','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S52
+	// S57
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 110: // ['a','n']
-			return 43
+			return 46
 		case r == 111: // ['o','o']
-			return 79
+			return 92
 		case 112 <= r && r <= 122: // ['p','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S53
+	// S58
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
+		}
+		return NoState
+	},
+	// S59
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
 		case 97 <= r && r <= 101: // ['a','e']
-			return 43
+			return 46
 		case r == 102: // ['f','f']
-			return 80
+			return 93
 		case 103 <= r && r <= 115: // ['g','s']
-			return 43
+			return 46
 		case r == 116: // ['t','t']
-			return 81
+			return 94
 		case 117 <= r && r <= 122: // ['u','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S54
+	// S60
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 109: // ['a','m']
-			return 43
+			return 46
 		case r == 110: // ['n','n']
-			return 82
+			return 95
 		case 111 <= r && r <= 122: // ['o','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S55
+	// S61
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 114: // ['a','r']
-			return 43
-		case r == 115: // ['s','s']
-			return 83
-		case 116 <= r && r <= 122: // ['t','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 119: // ['a','w']
+			return 46
+		case r == 120: // ['x','x']
+			return 96
+		case 121 <= r && r <= 122: // ['y','z']
+			return 46
 		}
 		return NoState
 	},
-	// S56
+	// S62
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 98: // ['a','b']
-			return 43
-		case r == 99: // ['c','c']
-			return 84
-		case 100 <= r && r <= 122: // ['d','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 114: // ['a','r']
+			return 46
+		case r == 115: // ['s','s']
+			return 97
+		case 116 <= r && r <= 122: // ['t','z']
+			return 46
 		}
 		return NoState
 	},
-	// S57
+	// S63
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 113: // ['a','q']
+			return 46
+		case r == 114: // ['r','r']
+			return 98
+		case 115 <= r && r <= 122: // ['s','z']
+			return 46
 		}
 		return NoState
 	},
-	// S58
+	// S64
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 100: // ['a','d']
-			return 43
-		case r == 101: // ['e','e']
-			return 85
-		case 102 <= r && r <= 122: // ['f','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 98: // ['a','b']
+			return 46
+		case r == 99: // ['c','c']
+			return 99
+		case 100 <= r && r <= 122: // ['d','z']
+			return 46
 		}
 		return NoState
 	},
-	// S59
+	// S65
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 109: // ['a','m']
-			return 43
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
+		}
+		return NoState
+	},
+	// S66
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 100: // ['a','d']
+			return 46
+		case r == 101: // ['e','e']
+			return 100
+		case 102 <= r && r <= 122: // ['f','z']
+			return 46
+		}
+		return NoState
+	},
+	// S67
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case r == 97: // ['a','a']
+			return 101
+		case 98 <= r && r <= 122: // ['b','z']
+			return 46
+		}
+		return NoState
+	},
+	// S68
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 109: // ['a','m']
+			return 46
 		case r == 110: // ['n','n']
-			return 86
+			return 102
 		case 111 <= r && r <= 122: // ['o','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S60
+	// S69
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 110: // ['a','n']
-			return 43
+			return 46
 		case r == 111: // ['o','o']
-			return 87
+			return 103
 		case 112 <= r && r <= 122: // ['p','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S61
+	// S70
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 113: // ['a','q']
-			return 43
+			return 46
 		case r == 114: // ['r','r']
-			return 88
+			return 104
 		case 115 <= r && r <= 122: // ['s','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S62
+	// S71
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 113: // ['a','q']
-			return 43
+			return 46
 		case r == 114: // ['r','r']
-			return 89
+			return 105
 		case 115 <= r && r <= 122: // ['s','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S63
+	// S72
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 109: // ['a','m']
-			return 43
+			return 46
 		case r == 110: // ['n','n']
-			return 90
+			return 106
 		case 111 <= r && r <= 122: // ['o','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S64
+	// S73
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 110: // ['a','n']
-			return 43
+			return 46
 		case r == 111: // ['o','o']
-			return 91
+			return 107
 		case 112 <= r && r <= 122: // ['p','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S65
+	// S74
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 100: // ['a','d']
-			return 43
+			return 46
 		case r == 101: // ['e','e']
-			return 92
+			return 108
 		case 102 <= r && r <= 103: // ['f','g']
-			return 43
+			return 46
 		case r == 104: // ['h','h']
-			return 93
+			return 109
 		case 105 <= r && r <= 122: // ['i','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S66
+	// S75
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 94
+			return 110
 		}
 		return NoState
 	},
-	// S67
+	// S76
 	func(r rune) int {
 		switch {
 		case r == 42: // ['*','*']
-			return 67
+			return 76
 		case r == 47: // ['/','/']
-			return 95
+			return 111
 		default:
-			return 37
+			return 40
 		}
 	},
-	// S68
+	// S77
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S69
+	// S78
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 69
+			return 78
 		}
 		return NoState
 	},
-	// S70
+	// S79
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 70
+			return 79
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 71
+			return 80
 		case r == 95: // ['_','_']
-			return 71
+			return 80
 		case 97 <= r && r <= 122: // ['a','z']
-			return 71
+			return 80
 		}
 		return NoState
 	},
-	// S71
+	// S80
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 70
+			return 79
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 71
+			return 80
 		case r == 95: // ['_','_']
-			return 71
+			return 80
 		case 97 <= r && r <= 122: // ['a','z']
-			return 71
+			return 80
 		}
 		return NoState
 	},
-	// S72
+	// S81
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 107: // ['a','k']
-			return 43
-		case r == 108: // ['l','l']
-			return 96
-		case 109 <= r && r <= 122: // ['m','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 102: // ['a','f']
+			return 46
+		case r == 103: // ['g','g']
+			return 112
+		case 104 <= r && r <= 122: // ['h','z']
+			return 46
 		}
 		return NoState
 	},
-	// S73
+	// S82
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 100: // ['a','d']
-			return 43
-		case r == 101: // ['e','e']
-			return 97
-		case 102 <= r && r <= 122: // ['f','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 107: // ['a','k']
+			return 46
+		case r == 108: // ['l','l']
+			return 113
+		case 109 <= r && r <= 122: // ['m','z']
+			return 46
 		}
 		return NoState
 	},
-	// S74
+	// S83
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
+		case 97 <= r && r <= 100: // ['a','d']
+			return 46
+		case r == 101: // ['e','e']
+			return 114
+		case 102 <= r && r <= 122: // ['f','z']
+			return 46
+		}
+		return NoState
+	},
+	// S84
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
 		case 97 <= r && r <= 113: // ['a','q']
-			return 43
+			return 46
 		case r == 114: // ['r','r']
-			return 98
+			return 115
 		case 115 <= r && r <= 122: // ['s','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S75
+	// S85
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 114: // ['a','r']
-			return 43
+			return 46
 		case r == 115: // ['s','s']
-			return 99
+			return 116
 		case 116 <= r && r <= 122: // ['t','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S76
+	// S86
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
+		case 97 <= r && r <= 115: // ['a','s']
+			return 46
+		case r == 116: // ['t','t']
+			return 117
+		case 117 <= r && r <= 122: // ['u','z']
+			return 46
+		}
+		return NoState
+	},
+	// S87
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
 		case r == 97: // ['a','a']
-			return 43
+			return 46
 		case r == 98: // ['b','b']
-			return 100
+			return 118
 		case 99 <= r && r <= 122: // ['c','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S77
+	// S88
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
+		case 97 <= r && r <= 110: // ['a','n']
+			return 46
+		case r == 111: // ['o','o']
+			return 119
+		case 112 <= r && r <= 122: // ['p','z']
+			return 46
+		}
+		return NoState
+	},
+	// S89
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 113: // ['a','q']
+			return 46
+		case r == 114: // ['r','r']
+			return 120
+		case 115 <= r && r <= 122: // ['s','z']
+			return 46
+		}
+		return NoState
+	},
+	// S90
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
 		case 97 <= r && r <= 108: // ['a','l']
-			return 43
+			return 46
 		case r == 109: // ['m','m']
-			return 101
+			return 121
 		case 110 <= r && r <= 122: // ['n','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S78
+	// S91
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 110: // ['a','n']
-			return 43
+			return 46
 		case r == 111: // ['o','o']
-			return 102
+			return 122
 		case 112 <= r && r <= 122: // ['p','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S79
+	// S92
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case r == 97: // ['a','a']
-			return 103
+			return 123
 		case 98 <= r && r <= 122: // ['b','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S80
+	// S93
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S81
+	// S94
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 50: // ['0','2']
-			return 42
+			return 45
 		case r == 51: // ['3','3']
-			return 104
+			return 124
 		case 52 <= r && r <= 53: // ['4','5']
-			return 42
+			return 45
 		case r == 54: // ['6','6']
-			return 105
+			return 125
 		case 55 <= r && r <= 57: // ['7','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S82
+	// S95
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 102: // ['a','f']
-			return 43
+			return 46
 		case r == 103: // ['g','g']
-			return 106
+			return 126
 		case 104 <= r && r <= 122: // ['h','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S83
+	// S96
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
+		case 65 <= r && r <= 66: // ['A','B']
+			return 46
+		case r == 67: // ['C','C']
+			return 127
+		case 68 <= r && r <= 75: // ['D','K']
+			return 46
+		case r == 76: // ['L','L']
+			return 128
+		case 77 <= r && r <= 90: // ['M','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
+		}
+		return NoState
+	},
+	// S97
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 114: // ['a','r']
-			return 43
+			return 46
 		case r == 115: // ['s','s']
-			return 107
+			return 129
 		case 116 <= r && r <= 122: // ['t','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S84
+	// S98
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
+		case 97 <= r && r <= 108: // ['a','l']
+			return 46
+		case r == 109: // ['m','m']
+			return 130
+		case 110 <= r && r <= 122: // ['n','z']
+			return 46
+		}
+		return NoState
+	},
+	// S99
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
 		case 97 <= r && r <= 106: // ['a','j']
-			return 43
+			return 46
 		case r == 107: // ['k','k']
-			return 108
+			return 131
 		case 108 <= r && r <= 122: // ['l','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S85
+	// S100
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 98: // ['a','b']
-			return 43
+			return 46
 		case r == 99: // ['c','c']
-			return 109
+			return 132
 		case 100 <= r && r <= 122: // ['d','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S86
+	// S101
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
+		case 97 <= r && r <= 115: // ['a','s']
+			return 46
+		case r == 116: // ['t','t']
+			return 133
+		case 117 <= r && r <= 122: // ['u','z']
+			return 46
+		}
+		return NoState
+	},
+	// S102
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
 		case 97 <= r && r <= 102: // ['a','f']
-			return 43
+			return 46
 		case r == 103: // ['g','g']
-			return 110
+			return 134
 		case 104 <= r && r <= 122: // ['h','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S87
+	// S103
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 113: // ['a','q']
-			return 43
+			return 46
 		case r == 114: // ['r','r']
-			return 111
+			return 135
 		case 115 <= r && r <= 122: // ['s','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S88
+	// S104
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 115: // ['a','s']
-			return 43
+			return 46
 		case r == 116: // ['t','t']
-			return 112
+			return 136
 		case 117 <= r && r <= 122: // ['u','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S89
+	// S105
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 104: // ['a','h']
-			return 43
+			return 46
 		case r == 105: // ['i','i']
-			return 113
+			return 137
 		case 106 <= r && r <= 116: // ['j','t']
-			return 43
+			return 46
 		case r == 117: // ['u','u']
-			return 114
+			return 138
 		case 118 <= r && r <= 122: // ['v','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S90
+	// S106
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 115: // ['a','s']
-			return 43
+			return 46
 		case r == 116: // ['t','t']
-			return 115
+			return 139
 		case 117 <= r && r <= 122: // ['u','z']
-			return 43
+			return 46
+		}
+		return NoState
+	},
+	// S107
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 109: // ['a','m']
+			return 46
+		case r == 110: // ['n','n']
+			return 140
+		case 111 <= r && r <= 122: // ['o','z']
+			return 46
+		}
+		return NoState
+	},
+	// S108
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
+		}
+		return NoState
+	},
+	// S109
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 110: // ['a','n']
+			return 46
+		case r == 111: // ['o','o']
+			return 141
+		case 112 <= r && r <= 122: // ['p','z']
+			return 46
+		}
+		return NoState
+	},
+	// S110
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 110
+		}
+		return NoState
+	},
+	// S111
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S112
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 109: // ['a','m']
+			return 46
+		case r == 110: // ['n','n']
+			return 142
+		case 111 <= r && r <= 122: // ['o','z']
+			return 46
+		}
+		return NoState
+	},
+	// S113
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
+		}
+		return NoState
+	},
+	// S114
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
+		}
+		return NoState
+	},
+	// S115
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 114: // ['a','r']
+			return 46
+		case r == 115: // ['s','s']
+			return 143
+		case 116 <= r && r <= 122: // ['t','z']
+			return 46
+		}
+		return NoState
+	},
+	// S116
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 114: // ['a','r']
+			return 46
+		case r == 115: // ['s','s']
+			return 144
+		case 116 <= r && r <= 122: // ['t','z']
+			return 46
+		}
+		return NoState
+	},
+	// S117
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case r == 97: // ['a','a']
+			return 145
+		case 98 <= r && r <= 122: // ['b','z']
+			return 46
+		}
+		return NoState
+	},
+	// S118
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 107: // ['a','k']
+			return 46
+		case r == 108: // ['l','l']
+			return 146
+		case 109 <= r && r <= 122: // ['m','z']
+			return 46
+		}
+		return NoState
+	},
+	// S119
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 99: // ['a','c']
+			return 46
+		case r == 100: // ['d','d']
+			return 147
+		case 101 <= r && r <= 122: // ['e','z']
+			return 46
+		}
+		return NoState
+	},
+	// S120
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 110: // ['a','n']
+			return 46
+		case r == 111: // ['o','o']
+			return 148
+		case 112 <= r && r <= 122: // ['p','z']
+			return 46
+		}
+		return NoState
+	},
+	// S121
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
+		}
+		return NoState
+	},
+	// S122
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 113: // ['a','q']
+			return 46
+		case r == 114: // ['r','r']
+			return 149
+		case 115 <= r && r <= 122: // ['s','z']
+			return 46
+		}
+		return NoState
+	},
+	// S123
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 115: // ['a','s']
+			return 46
+		case r == 116: // ['t','t']
+			return 150
+		case 117 <= r && r <= 122: // ['u','z']
+			return 46
+		}
+		return NoState
+	},
+	// S124
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 49: // ['0','1']
+			return 45
+		case r == 50: // ['2','2']
+			return 151
+		case 51 <= r && r <= 57: // ['3','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
+		}
+		return NoState
+	},
+	// S125
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 51: // ['0','3']
+			return 45
+		case r == 52: // ['4','4']
+			return 152
+		case 53 <= r && r <= 57: // ['5','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
+		}
+		return NoState
+	},
+	// S126
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
+		}
+		return NoState
+	},
+	// S127
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 110: // ['a','n']
+			return 46
+		case r == 111: // ['o','o']
+			return 153
+		case 112 <= r && r <= 122: // ['p','z']
+			return 46
+		}
+		return NoState
+	},
+	// S128
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 100: // ['a','d']
+			return 46
+		case r == 101: // ['e','e']
+			return 154
+		case 102 <= r && r <= 122: // ['f','z']
+			return 46
+		}
+		return NoState
+	},
+	// S129
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case r == 97: // ['a','a']
+			return 155
+		case 98 <= r && r <= 122: // ['b','z']
+			return 46
+		}
+		return NoState
+	},
+	// S130
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case r == 97: // ['a','a']
+			return 156
+		case 98 <= r && r <= 122: // ['b','z']
+			return 46
+		}
+		return NoState
+	},
+	// S131
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case r == 97: // ['a','a']
+			return 157
+		case 98 <= r && r <= 122: // ['b','z']
+			return 46
+		}
+		return NoState
+	},
+	// S132
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 104: // ['a','h']
+			return 46
+		case r == 105: // ['i','i']
+			return 158
+		case 106 <= r && r <= 122: // ['j','z']
+			return 46
+		}
+		return NoState
+	},
+	// S133
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 100: // ['a','d']
+			return 46
+		case r == 101: // ['e','e']
+			return 159
+		case 102 <= r && r <= 122: // ['f','z']
+			return 46
+		}
+		return NoState
+	},
+	// S134
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 100: // ['a','d']
+			return 46
+		case r == 101: // ['e','e']
+			return 160
+		case 102 <= r && r <= 122: // ['f','z']
+			return 46
+		}
+		return NoState
+	},
+	// S135
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 115: // ['a','s']
+			return 46
+		case r == 116: // ['t','t']
+			return 161
+		case 117 <= r && r <= 122: // ['u','z']
+			return 46
+		}
+		return NoState
+	},
+	// S136
+	func(r rune) int {
+		switch {
+		case r == 40: // ['(','(']
+			return 162
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
+		}
+		return NoState
+	},
+	// S137
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 109: // ['a','m']
+			return 46
+		case r == 110: // ['n','n']
+			return 163
+		case 111 <= r && r <= 122: // ['o','z']
+			return 46
+		}
+		return NoState
+	},
+	// S138
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 98: // ['a','b']
+			return 46
+		case r == 99: // ['c','c']
+			return 164
+		case 100 <= r && r <= 122: // ['d','z']
+			return 46
+		}
+		return NoState
+	},
+	// S139
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 50: // ['0','2']
+			return 45
+		case r == 51: // ['3','3']
+			return 165
+		case 52 <= r && r <= 53: // ['4','5']
+			return 45
+		case r == 54: // ['6','6']
+			return 166
+		case 55 <= r && r <= 57: // ['7','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
+		}
+		return NoState
+	},
+	// S140
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 102: // ['a','f']
+			return 46
+		case r == 103: // ['g','g']
+			return 167
+		case 104 <= r && r <= 122: // ['h','z']
+			return 46
+		}
+		return NoState
+	},
+	// S141
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 113: // ['a','q']
+			return 46
+		case r == 114: // ['r','r']
+			return 168
+		case 115 <= r && r <= 122: // ['s','z']
+			return 46
+		}
+		return NoState
+	},
+	// S142
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 108: // ['a','l']
+			return 46
+		case r == 109: // ['m','m']
+			return 169
+		case 110 <= r && r <= 122: // ['n','z']
+			return 46
+		}
+		return NoState
+	},
+	// S143
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 100: // ['a','d']
+			return 46
+		case r == 101: // ['e','e']
+			return 170
+		case 102 <= r && r <= 122: // ['f','z']
+			return 46
+		}
+		return NoState
+	},
+	// S144
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
+		}
+		return NoState
+	},
+	// S145
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
+		}
+		return NoState
+	},
+	// S146
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 100: // ['a','d']
+			return 46
+		case r == 101: // ['e','e']
+			return 171
+		case 102 <= r && r <= 122: // ['f','z']
+			return 46
+		}
+		return NoState
+	},
+	// S147
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 104: // ['a','h']
+			return 46
+		case r == 105: // ['i','i']
+			return 172
+		case 106 <= r && r <= 122: // ['j','z']
+			return 46
+		}
+		return NoState
+	},
+	// S148
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 111: // ['a','o']
+			return 46
+		case r == 112: // ['p','p']
+			return 173
+		case 113 <= r && r <= 122: // ['q','z']
+			return 46
+		}
+		return NoState
+	},
+	// S149
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 115: // ['a','s']
+			return 46
+		case r == 116: // ['t','t']
+			return 174
+		case 117 <= r && r <= 122: // ['u','z']
+			return 46
+		}
+		return NoState
+	},
+	// S150
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
 		}
 		return NoState
 	},
-	// S91
+	// S151
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 109: // ['a','m']
-			return 43
-		case r == 110: // ['n','n']
-			return 116
-		case 111 <= r && r <= 122: // ['o','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
 		}
 		return NoState
 	},
-	// S92
+	// S152
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S93
+	// S153
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 110: // ['a','n']
-			return 43
-		case r == 111: // ['o','o']
-			return 117
-		case 112 <= r && r <= 122: // ['p','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 116: // ['a','t']
+			return 46
+		case r == 117: // ['u','u']
+			return 175
+		case 118 <= r && r <= 122: // ['v','z']
+			return 46
 		}
 		return NoState
 	},
-	// S94
+	// S154
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 94
-		}
-		return NoState
-	},
-	// S95
-	func(r rune) int {
-		switch {
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 109: // ['a','m']
+			return 46
+		case r == 110: // ['n','n']
+			return 176
+		case 111 <= r && r <= 122: // ['o','z']
+			return 46
 		}
 		return NoState
 	},
-	// S96
+	// S155
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 102: // ['a','f']
+			return 46
+		case r == 103: // ['g','g']
+			return 177
+		case 104 <= r && r <= 122: // ['h','z']
+			return 46
 		}
 		return NoState
 	},
-	// S97
+	// S156
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 107: // ['a','k']
+			return 46
+		case r == 108: // ['l','l']
+			return 178
+		case 109 <= r && r <= 122: // ['m','z']
+			return 46
 		}
 		return NoState
 	},
-	// S98
+	// S157
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 102: // ['a','f']
+			return 46
+		case r == 103: // ['g','g']
+			return 179
+		case 104 <= r && r <= 122: // ['h','z']
+			return 46
 		}
 		return NoState
 	},
-	// S99
+	// S158
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 114: // ['a','r']
-			return 43
+			return 46
 		case r == 115: // ['s','s']
-			return 118
+			return 180
 		case 116 <= r && r <= 122: // ['t','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S100
+	// S159
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 107: // ['a','k']
-			return 43
-		case r == 108: // ['l','l']
-			return 119
-		case 109 <= r && r <= 122: // ['m','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 113: // ['a','q']
+			return 46
+		case r == 114: // ['r','r']
+			return 181
+		case 115 <= r && r <= 122: // ['s','z']
+			return 46
 		}
 		return NoState
 	},
-	// S101
+	// S160
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S102
+	// S161
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 113: // ['a','q']
-			return 43
-		case r == 114: // ['r','r']
-			return 120
-		case 115 <= r && r <= 122: // ['s','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
 		}
 		return NoState
 	},
-	// S103
+	// S162
 	func(r rune) int {
 		switch {
-		case r == 46: // ['.','.']
-			return 41
-		case 48 <= r && r <= 57: // ['0','9']
-			return 42
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
-		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 115: // ['a','s']
-			return 43
-		case r == 116: // ['t','t']
-			return 121
-		case 117 <= r && r <= 122: // ['u','z']
-			return 43
 		}
 		return NoState
 	},
-	// S104
+	// S163
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
-		case 48 <= r && r <= 49: // ['0','1']
-			return 42
-		case r == 50: // ['2','2']
-			return 122
-		case 51 <= r && r <= 57: // ['3','9']
-			return 42
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 102: // ['a','f']
+			return 46
+		case r == 103: // ['g','g']
+			return 182
+		case 104 <= r && r <= 122: // ['h','z']
+			return 46
 		}
 		return NoState
 	},
-	// S105
+	// S164
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
-		case 48 <= r && r <= 51: // ['0','3']
-			return 42
-		case r == 52: // ['4','4']
-			return 123
-		case 53 <= r && r <= 57: // ['5','9']
-			return 42
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 115: // ['a','s']
+			return 46
+		case r == 116: // ['t','t']
+			return 183
+		case 117 <= r && r <= 122: // ['u','z']
+			return 46
 		}
 		return NoState
 	},
-	// S106
+	// S165
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
-		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 44
+		case 48 <= r && r <= 49: // ['0','1']
+			return 45
+		case r == 50: // ['2','2']
+			return 184
+		case 51 <= r && r <= 57: // ['3','9']
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S107
+	// S166
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
-		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 44
+		case 48 <= r && r <= 51: // ['0','3']
+			return 45
+		case r == 52: // ['4','4']
+			return 185
+		case 53 <= r && r <= 57: // ['5','9']
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case r == 97: // ['a','a']
-			return 124
-		case 98 <= r && r <= 122: // ['b','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
 		}
 		return NoState
 	},
-	// S108
+	// S167
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case r == 97: // ['a','a']
-			return 125
-		case 98 <= r && r <= 122: // ['b','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
 		}
 		return NoState
 	},
-	// S109
+	// S168
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 104: // ['a','h']
-			return 43
-		case r == 105: // ['i','i']
-			return 126
-		case 106 <= r && r <= 122: // ['j','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 115: // ['a','s']
+			return 46
+		case r == 116: // ['t','t']
+			return 186
+		case 117 <= r && r <= 122: // ['u','z']
+			return 46
 		}
 		return NoState
 	},
-	// S110
+	// S169
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 100: // ['a','d']
-			return 43
+			return 46
 		case r == 101: // ['e','e']
-			return 127
+			return 187
 		case 102 <= r && r <= 122: // ['f','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S111
+	// S170
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 115: // ['a','s']
-			return 43
+			return 46
 		case r == 116: // ['t','t']
-			return 128
+			return 188
 		case 117 <= r && r <= 122: // ['u','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S112
+	// S171
 	func(r rune) int {
 		switch {
-		case r == 40: // ['(','(']
-			return 129
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S113
+	// S172
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 109: // ['a','m']
-			return 43
+			return 46
 		case r == 110: // ['n','n']
-			return 130
+			return 189
 		case 111 <= r && r <= 122: // ['o','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S114
+	// S173
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 98: // ['a','b']
-			return 43
-		case r == 99: // ['c','c']
-			return 131
-		case 100 <= r && r <= 122: // ['d','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 120: // ['a','x']
+			return 46
+		case r == 121: // ['y','y']
+			return 190
+		case r == 122: // ['z','z']
+			return 46
 		}
 		return NoState
 	},
-	// S115
+	// S174
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
-		case 48 <= r && r <= 50: // ['0','2']
-			return 42
-		case r == 51: // ['3','3']
-			return 132
-		case 52 <= r && r <= 53: // ['4','5']
-			return 42
-		case r == 54: // ['6','6']
-			return 133
-		case 55 <= r && r <= 57: // ['7','9']
-			return 42
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case r == 65: // ['A','A']
+			return 191
+		case 66 <= r && r <= 90: // ['B','Z']
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S116
+	// S175
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
+		case 97 <= r && r <= 109: // ['a','m']
+			return 46
+		case r == 110: // ['n','n']
+			return 192
+		case 111 <= r && r <= 122: // ['o','z']
+			return 46
+		}
+		return NoState
+	},
+	// S176
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
 		case 97 <= r && r <= 102: // ['a','f']
-			return 43
+			return 46
 		case r == 103: // ['g','g']
-			return 134
+			return 193
 		case 104 <= r && r <= 122: // ['h','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S117
+	// S177
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 113: // ['a','q']
-			return 43
-		case r == 114: // ['r','r']
-			return 135
-		case 115 <= r && r <= 122: // ['s','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 100: // ['a','d']
+			return 46
+		case r == 101: // ['e','e']
+			return 194
+		case 102 <= r && r <= 122: // ['f','z']
+			return 46
 		}
 		return NoState
 	},
-	// S118
+	// S178
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 104: // ['a','h']
+			return 46
+		case r == 105: // ['i','i']
+			return 195
+		case 106 <= r && r <= 122: // ['j','z']
+			return 46
 		}
 		return NoState
 	},
-	// S119
+	// S179
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 100: // ['a','d']
-			return 43
+			return 46
 		case r == 101: // ['e','e']
-			return 136
+			return 196
 		case 102 <= r && r <= 122: // ['f','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S120
+	// S180
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 115: // ['a','s']
-			return 43
-		case r == 116: // ['t','t']
-			return 137
-		case 117 <= r && r <= 122: // ['u','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 104: // ['a','h']
+			return 46
+		case r == 105: // ['i','i']
+			return 197
+		case 106 <= r && r <= 122: // ['j','z']
+			return 46
 		}
 		return NoState
 	},
-	// S121
+	// S181
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 109: // ['a','m']
+			return 46
+		case r == 110: // ['n','n']
+			return 198
+		case 111 <= r && r <= 122: // ['o','z']
+			return 46
 		}
 		return NoState
 	},
-	// S122
+	// S182
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S123
+	// S183
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S124
+	// S184
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 102: // ['a','f']
-			return 43
-		case r == 103: // ['g','g']
-			return 138
-		case 104 <= r && r <= 122: // ['h','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
 		}
 		return NoState
 	},
-	// S125
+	// S185
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 102: // ['a','f']
-			return 43
-		case r == 103: // ['g','g']
-			return 139
-		case 104 <= r && r <= 122: // ['h','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
 		}
 		return NoState
 	},
-	// S126
+	// S186
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 114: // ['a','r']
-			return 43
-		case r == 115: // ['s','s']
-			return 140
-		case 116 <= r && r <= 122: // ['t','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
 		}
 		return NoState
 	},
-	// S127
+	// S187
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 109: // ['a','m']
+			return 46
+		case r == 110: // ['n','n']
+			return 199
+		case 111 <= r && r <= 122: // ['o','z']
+			return 46
 		}
 		return NoState
 	},
-	// S128
+	// S188
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
-		}
-		return NoState
-	},
-	// S129
-	func(r rune) int {
-		switch {
+			return 46
 		}
 		return NoState
 	},
-	// S130
+	// S189
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 102: // ['a','f']
-			return 43
+			return 46
 		case r == 103: // ['g','g']
-			return 141
+			return 200
 		case 104 <= r && r <= 122: // ['h','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S131
+	// S190
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 115: // ['a','s']
-			return 43
-		case r == 116: // ['t','t']
-			return 142
-		case 117 <= r && r <= 122: // ['u','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
 		}
 		return NoState
 	},
-	// S132
+	// S191
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
-		case 48 <= r && r <= 49: // ['0','1']
-			return 42
-		case r == 50: // ['2','2']
-			return 143
-		case 51 <= r && r <= 57: // ['3','9']
-			return 42
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 114: // ['a','r']
+			return 46
+		case r == 115: // ['s','s']
+			return 201
+		case 116 <= r && r <= 122: // ['t','z']
+			return 46
 		}
 		return NoState
 	},
-	// S133
+	// S192
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
-		case 48 <= r && r <= 51: // ['0','3']
-			return 42
-		case r == 52: // ['4','4']
-			return 144
-		case 53 <= r && r <= 57: // ['5','9']
-			return 42
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 115: // ['a','s']
+			return 46
+		case r == 116: // ['t','t']
+			return 202
+		case 117 <= r && r <= 122: // ['u','z']
+			return 46
 		}
 		return NoState
 	},
-	// S134
+	// S193
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 115: // ['a','s']
+			return 46
+		case r == 116: // ['t','t']
+			return 203
+		case 117 <= r && r <= 122: // ['u','z']
+			return 46
 		}
 		return NoState
 	},
-	// S135
+	// S194
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 115: // ['a','s']
-			return 43
-		case r == 116: // ['t','t']
-			return 145
-		case 117 <= r && r <= 122: // ['u','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
 		}
 		return NoState
 	},
-	// S136
+	// S195
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 121: // ['a','y']
+			return 46
+		case r == 122: // ['z','z']
+			return 204
 		}
 		return NoState
 	},
-	// S137
+	// S196
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
-		case r == 65: // ['A','A']
-			return 146
-		case 66 <= r && r <= 90: // ['B','Z']
-			return 43
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S138
+	// S197
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 100: // ['a','d']
-			return 43
-		case r == 101: // ['e','e']
-			return 147
-		case 102 <= r && r <= 122: // ['f','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 110: // ['a','n']
+			return 46
+		case r == 111: // ['o','o']
+			return 205
+		case 112 <= r && r <= 122: // ['p','z']
+			return 46
 		}
 		return NoState
 	},
-	// S139
+	// S198
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 100: // ['a','d']
-			return 43
-		case r == 101: // ['e','e']
-			return 148
-		case 102 <= r && r <= 122: // ['f','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 104: // ['a','h']
+			return 46
+		case r == 105: // ['i','i']
+			return 206
+		case 106 <= r && r <= 122: // ['j','z']
+			return 46
 		}
 		return NoState
 	},
-	// S140
+	// S199
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 104: // ['a','h']
-			return 43
-		case r == 105: // ['i','i']
-			return 149
-		case 106 <= r && r <= 122: // ['j','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 115: // ['a','s']
+			return 46
+		case r == 116: // ['t','t']
+			return 207
+		case 117 <= r && r <= 122: // ['u','z']
+			return 46
 		}
 		return NoState
 	},
-	// S141
+	// S200
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S142
+	// S201
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S143
+	// S202
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S144
+	// S203
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 103: // ['a','g']
+			return 46
+		case r == 104: // ['h','h']
+			return 208
+		case 105 <= r && r <= 122: // ['i','z']
+			return 46
 		}
 		return NoState
 	},
-	// S145
+	// S204
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 100: // ['a','d']
+			return 46
+		case r == 101: // ['e','e']
+			return 209
+		case 102 <= r && r <= 122: // ['f','z']
+			return 46
 		}
 		return NoState
 	},
-	// S146
+	// S205
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 114: // ['a','r']
-			return 43
-		case r == 115: // ['s','s']
-			return 150
-		case 116 <= r && r <= 122: // ['t','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 109: // ['a','m']
+			return 46
+		case r == 110: // ['n','n']
+			return 210
+		case 111 <= r && r <= 122: // ['o','z']
+			return 46
 		}
 		return NoState
 	},
-	// S147
+	// S206
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
+		case 97 <= r && r <= 110: // ['a','n']
+			return 46
+		case r == 111: // ['o','o']
+			return 211
+		case 112 <= r && r <= 122: // ['p','z']
+			return 46
+		}
+		return NoState
+	},
+	// S207
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S148
+	// S208
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S149
+	// S209
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
-		case 97 <= r && r <= 110: // ['a','n']
-			return 43
-		case r == 111: // ['o','o']
-			return 151
-		case 112 <= r && r <= 122: // ['p','z']
-			return 43
+			return 46
+		case 97 <= r && r <= 99: // ['a','c']
+			return 46
+		case r == 100: // ['d','d']
+			return 212
+		case 101 <= r && r <= 122: // ['e','z']
+			return 46
 		}
 		return NoState
 	},
-	// S150
+	// S210
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S151
+	// S211
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 109: // ['a','m']
-			return 43
+			return 46
 		case r == 110: // ['n','n']
-			return 152
+			return 213
 		case 111 <= r && r <= 122: // ['o','z']
-			return 43
+			return 46
 		}
 		return NoState
 	},
-	// S152
+	// S212
 	func(r rune) int {
 		switch {
 		case r == 46: // ['.','.']
-			return 41
+			return 44
 		case 48 <= r && r <= 57: // ['0','9']
-			return 42
+			return 45
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 43
+			return 46
 		case r == 95: // ['_','_']
-			return 43
+			return 46
 		case 97 <= r && r <= 122: // ['a','z']
-			return 43
+			return 46
+		}
+		return NoState
+	},
+	// S213
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 44
+		case 48 <= r && r <= 57: // ['0','9']
+			return 45
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 46
+		case r == 95: // ['_','_']
+			return 46
+		case 97 <= r && r <= 122: // ['a','z']
+			return 46
 		}
 		return NoState
 	},
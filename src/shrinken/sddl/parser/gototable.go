@@ -2,7 +2,7 @@
 
 package parser
 
-const numNTSymbols = 33
+const numNTSymbols = 44
 
 type (
 	gotoTable [numStates]gotoRow
@@ -25,6 +25,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -36,6 +37,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -60,6 +71,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -71,6 +83,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -95,6 +117,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		4,  // AttributeGroup
@@ -106,6 +129,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -130,6 +163,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -141,6 +175,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -165,6 +209,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -176,6 +221,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -200,6 +255,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -207,11 +263,21 @@ var gotoTab = gotoTable{
 		-1, // AttributeGroupElement
 		-1, // SingleAttribute
 		-1, // Attributes
-		11, // Attribute
-		12, // RangeAttribute
-		13, // ExportAsAttribute
-		14, // PrecisionAttribute
-		15, // MessageAttribute
+		21, // Attribute
+		22, // RangeAttribute
+		23, // ExportAsAttribute
+		24, // PrecisionAttribute
+		25, // EncodingAttribute
+		26, // MaxLengthAttribute
+		27, // CharsetAttribute
+		28, // MaxCountAttribute
+		29, // DeltaAttribute
+		30, // QuaternionAttribute
+		31, // NormalizedAttribute
+		32, // AlignmentAttribute
+		33, // EntropyAttribute
+		34, // IdAttribute
+		35, // MessageAttribute
 		-1, // Range
 		-1, // Number
 		-1, // MathExpr
@@ -235,6 +301,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -246,6 +313,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -259,7 +336,7 @@ var gotoTab = gotoTable{
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
-		20, // PackageBody
+		40, // PackageBody
 		-1, // PackageElement
 		-1, // Import
 		-1, // ClassDef
@@ -270,6 +347,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -281,6 +359,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -305,6 +393,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -316,6 +405,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -340,6 +439,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -351,6 +451,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -375,10 +485,11 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
-		21, // AttributeGroupBody
+		41, // AttributeGroupBody
 		-1, // AttributeGroupElement
 		-1, // SingleAttribute
 		-1, // Attributes
@@ -386,6 +497,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -410,6 +531,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -421,6 +543,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -445,6 +577,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -456,6 +589,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -480,6 +623,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -491,6 +635,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -515,6 +669,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -526,6 +681,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -550,6 +715,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -561,6 +727,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -585,6 +761,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -596,6 +773,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -620,6 +807,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -631,6 +819,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -655,6 +853,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -666,6 +865,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -690,6 +899,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -701,6 +911,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -715,27 +935,38 @@ var gotoTab = gotoTable{
 		-1, // Package
 		-1, // PackageName
 		-1, // PackageBody
-		27, // PackageElement
-		26, // Import
-		28, // ClassDef
-		29, // StructDef
-		30, // EnumDef
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
 		-1, // GenericType
 		-1, // Type
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
 		-1, // AttributeGroupBody
 		-1, // AttributeGroupElement
 		-1, // SingleAttribute
-		25, // Attributes
+		-1, // Attributes
 		-1, // Attribute
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -760,18 +991,29 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
 		-1, // AttributeGroupBody
-		32, // AttributeGroupElement
+		-1, // AttributeGroupElement
 		-1, // SingleAttribute
 		-1, // Attributes
-		33, // Attribute
-		34, // RangeAttribute
-		35, // ExportAsAttribute
-		36, // PrecisionAttribute
-		37, // MessageAttribute
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
 		-1, // MathExpr
@@ -795,6 +1037,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -806,8 +1049,18 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
-		43, // Range
+		-1, // Range
 		-1, // Number
 		-1, // MathExpr
 		-1, // AddSub
@@ -830,6 +1083,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -841,6 +1095,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -865,6 +1129,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -876,14 +1141,24 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
-		48, // Number
-		47, // MathExpr
-		54, // AddSub
-		55, // MulDiv
-		56, // Pot
-		57, // Factor
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
 	},
 	gotoRow{ // S25
 		-1, // S'
@@ -900,17 +1175,28 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
-		64, // AttributeGroup
+		-1, // AttributeGroup
 		-1, // AttributeGroupBody
 		-1, // AttributeGroupElement
-		66, // SingleAttribute
+		-1, // SingleAttribute
 		-1, // Attributes
 		-1, // Attribute
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -935,6 +1221,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -946,6 +1233,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -970,6 +1267,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -981,6 +1279,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1005,6 +1313,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1016,6 +1325,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1040,6 +1359,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1051,6 +1371,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1075,6 +1405,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1086,6 +1417,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1110,6 +1451,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1121,6 +1463,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1145,6 +1497,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1156,6 +1509,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1180,6 +1543,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1191,6 +1555,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1215,6 +1589,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1226,6 +1601,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1250,6 +1635,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1261,6 +1647,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1285,6 +1681,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1296,6 +1693,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1320,6 +1727,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1331,6 +1739,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1355,6 +1773,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1366,6 +1785,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1390,6 +1819,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1401,6 +1831,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1415,27 +1855,38 @@ var gotoTab = gotoTable{
 		-1, // Package
 		-1, // PackageName
 		-1, // PackageBody
-		-1, // PackageElement
-		-1, // Import
-		-1, // ClassDef
-		-1, // StructDef
-		-1, // EnumDef
+		54, // PackageElement
+		53, // Import
+		55, // ClassDef
+		56, // StructDef
+		57, // EnumDef
 		-1, // GenericType
 		-1, // Type
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
 		-1, // AttributeGroupBody
 		-1, // AttributeGroupElement
 		-1, // SingleAttribute
-		-1, // Attributes
+		52, // Attributes
 		-1, // Attribute
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1460,18 +1911,29 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
 		-1, // AttributeGroupBody
-		-1, // AttributeGroupElement
+		69, // AttributeGroupElement
 		-1, // SingleAttribute
 		-1, // Attributes
-		-1, // Attribute
-		-1, // RangeAttribute
-		-1, // ExportAsAttribute
-		-1, // PrecisionAttribute
-		-1, // MessageAttribute
+		70, // Attribute
+		71, // RangeAttribute
+		72, // ExportAsAttribute
+		73, // PrecisionAttribute
+		74, // EncodingAttribute
+		75, // MaxLengthAttribute
+		76, // CharsetAttribute
+		77, // MaxCountAttribute
+		78, // DeltaAttribute
+		79, // QuaternionAttribute
+		80, // NormalizedAttribute
+		81, // AlignmentAttribute
+		82, // EntropyAttribute
+		83, // IdAttribute
+		84, // MessageAttribute
 		-1, // Range
 		-1, // Number
 		-1, // MathExpr
@@ -1495,6 +1957,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1506,41 +1969,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
-		-1, // MessageAttribute
-		-1, // Range
-		73, // Number
-		72, // MathExpr
-		79, // AddSub
-		80, // MulDiv
-		81, // Pot
-		82, // Factor
-	},
-	gotoRow{ // S43
-		-1, // S'
-		-1, // Package
-		-1, // PackageName
-		-1, // PackageBody
-		-1, // PackageElement
-		-1, // Import
-		-1, // ClassDef
-		-1, // StructDef
-		-1, // EnumDef
-		-1, // GenericType
-		-1, // Type
-		-1, // TypeName
-		-1, // VarDecl
-		-1, // MultiVarDecl
-		-1, // StructBody
-		-1, // EnumBody
-		-1, // AttributeGroup
-		-1, // AttributeGroupBody
-		-1, // AttributeGroupElement
-		-1, // SingleAttribute
-		-1, // Attributes
-		-1, // Attribute
-		-1, // RangeAttribute
-		-1, // ExportAsAttribute
-		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1550,42 +1988,53 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S44
-		-1, // S'
-		-1, // Package
-		-1, // PackageName
-		-1, // PackageBody
-		-1, // PackageElement
-		-1, // Import
-		-1, // ClassDef
-		-1, // StructDef
-		-1, // EnumDef
-		-1, // GenericType
-		-1, // Type
-		-1, // TypeName
-		-1, // VarDecl
-		-1, // MultiVarDecl
-		-1, // StructBody
-		-1, // EnumBody
-		-1, // AttributeGroup
-		-1, // AttributeGroupBody
-		-1, // AttributeGroupElement
-		-1, // SingleAttribute
-		-1, // Attributes
-		-1, // Attribute
-		-1, // RangeAttribute
-		-1, // ExportAsAttribute
-		-1, // PrecisionAttribute
-		-1, // MessageAttribute
-		-1, // Range
-		73, // Number
-		85, // MathExpr
-		79, // AddSub
-		80, // MulDiv
-		81, // Pot
-		82, // Factor
+	gotoRow{ // S43
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		92,  // Number
+		91,  // MathExpr
+		98,  // AddSub
+		99,  // MulDiv
+		100, // Pot
+		101, // Factor
 	},
-	gotoRow{ // S45
+	gotoRow{ // S44
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -1600,6 +2049,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1611,6 +2061,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1620,7 +2080,99 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
+	gotoRow{ // S45
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		92,  // Number
+		105, // MathExpr
+		98,  // AddSub
+		99,  // MulDiv
+		100, // Pot
+		101, // Factor
+	},
 	gotoRow{ // S46
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		92,  // Number
+		106, // MathExpr
+		98,  // AddSub
+		99,  // MulDiv
+		100, // Pot
+		101, // Factor
+	},
+	gotoRow{ // S47
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -1635,6 +2187,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1646,6 +2199,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1655,7 +2218,99 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S47
+	gotoRow{ // S48
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		92,  // Number
+		108, // MathExpr
+		98,  // AddSub
+		99,  // MulDiv
+		100, // Pot
+		101, // Factor
+	},
+	gotoRow{ // S49
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		110, // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
+	},
+	gotoRow{ // S50
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -1670,6 +2325,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1681,6 +2337,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1690,21 +2356,114 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S48
-		-1, // S'
-		-1, // Package
-		-1, // PackageName
-		-1, // PackageBody
-		-1, // PackageElement
-		-1, // Import
-		-1, // ClassDef
-		-1, // StructDef
-		-1, // EnumDef
-		-1, // GenericType
-		-1, // Type
-		-1, // TypeName
+	gotoRow{ // S51
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		92,  // Number
+		113, // MathExpr
+		98,  // AddSub
+		99,  // MulDiv
+		100, // Pot
+		101, // Factor
+	},
+	gotoRow{ // S52
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		118, // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		120, // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
+	},
+	gotoRow{ // S53
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1716,6 +2475,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1725,7 +2494,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S49
+	gotoRow{ // S54
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -1740,6 +2509,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1751,6 +2521,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1760,7 +2540,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S50
+	gotoRow{ // S55
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -1775,6 +2555,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1786,6 +2567,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1795,7 +2586,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S51
+	gotoRow{ // S56
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -1810,6 +2601,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1821,6 +2613,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1830,7 +2632,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S52
+	gotoRow{ // S57
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -1845,6 +2647,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1856,6 +2659,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1865,7 +2678,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S53
+	gotoRow{ // S58
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -1880,6 +2693,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1891,6 +2705,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1900,7 +2724,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S54
+	gotoRow{ // S59
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -1915,6 +2739,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1926,6 +2751,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1935,7 +2770,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S55
+	gotoRow{ // S60
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -1950,6 +2785,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1961,6 +2797,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -1970,7 +2816,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S56
+	gotoRow{ // S61
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -1985,6 +2831,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -1996,6 +2843,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2005,7 +2862,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S57
+	gotoRow{ // S62
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -2020,6 +2877,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2031,6 +2889,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2040,77 +2908,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S58
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		93,  // Number
-		-1,  // MathExpr
-		99,  // AddSub
-		100, // MulDiv
-		101, // Pot
-		102, // Factor
-	},
-	gotoRow{ // S59
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		93,  // Number
-		-1,  // MathExpr
-		105, // AddSub
-		100, // MulDiv
-		101, // Pot
-		102, // Factor
-	},
-	gotoRow{ // S60
+	gotoRow{ // S63
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -2125,6 +2923,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2136,6 +2935,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2145,7 +2954,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S61
+	gotoRow{ // S64
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -2160,6 +2969,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2171,6 +2981,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2180,7 +3000,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S62
+	gotoRow{ // S65
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -2195,6 +3015,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2206,6 +3027,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2215,7 +3046,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S63
+	gotoRow{ // S66
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -2230,6 +3061,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2241,6 +3073,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2250,7 +3092,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S64
+	gotoRow{ // S67
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -2265,6 +3107,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2276,6 +3119,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2285,42 +3138,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S65
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		111, // Attribute
-		112, // RangeAttribute
-		113, // ExportAsAttribute
-		114, // PrecisionAttribute
-		115, // MessageAttribute
-		-1,  // Range
-		-1,  // Number
-		-1,  // MathExpr
-		-1,  // AddSub
-		-1,  // MulDiv
-		-1,  // Pot
-		-1,  // Factor
-	},
-	gotoRow{ // S66
+	gotoRow{ // S68
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -2335,6 +3153,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2346,6 +3165,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2355,7 +3184,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S67
+	gotoRow{ // S69
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -2370,6 +3199,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2381,6 +3211,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2390,42 +3230,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S68
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		121, // Range
-		-1,  // Number
-		-1,  // MathExpr
-		-1,  // AddSub
-		-1,  // MulDiv
-		-1,  // Pot
-		-1,  // Factor
-	},
-	gotoRow{ // S69
+	gotoRow{ // S70
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -2440,6 +3245,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2451,6 +3257,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2460,41 +3276,6 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S70
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		73,  // Number
-		124, // MathExpr
-		79,  // AddSub
-		80,  // MulDiv
-		81,  // Pot
-		82,  // Factor
-	},
 	gotoRow{ // S71
 		-1, // S'
 		-1, // Package
@@ -2510,6 +3291,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2521,6 +3303,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2545,6 +3337,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2556,6 +3349,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2580,6 +3383,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2591,6 +3395,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2615,6 +3429,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2626,6 +3441,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2650,6 +3475,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2661,6 +3487,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2685,6 +3521,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2696,6 +3533,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2720,6 +3567,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2731,6 +3579,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2755,6 +3613,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2766,6 +3625,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2790,6 +3659,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2801,6 +3671,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2825,6 +3705,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2836,6 +3717,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2860,6 +3751,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2871,6 +3763,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2895,6 +3797,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -2906,6 +3809,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -2916,76 +3829,6 @@ var gotoTab = gotoTable{
 		-1, // Factor
 	},
 	gotoRow{ // S83
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		93,  // Number
-		-1,  // MathExpr
-		132, // AddSub
-		100, // MulDiv
-		101, // Pot
-		102, // Factor
-	},
-	gotoRow{ // S84
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		93,  // Number
-		-1,  // MathExpr
-		133, // AddSub
-		100, // MulDiv
-		101, // Pot
-		102, // Factor
-	},
-	gotoRow{ // S85
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3000,6 +3843,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3011,6 +3855,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -3020,7 +3874,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S86
+	gotoRow{ // S84
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3035,6 +3889,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3046,6 +3901,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -3055,182 +3920,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S87
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		48,  // Number
-		-1,  // MathExpr
-		135, // AddSub
-		136, // MulDiv
-		56,  // Pot
-		57,  // Factor
-	},
-	gotoRow{ // S88
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		48,  // Number
-		-1,  // MathExpr
-		135, // AddSub
-		137, // MulDiv
-		56,  // Pot
-		57,  // Factor
-	},
-	gotoRow{ // S89
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		48,  // Number
-		-1,  // MathExpr
-		135, // AddSub
-		55,  // MulDiv
-		138, // Pot
-		57,  // Factor
-	},
-	gotoRow{ // S90
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		48,  // Number
-		-1,  // MathExpr
-		135, // AddSub
-		55,  // MulDiv
-		139, // Pot
-		57,  // Factor
-	},
-	gotoRow{ // S91
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		48,  // Number
-		-1,  // MathExpr
-		135, // AddSub
-		55,  // MulDiv
-		56,  // Pot
-		140, // Factor
-	},
-	gotoRow{ // S92
+	gotoRow{ // S85
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3245,6 +3935,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3256,6 +3947,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -3265,7 +3966,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S93
+	gotoRow{ // S86
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3280,6 +3981,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3291,6 +3993,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -3300,7 +4012,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S94
+	gotoRow{ // S87
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3315,6 +4027,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3326,6 +4039,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -3335,7 +4058,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S95
+	gotoRow{ // S88
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3350,6 +4073,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3361,6 +4085,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -3370,7 +4104,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S96
+	gotoRow{ // S89
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3385,6 +4119,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3396,6 +4131,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -3405,7 +4150,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S97
+	gotoRow{ // S90
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3420,6 +4165,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3431,6 +4177,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -3440,7 +4196,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S98
+	gotoRow{ // S91
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3455,6 +4211,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3466,6 +4223,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -3475,7 +4242,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S99
+	gotoRow{ // S92
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3490,6 +4257,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3501,6 +4269,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -3510,7 +4288,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S100
+	gotoRow{ // S93
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3525,6 +4303,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3536,6 +4315,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -3545,7 +4334,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S101
+	gotoRow{ // S94
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3560,6 +4349,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3571,6 +4361,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -3580,7 +4380,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S102
+	gotoRow{ // S95
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3595,6 +4395,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3606,6 +4407,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -3615,77 +4426,53 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S103
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		93,  // Number
-		-1,  // MathExpr
-		148, // AddSub
-		100, // MulDiv
-		101, // Pot
-		102, // Factor
-	},
-	gotoRow{ // S104
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		93,  // Number
-		-1,  // MathExpr
-		149, // AddSub
-		100, // MulDiv
-		101, // Pot
-		102, // Factor
+	gotoRow{ // S96
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
 	},
-	gotoRow{ // S105
+	gotoRow{ // S97
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3700,6 +4487,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3711,6 +4499,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -3720,7 +4518,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S106
+	gotoRow{ // S98
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3735,6 +4533,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3746,6 +4545,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -3755,7 +4564,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S107
+	gotoRow{ // S99
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3770,6 +4579,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3781,6 +4591,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -3790,7 +4610,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S108
+	gotoRow{ // S100
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3805,6 +4625,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3816,6 +4637,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -3825,7 +4656,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S109
+	gotoRow{ // S101
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3840,6 +4671,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3851,6 +4683,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -3860,7 +4702,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S110
+	gotoRow{ // S102
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -3875,10 +4717,11 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
-		156, // AttributeGroupBody
+		-1,  // AttributeGroupBody
 		-1,  // AttributeGroupElement
 		-1,  // SingleAttribute
 		-1,  // Attributes
@@ -3886,16 +4729,72 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		-1,  // Number
+		139, // Number
 		-1,  // MathExpr
-		-1,  // AddSub
-		-1,  // MulDiv
-		-1,  // Pot
-		-1,  // Factor
+		145, // AddSub
+		146, // MulDiv
+		147, // Pot
+		148, // Factor
 	},
-	gotoRow{ // S111
+	gotoRow{ // S103
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		139, // Number
+		-1,  // MathExpr
+		151, // AddSub
+		146, // MulDiv
+		147, // Pot
+		148, // Factor
+	},
+	gotoRow{ // S104
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3910,6 +4809,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3921,6 +4821,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -3930,7 +4840,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S112
+	gotoRow{ // S105
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3945,6 +4855,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3956,6 +4867,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -3965,7 +4886,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S113
+	gotoRow{ // S106
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -3980,6 +4901,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -3991,6 +4913,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -4000,7 +4932,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S114
+	gotoRow{ // S107
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -4015,6 +4947,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -4026,6 +4959,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -4035,7 +4978,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S115
+	gotoRow{ // S108
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -4050,6 +4993,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -4061,6 +5005,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -4070,7 +5024,53 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S116
+	gotoRow{ // S109
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		154, // Number
+		153, // MathExpr
+		160, // AddSub
+		161, // MulDiv
+		162, // Pot
+		163, // Factor
+	},
+	gotoRow{ // S110
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -4085,6 +5085,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -4096,6 +5097,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -4105,7 +5116,53 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S117
+	gotoRow{ // S111
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		154, // Number
+		166, // MathExpr
+		160, // AddSub
+		161, // MulDiv
+		162, // Pot
+		163, // Factor
+	},
+	gotoRow{ // S112
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -4120,6 +5177,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -4131,6 +5189,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -4140,7 +5208,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S118
+	gotoRow{ // S113
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -4155,6 +5223,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -4166,6 +5235,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -4175,7 +5254,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S119
+	gotoRow{ // S114
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -4190,6 +5269,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -4201,6 +5281,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -4210,42 +5300,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S120
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		73,  // Number
-		160, // MathExpr
-		79,  // AddSub
-		80,  // MulDiv
-		81,  // Pot
-		82,  // Factor
-	},
-	gotoRow{ // S121
+	gotoRow{ // S115
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -4260,6 +5315,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -4271,6 +5327,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -4280,42 +5346,53 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S122
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		73,  // Number
-		161, // MathExpr
-		79,  // AddSub
-		80,  // MulDiv
-		81,  // Pot
-		82,  // Factor
+	gotoRow{ // S116
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
 	},
-	gotoRow{ // S123
+	gotoRow{ // S117
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -4330,6 +5407,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -4341,6 +5419,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -4350,7 +5438,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S124
+	gotoRow{ // S118
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -4365,6 +5453,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -4376,6 +5465,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -4385,7 +5484,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S125
+	gotoRow{ // S119
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -4400,6 +5499,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -4407,20 +5507,30 @@ var gotoTab = gotoTable{
 		-1,  // AttributeGroupElement
 		-1,  // SingleAttribute
 		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
+		182, // Attribute
+		183, // RangeAttribute
+		184, // ExportAsAttribute
+		185, // PrecisionAttribute
+		186, // EncodingAttribute
+		187, // MaxLengthAttribute
+		188, // CharsetAttribute
+		189, // MaxCountAttribute
+		190, // DeltaAttribute
+		191, // QuaternionAttribute
+		192, // NormalizedAttribute
+		193, // AlignmentAttribute
+		194, // EntropyAttribute
+		195, // IdAttribute
+		196, // MessageAttribute
 		-1,  // Range
-		164, // Number
-		163, // MathExpr
-		170, // AddSub
-		171, // MulDiv
-		172, // Pot
-		173, // Factor
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
 	},
-	gotoRow{ // S126
+	gotoRow{ // S120
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -4435,6 +5545,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -4446,6 +5557,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -4455,42 +5576,53 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S127
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		73,  // Number
-		-1,  // MathExpr
-		176, // AddSub
-		177, // MulDiv
-		81,  // Pot
-		82,  // Factor
+	gotoRow{ // S121
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
 	},
-	gotoRow{ // S128
+	gotoRow{ // S122
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -4505,6 +5637,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -4516,16 +5649,72 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		73,  // Number
-		-1,  // MathExpr
-		176, // AddSub
-		178, // MulDiv
-		81,  // Pot
-		82,  // Factor
+		154, // Number
+		202, // MathExpr
+		160, // AddSub
+		161, // MulDiv
+		162, // Pot
+		163, // Factor
 	},
-	gotoRow{ // S129
+	gotoRow{ // S123
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S124
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -4540,6 +5729,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -4551,16 +5741,26 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		73,  // Number
-		-1,  // MathExpr
-		176, // AddSub
-		80,  // MulDiv
-		179, // Pot
-		82,  // Factor
+		154, // Number
+		204, // MathExpr
+		160, // AddSub
+		161, // MulDiv
+		162, // Pot
+		163, // Factor
 	},
-	gotoRow{ // S130
+	gotoRow{ // S125
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -4575,6 +5775,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -4586,16 +5787,72 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		73,  // Number
-		-1,  // MathExpr
-		176, // AddSub
-		80,  // MulDiv
-		180, // Pot
-		82,  // Factor
+		154, // Number
+		205, // MathExpr
+		160, // AddSub
+		161, // MulDiv
+		162, // Pot
+		163, // Factor
 	},
-	gotoRow{ // S131
+	gotoRow{ // S126
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S127
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -4610,6 +5867,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -4621,16 +5879,26 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		73,  // Number
-		-1,  // MathExpr
-		176, // AddSub
-		80,  // MulDiv
-		81,  // Pot
-		181, // Factor
+		154, // Number
+		207, // MathExpr
+		160, // AddSub
+		161, // MulDiv
+		162, // Pot
+		163, // Factor
 	},
-	gotoRow{ // S132
+	gotoRow{ // S128
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -4645,6 +5913,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -4656,6 +5925,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -4665,7 +5944,53 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S133
+	gotoRow{ // S129
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		209, // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
+	},
+	gotoRow{ // S130
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -4680,6 +6005,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -4691,6 +6017,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -4700,7 +6036,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S134
+	gotoRow{ // S131
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -4715,6 +6051,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -4726,16 +6063,26 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		164, // Number
-		184, // MathExpr
-		170, // AddSub
-		171, // MulDiv
-		172, // Pot
-		173, // Factor
+		154, // Number
+		212, // MathExpr
+		160, // AddSub
+		161, // MulDiv
+		162, // Pot
+		163, // Factor
 	},
-	gotoRow{ // S135
+	gotoRow{ // S132
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -4750,6 +6097,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -4761,6 +6109,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -4770,217 +6128,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S136
-		-1, // S'
-		-1, // Package
-		-1, // PackageName
-		-1, // PackageBody
-		-1, // PackageElement
-		-1, // Import
-		-1, // ClassDef
-		-1, // StructDef
-		-1, // EnumDef
-		-1, // GenericType
-		-1, // Type
-		-1, // TypeName
-		-1, // VarDecl
-		-1, // MultiVarDecl
-		-1, // StructBody
-		-1, // EnumBody
-		-1, // AttributeGroup
-		-1, // AttributeGroupBody
-		-1, // AttributeGroupElement
-		-1, // SingleAttribute
-		-1, // Attributes
-		-1, // Attribute
-		-1, // RangeAttribute
-		-1, // ExportAsAttribute
-		-1, // PrecisionAttribute
-		-1, // MessageAttribute
-		-1, // Range
-		-1, // Number
-		-1, // MathExpr
-		-1, // AddSub
-		-1, // MulDiv
-		-1, // Pot
-		-1, // Factor
-	},
-	gotoRow{ // S137
-		-1, // S'
-		-1, // Package
-		-1, // PackageName
-		-1, // PackageBody
-		-1, // PackageElement
-		-1, // Import
-		-1, // ClassDef
-		-1, // StructDef
-		-1, // EnumDef
-		-1, // GenericType
-		-1, // Type
-		-1, // TypeName
-		-1, // VarDecl
-		-1, // MultiVarDecl
-		-1, // StructBody
-		-1, // EnumBody
-		-1, // AttributeGroup
-		-1, // AttributeGroupBody
-		-1, // AttributeGroupElement
-		-1, // SingleAttribute
-		-1, // Attributes
-		-1, // Attribute
-		-1, // RangeAttribute
-		-1, // ExportAsAttribute
-		-1, // PrecisionAttribute
-		-1, // MessageAttribute
-		-1, // Range
-		-1, // Number
-		-1, // MathExpr
-		-1, // AddSub
-		-1, // MulDiv
-		-1, // Pot
-		-1, // Factor
-	},
-	gotoRow{ // S138
-		-1, // S'
-		-1, // Package
-		-1, // PackageName
-		-1, // PackageBody
-		-1, // PackageElement
-		-1, // Import
-		-1, // ClassDef
-		-1, // StructDef
-		-1, // EnumDef
-		-1, // GenericType
-		-1, // Type
-		-1, // TypeName
-		-1, // VarDecl
-		-1, // MultiVarDecl
-		-1, // StructBody
-		-1, // EnumBody
-		-1, // AttributeGroup
-		-1, // AttributeGroupBody
-		-1, // AttributeGroupElement
-		-1, // SingleAttribute
-		-1, // Attributes
-		-1, // Attribute
-		-1, // RangeAttribute
-		-1, // ExportAsAttribute
-		-1, // PrecisionAttribute
-		-1, // MessageAttribute
-		-1, // Range
-		-1, // Number
-		-1, // MathExpr
-		-1, // AddSub
-		-1, // MulDiv
-		-1, // Pot
-		-1, // Factor
-	},
-	gotoRow{ // S139
-		-1, // S'
-		-1, // Package
-		-1, // PackageName
-		-1, // PackageBody
-		-1, // PackageElement
-		-1, // Import
-		-1, // ClassDef
-		-1, // StructDef
-		-1, // EnumDef
-		-1, // GenericType
-		-1, // Type
-		-1, // TypeName
-		-1, // VarDecl
-		-1, // MultiVarDecl
-		-1, // StructBody
-		-1, // EnumBody
-		-1, // AttributeGroup
-		-1, // AttributeGroupBody
-		-1, // AttributeGroupElement
-		-1, // SingleAttribute
-		-1, // Attributes
-		-1, // Attribute
-		-1, // RangeAttribute
-		-1, // ExportAsAttribute
-		-1, // PrecisionAttribute
-		-1, // MessageAttribute
-		-1, // Range
-		-1, // Number
-		-1, // MathExpr
-		-1, // AddSub
-		-1, // MulDiv
-		-1, // Pot
-		-1, // Factor
-	},
-	gotoRow{ // S140
-		-1, // S'
-		-1, // Package
-		-1, // PackageName
-		-1, // PackageBody
-		-1, // PackageElement
-		-1, // Import
-		-1, // ClassDef
-		-1, // StructDef
-		-1, // EnumDef
-		-1, // GenericType
-		-1, // Type
-		-1, // TypeName
-		-1, // VarDecl
-		-1, // MultiVarDecl
-		-1, // StructBody
-		-1, // EnumBody
-		-1, // AttributeGroup
-		-1, // AttributeGroupBody
-		-1, // AttributeGroupElement
-		-1, // SingleAttribute
-		-1, // Attributes
-		-1, // Attribute
-		-1, // RangeAttribute
-		-1, // ExportAsAttribute
-		-1, // PrecisionAttribute
-		-1, // MessageAttribute
-		-1, // Range
-		-1, // Number
-		-1, // MathExpr
-		-1, // AddSub
-		-1, // MulDiv
-		-1, // Pot
-		-1, // Factor
-	},
-	gotoRow{ // S141
-		-1, // S'
-		-1, // Package
-		-1, // PackageName
-		-1, // PackageBody
-		-1, // PackageElement
-		-1, // Import
-		-1, // ClassDef
-		-1, // StructDef
-		-1, // EnumDef
-		-1, // GenericType
-		-1, // Type
-		-1, // TypeName
-		-1, // VarDecl
-		-1, // MultiVarDecl
-		-1, // StructBody
-		-1, // EnumBody
-		-1, // AttributeGroup
-		-1, // AttributeGroupBody
-		-1, // AttributeGroupElement
-		-1, // SingleAttribute
-		-1, // Attributes
-		-1, // Attribute
-		-1, // RangeAttribute
-		-1, // ExportAsAttribute
-		-1, // PrecisionAttribute
-		-1, // MessageAttribute
-		-1, // Range
-		-1, // Number
-		-1, // MathExpr
-		-1, // AddSub
-		-1, // MulDiv
-		-1, // Pot
-		-1, // Factor
-	},
-	gotoRow{ // S142
+	gotoRow{ // S133
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -4995,6 +6143,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -5006,16 +6155,26 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		93,  // Number
+		92,  // Number
 		-1,  // MathExpr
-		185, // AddSub
-		186, // MulDiv
-		101, // Pot
-		102, // Factor
+		213, // AddSub
+		214, // MulDiv
+		100, // Pot
+		101, // Factor
 	},
-	gotoRow{ // S143
+	gotoRow{ // S134
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -5030,6 +6189,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -5041,51 +6201,26 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		93,  // Number
+		92,  // Number
 		-1,  // MathExpr
-		185, // AddSub
-		187, // MulDiv
-		101, // Pot
-		102, // Factor
-	},
-	gotoRow{ // S144
-		-1, // S'
-		-1, // Package
-		-1, // PackageName
-		-1, // PackageBody
-		-1, // PackageElement
-		-1, // Import
-		-1, // ClassDef
-		-1, // StructDef
-		-1, // EnumDef
-		-1, // GenericType
-		-1, // Type
-		-1, // TypeName
-		-1, // VarDecl
-		-1, // MultiVarDecl
-		-1, // StructBody
-		-1, // EnumBody
-		-1, // AttributeGroup
-		-1, // AttributeGroupBody
-		-1, // AttributeGroupElement
-		-1, // SingleAttribute
-		-1, // Attributes
-		-1, // Attribute
-		-1, // RangeAttribute
-		-1, // ExportAsAttribute
-		-1, // PrecisionAttribute
-		-1, // MessageAttribute
-		-1, // Range
-		-1, // Number
-		-1, // MathExpr
-		-1, // AddSub
-		-1, // MulDiv
-		-1, // Pot
-		-1, // Factor
+		213, // AddSub
+		215, // MulDiv
+		100, // Pot
+		101, // Factor
 	},
-	gotoRow{ // S145
+	gotoRow{ // S135
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -5100,6 +6235,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -5111,16 +6247,26 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		93,  // Number
+		92,  // Number
 		-1,  // MathExpr
-		185, // AddSub
-		100, // MulDiv
-		188, // Pot
-		102, // Factor
+		213, // AddSub
+		99,  // MulDiv
+		216, // Pot
+		101, // Factor
 	},
-	gotoRow{ // S146
+	gotoRow{ // S136
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -5135,6 +6281,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -5146,16 +6293,26 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		93,  // Number
+		92,  // Number
 		-1,  // MathExpr
-		185, // AddSub
-		100, // MulDiv
-		189, // Pot
-		102, // Factor
+		213, // AddSub
+		99,  // MulDiv
+		217, // Pot
+		101, // Factor
 	},
-	gotoRow{ // S147
+	gotoRow{ // S137
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -5170,6 +6327,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -5181,16 +6339,26 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		93,  // Number
+		92,  // Number
 		-1,  // MathExpr
-		185, // AddSub
-		100, // MulDiv
-		101, // Pot
-		190, // Factor
+		213, // AddSub
+		99,  // MulDiv
+		100, // Pot
+		218, // Factor
 	},
-	gotoRow{ // S148
+	gotoRow{ // S138
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -5205,6 +6373,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -5216,6 +6385,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -5225,7 +6404,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S149
+	gotoRow{ // S139
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -5240,6 +6419,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -5251,6 +6431,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -5260,7 +6450,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S150
+	gotoRow{ // S140
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -5275,6 +6465,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -5286,6 +6477,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -5295,182 +6496,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S151
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		193, // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		-1,  // Number
-		-1,  // MathExpr
-		-1,  // AddSub
-		-1,  // MulDiv
-		-1,  // Pot
-		-1,  // Factor
-	},
-	gotoRow{ // S152
-		-1,  // S'
-		-1,  // Package
-		194, // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		197, // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		-1,  // Number
-		-1,  // MathExpr
-		-1,  // AddSub
-		-1,  // MulDiv
-		-1,  // Pot
-		-1,  // Factor
-	},
-	gotoRow{ // S153
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		198, // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		-1,  // Number
-		-1,  // MathExpr
-		-1,  // AddSub
-		-1,  // MulDiv
-		-1,  // Pot
-		-1,  // Factor
-	},
-	gotoRow{ // S154
-		-1,  // S'
-		-1,  // Package
-		194, // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		199, // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		-1,  // Number
-		-1,  // MathExpr
-		-1,  // AddSub
-		-1,  // MulDiv
-		-1,  // Pot
-		-1,  // Factor
-	},
-	gotoRow{ // S155
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		200, // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		-1,  // Number
-		-1,  // MathExpr
-		-1,  // AddSub
-		-1,  // MulDiv
-		-1,  // Pot
-		-1,  // Factor
-	},
-	gotoRow{ // S156
+	gotoRow{ // S141
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -5485,18 +6511,29 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
 		-1, // AttributeGroupBody
-		32, // AttributeGroupElement
+		-1, // AttributeGroupElement
 		-1, // SingleAttribute
 		-1, // Attributes
-		33, // Attribute
-		34, // RangeAttribute
-		35, // ExportAsAttribute
-		36, // PrecisionAttribute
-		37, // MessageAttribute
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
 		-1, // MathExpr
@@ -5505,42 +6542,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S157
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		203, // Range
-		-1,  // Number
-		-1,  // MathExpr
-		-1,  // AddSub
-		-1,  // MulDiv
-		-1,  // Pot
-		-1,  // Factor
-	},
-	gotoRow{ // S158
+	gotoRow{ // S142
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -5555,6 +6557,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -5566,6 +6569,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -5575,42 +6588,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S159
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		208, // Number
-		207, // MathExpr
-		214, // AddSub
-		215, // MulDiv
-		216, // Pot
-		217, // Factor
-	},
-	gotoRow{ // S160
+	gotoRow{ // S143
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -5625,6 +6603,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -5636,6 +6615,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -5645,7 +6634,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S161
+	gotoRow{ // S144
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -5660,6 +6649,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -5671,6 +6661,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -5680,7 +6680,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S162
+	gotoRow{ // S145
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -5695,6 +6695,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -5706,6 +6707,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -5715,7 +6726,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S163
+	gotoRow{ // S146
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -5730,6 +6741,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -5741,6 +6753,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -5750,7 +6772,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S164
+	gotoRow{ // S147
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -5765,6 +6787,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -5776,6 +6799,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -5785,7 +6818,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S165
+	gotoRow{ // S148
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -5800,6 +6833,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -5811,6 +6845,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -5820,10 +6864,102 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S166
-		-1, // S'
-		-1, // Package
-		-1, // PackageName
+	gotoRow{ // S149
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		139, // Number
+		-1,  // MathExpr
+		226, // AddSub
+		146, // MulDiv
+		147, // Pot
+		148, // Factor
+	},
+	gotoRow{ // S150
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		139, // Number
+		-1,  // MathExpr
+		227, // AddSub
+		146, // MulDiv
+		147, // Pot
+		148, // Factor
+	},
+	gotoRow{ // S151
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
 		-1, // PackageBody
 		-1, // PackageElement
 		-1, // Import
@@ -5835,6 +6971,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -5846,6 +6983,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -5855,7 +7002,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S167
+	gotoRow{ // S152
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -5870,6 +7017,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -5881,6 +7029,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -5890,7 +7048,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S168
+	gotoRow{ // S153
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -5905,6 +7063,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -5916,6 +7075,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -5925,7 +7094,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S169
+	gotoRow{ // S154
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -5940,6 +7109,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -5951,6 +7121,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -5960,7 +7140,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S170
+	gotoRow{ // S155
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -5975,6 +7155,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -5986,6 +7167,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -5995,7 +7186,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S171
+	gotoRow{ // S156
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6010,6 +7201,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6021,6 +7213,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6030,7 +7232,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S172
+	gotoRow{ // S157
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6045,6 +7247,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6056,6 +7259,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6065,7 +7278,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S173
+	gotoRow{ // S158
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6080,6 +7293,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6091,6 +7305,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6100,77 +7324,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S174
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		93,  // Number
-		-1,  // MathExpr
-		230, // AddSub
-		100, // MulDiv
-		101, // Pot
-		102, // Factor
-	},
-	gotoRow{ // S175
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		93,  // Number
-		-1,  // MathExpr
-		231, // AddSub
-		100, // MulDiv
-		101, // Pot
-		102, // Factor
-	},
-	gotoRow{ // S176
+	gotoRow{ // S159
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6185,6 +7339,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6196,6 +7351,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6205,7 +7370,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S177
+	gotoRow{ // S160
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6220,6 +7385,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6231,6 +7397,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6240,7 +7416,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S178
+	gotoRow{ // S161
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6255,6 +7431,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6266,6 +7443,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6275,7 +7462,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S179
+	gotoRow{ // S162
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6290,6 +7477,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6301,6 +7489,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6310,7 +7508,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S180
+	gotoRow{ // S163
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6325,6 +7523,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6336,6 +7535,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6345,7 +7554,99 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S181
+	gotoRow{ // S164
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		139, // Number
+		-1,  // MathExpr
+		236, // AddSub
+		146, // MulDiv
+		147, // Pot
+		148, // Factor
+	},
+	gotoRow{ // S165
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		139, // Number
+		-1,  // MathExpr
+		237, // AddSub
+		146, // MulDiv
+		147, // Pot
+		148, // Factor
+	},
+	gotoRow{ // S166
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6360,6 +7661,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6371,6 +7673,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6380,7 +7692,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S182
+	gotoRow{ // S167
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6395,6 +7707,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6406,6 +7719,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6415,7 +7738,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S183
+	gotoRow{ // S168
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6430,6 +7753,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6441,6 +7765,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6450,7 +7784,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S184
+	gotoRow{ // S169
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6465,6 +7799,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6476,6 +7811,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6485,7 +7830,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S185
+	gotoRow{ // S170
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6500,6 +7845,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6511,6 +7857,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6520,7 +7876,53 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S186
+	gotoRow{ // S171
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		244, // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
+	},
+	gotoRow{ // S172
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6535,6 +7937,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6546,6 +7949,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6555,7 +7968,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S187
+	gotoRow{ // S173
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6570,6 +7983,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6581,6 +7995,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6590,7 +8014,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S188
+	gotoRow{ // S174
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6605,6 +8029,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6616,6 +8041,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6625,7 +8060,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S189
+	gotoRow{ // S175
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6640,6 +8075,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6651,6 +8087,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6660,7 +8106,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S190
+	gotoRow{ // S176
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6675,6 +8121,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6686,6 +8133,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6695,7 +8152,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S191
+	gotoRow{ // S177
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6710,6 +8167,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6721,6 +8179,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6730,7 +8198,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S192
+	gotoRow{ // S178
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6745,6 +8213,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6756,6 +8225,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6765,42 +8244,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S193
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		236, // VarDecl
-		237, // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		234, // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		-1,  // Number
-		-1,  // MathExpr
-		-1,  // AddSub
-		-1,  // MulDiv
-		-1,  // Pot
-		-1,  // Factor
-	},
-	gotoRow{ // S194
+	gotoRow{ // S179
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6815,6 +8259,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6826,6 +8271,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6835,7 +8290,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S195
+	gotoRow{ // S180
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6850,6 +8305,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6861,6 +8317,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6870,7 +8336,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S196
+	gotoRow{ // S181
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6885,6 +8351,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6896,6 +8363,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6905,7 +8382,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S197
+	gotoRow{ // S182
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6920,6 +8397,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -6931,6 +8409,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -6940,42 +8428,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S198
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		236, // VarDecl
-		237, // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		234, // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		-1,  // Number
-		-1,  // MathExpr
-		-1,  // AddSub
-		-1,  // MulDiv
-		-1,  // Pot
-		-1,  // Factor
-	},
-	gotoRow{ // S199
+	gotoRow{ // S183
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -6990,6 +8443,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -7001,6 +8455,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -7010,7 +8474,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S200
+	gotoRow{ // S184
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -7025,6 +8489,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -7036,6 +8501,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -7045,7 +8520,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S201
+	gotoRow{ // S185
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -7060,6 +8535,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -7071,6 +8547,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -7080,42 +8566,53 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S202
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		73,  // Number
-		243, // MathExpr
-		79,  // AddSub
-		80,  // MulDiv
-		81,  // Pot
-		82,  // Factor
+	gotoRow{ // S186
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
 	},
-	gotoRow{ // S203
+	gotoRow{ // S187
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -7130,6 +8627,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -7141,6 +8639,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -7150,42 +8658,53 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S204
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		73,  // Number
-		244, // MathExpr
-		79,  // AddSub
-		80,  // MulDiv
-		81,  // Pot
-		82,  // Factor
+	gotoRow{ // S188
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
 	},
-	gotoRow{ // S205
+	gotoRow{ // S189
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -7200,6 +8719,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -7211,6 +8731,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -7220,7 +8750,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S206
+	gotoRow{ // S190
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -7235,6 +8765,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -7246,6 +8777,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -7255,7 +8796,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S207
+	gotoRow{ // S191
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -7270,6 +8811,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -7281,6 +8823,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -7290,7 +8842,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S208
+	gotoRow{ // S192
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -7305,6 +8857,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -7316,6 +8869,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -7325,7 +8888,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S209
+	gotoRow{ // S193
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -7340,6 +8903,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -7351,6 +8915,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -7360,7 +8934,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S210
+	gotoRow{ // S194
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -7375,6 +8949,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -7386,6 +8961,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -7395,7 +8980,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S211
+	gotoRow{ // S195
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -7410,6 +8995,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -7421,6 +9007,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -7430,7 +9026,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S212
+	gotoRow{ // S196
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -7445,6 +9041,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -7456,6 +9053,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -7465,7 +9072,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S213
+	gotoRow{ // S197
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -7480,6 +9087,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -7491,6 +9099,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -7500,7 +9118,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S214
+	gotoRow{ // S198
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -7515,6 +9133,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -7526,6 +9145,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -7535,7 +9164,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S215
+	gotoRow{ // S199
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -7550,6 +9179,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -7561,6 +9191,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -7570,7 +9210,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S216
+	gotoRow{ // S200
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -7585,6 +9225,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -7596,6 +9237,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -7605,7 +9256,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S217
+	gotoRow{ // S201
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -7620,6 +9271,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -7631,6 +9283,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -7640,147 +9302,145 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S218
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		93,  // Number
-		-1,  // MathExpr
-		251, // AddSub
-		100, // MulDiv
-		101, // Pot
-		102, // Factor
-	},
-	gotoRow{ // S219
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		93,  // Number
-		-1,  // MathExpr
-		252, // AddSub
-		100, // MulDiv
-		101, // Pot
-		102, // Factor
+	gotoRow{ // S202
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
 	},
-	gotoRow{ // S220
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		164, // Number
-		253, // MathExpr
-		170, // AddSub
-		171, // MulDiv
-		172, // Pot
-		173, // Factor
+	gotoRow{ // S203
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
 	},
-	gotoRow{ // S221
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		164, // Number
-		254, // MathExpr
-		170, // AddSub
-		171, // MulDiv
-		172, // Pot
-		173, // Factor
+	gotoRow{ // S204
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
 	},
-	gotoRow{ // S222
+	gotoRow{ // S205
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -7795,6 +9455,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -7806,6 +9467,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -7815,7 +9486,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S223
+	gotoRow{ // S206
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -7830,6 +9501,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -7841,6 +9513,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -7850,7 +9532,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S224
+	gotoRow{ // S207
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -7865,6 +9547,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -7876,6 +9559,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -7885,7 +9578,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S225
+	gotoRow{ // S208
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -7900,6 +9593,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -7911,16 +9605,72 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		164, // Number
-		-1,  // MathExpr
-		255, // AddSub
-		256, // MulDiv
-		172, // Pot
-		173, // Factor
+		154, // Number
+		255, // MathExpr
+		160, // AddSub
+		161, // MulDiv
+		162, // Pot
+		163, // Factor
 	},
-	gotoRow{ // S226
+	gotoRow{ // S209
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S210
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -7935,6 +9685,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -7946,23 +9697,6427 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		164, // Number
-		-1,  // MathExpr
-		255, // AddSub
-		257, // MulDiv
-		172, // Pot
-		173, // Factor
+		154, // Number
+		256, // MathExpr
+		160, // AddSub
+		161, // MulDiv
+		162, // Pot
+		163, // Factor
 	},
-	gotoRow{ // S227
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
+	gotoRow{ // S211
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S212
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S213
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S214
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S215
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S216
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S217
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S218
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S219
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S220
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		139, // Number
+		-1,  // MathExpr
+		257, // AddSub
+		258, // MulDiv
+		147, // Pot
+		148, // Factor
+	},
+	gotoRow{ // S221
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		139, // Number
+		-1,  // MathExpr
+		257, // AddSub
+		259, // MulDiv
+		147, // Pot
+		148, // Factor
+	},
+	gotoRow{ // S222
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S223
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		139, // Number
+		-1,  // MathExpr
+		257, // AddSub
+		146, // MulDiv
+		260, // Pot
+		148, // Factor
+	},
+	gotoRow{ // S224
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		139, // Number
+		-1,  // MathExpr
+		257, // AddSub
+		146, // MulDiv
+		261, // Pot
+		148, // Factor
+	},
+	gotoRow{ // S225
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		139, // Number
+		-1,  // MathExpr
+		257, // AddSub
+		146, // MulDiv
+		147, // Pot
+		262, // Factor
+	},
+	gotoRow{ // S226
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S227
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S228
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S229
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		267, // Number
+		266, // MathExpr
+		273, // AddSub
+		274, // MulDiv
+		275, // Pot
+		276, // Factor
+	},
+	gotoRow{ // S230
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S231
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		154, // Number
+		-1,  // MathExpr
+		279, // AddSub
+		280, // MulDiv
+		162, // Pot
+		163, // Factor
+	},
+	gotoRow{ // S232
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		154, // Number
+		-1,  // MathExpr
+		279, // AddSub
+		281, // MulDiv
+		162, // Pot
+		163, // Factor
+	},
+	gotoRow{ // S233
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		154, // Number
+		-1,  // MathExpr
+		279, // AddSub
+		161, // MulDiv
+		282, // Pot
+		163, // Factor
+	},
+	gotoRow{ // S234
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		154, // Number
+		-1,  // MathExpr
+		279, // AddSub
+		161, // MulDiv
+		283, // Pot
+		163, // Factor
+	},
+	gotoRow{ // S235
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		154, // Number
+		-1,  // MathExpr
+		279, // AddSub
+		161, // MulDiv
+		162, // Pot
+		284, // Factor
+	},
+	gotoRow{ // S236
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S237
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S238
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		267, // Number
+		287, // MathExpr
+		273, // AddSub
+		274, // MulDiv
+		275, // Pot
+		276, // Factor
+	},
+	gotoRow{ // S239
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		288, // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
+	},
+	gotoRow{ // S240
+		-1,  // S'
+		-1,  // Package
+		289, // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		292, // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
+	},
+	gotoRow{ // S241
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		293, // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
+	},
+	gotoRow{ // S242
+		-1,  // S'
+		-1,  // Package
+		289, // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		294, // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
+	},
+	gotoRow{ // S243
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		295, // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
+	},
+	gotoRow{ // S244
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		69, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		70, // Attribute
+		71, // RangeAttribute
+		72, // ExportAsAttribute
+		73, // PrecisionAttribute
+		74, // EncodingAttribute
+		75, // MaxLengthAttribute
+		76, // CharsetAttribute
+		77, // MaxCountAttribute
+		78, // DeltaAttribute
+		79, // QuaternionAttribute
+		80, // NormalizedAttribute
+		81, // AlignmentAttribute
+		82, // EntropyAttribute
+		83, // IdAttribute
+		84, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S245
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S246
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		300, // Number
+		299, // MathExpr
+		306, // AddSub
+		307, // MulDiv
+		308, // Pot
+		309, // Factor
+	},
+	gotoRow{ // S247
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S248
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		300, // Number
+		313, // MathExpr
+		306, // AddSub
+		307, // MulDiv
+		308, // Pot
+		309, // Factor
+	},
+	gotoRow{ // S249
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		300, // Number
+		314, // MathExpr
+		306, // AddSub
+		307, // MulDiv
+		308, // Pot
+		309, // Factor
+	},
+	gotoRow{ // S250
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S251
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		300, // Number
+		316, // MathExpr
+		306, // AddSub
+		307, // MulDiv
+		308, // Pot
+		309, // Factor
+	},
+	gotoRow{ // S252
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		318, // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
+	},
+	gotoRow{ // S253
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S254
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		300, // Number
+		321, // MathExpr
+		306, // AddSub
+		307, // MulDiv
+		308, // Pot
+		309, // Factor
+	},
+	gotoRow{ // S255
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S256
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S257
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S258
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S259
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S260
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S261
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S262
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S263
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S264
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S265
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S266
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S267
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S268
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S269
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S270
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S271
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S272
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S273
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S274
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S275
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S276
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S277
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		139, // Number
+		-1,  // MathExpr
+		332, // AddSub
+		146, // MulDiv
+		147, // Pot
+		148, // Factor
+	},
+	gotoRow{ // S278
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		139, // Number
+		-1,  // MathExpr
+		333, // AddSub
+		146, // MulDiv
+		147, // Pot
+		148, // Factor
+	},
+	gotoRow{ // S279
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S280
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S281
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S282
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S283
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S284
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S285
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S286
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S287
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S288
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		338, // VarDecl
+		339, // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		336, // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
+	},
+	gotoRow{ // S289
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S290
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S291
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S292
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S293
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		338, // VarDecl
+		339, // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		336, // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
+	},
+	gotoRow{ // S294
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S295
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S296
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S297
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S298
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S299
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S300
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S301
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S302
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S303
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S304
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S305
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S306
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S307
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S308
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S309
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S310
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		139, // Number
+		-1,  // MathExpr
+		351, // AddSub
+		146, // MulDiv
+		147, // Pot
+		148, // Factor
+	},
+	gotoRow{ // S311
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		139, // Number
+		-1,  // MathExpr
+		352, // AddSub
+		146, // MulDiv
+		147, // Pot
+		148, // Factor
+	},
+	gotoRow{ // S312
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S313
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S314
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S315
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S316
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S317
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		154, // Number
+		353, // MathExpr
+		160, // AddSub
+		161, // MulDiv
+		162, // Pot
+		163, // Factor
+	},
+	gotoRow{ // S318
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S319
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		154, // Number
+		354, // MathExpr
+		160, // AddSub
+		161, // MulDiv
+		162, // Pot
+		163, // Factor
+	},
+	gotoRow{ // S320
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S321
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S322
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		267, // Number
+		355, // MathExpr
+		273, // AddSub
+		274, // MulDiv
+		275, // Pot
+		276, // Factor
+	},
+	gotoRow{ // S323
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		267, // Number
+		356, // MathExpr
+		273, // AddSub
+		274, // MulDiv
+		275, // Pot
+		276, // Factor
+	},
+	gotoRow{ // S324
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S325
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S326
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S327
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		267, // Number
+		-1,  // MathExpr
+		357, // AddSub
+		358, // MulDiv
+		275, // Pot
+		276, // Factor
+	},
+	gotoRow{ // S328
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		267, // Number
+		-1,  // MathExpr
+		357, // AddSub
+		359, // MulDiv
+		275, // Pot
+		276, // Factor
+	},
+	gotoRow{ // S329
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		267, // Number
+		-1,  // MathExpr
+		357, // AddSub
+		274, // MulDiv
+		360, // Pot
+		276, // Factor
+	},
+	gotoRow{ // S330
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		267, // Number
+		-1,  // MathExpr
+		357, // AddSub
+		274, // MulDiv
+		361, // Pot
+		276, // Factor
+	},
+	gotoRow{ // S331
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		267, // Number
+		-1,  // MathExpr
+		357, // AddSub
+		274, // MulDiv
+		275, // Pot
+		362, // Factor
+	},
+	gotoRow{ // S332
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S333
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S334
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S335
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S336
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		366, // GenericType
+		383, // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		384, // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		386, // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
+	},
+	gotoRow{ // S337
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S338
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S339
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S340
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		388, // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
+	},
+	gotoRow{ // S341
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S342
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		389, // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
+	},
+	gotoRow{ // S343
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S344
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S345
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S346
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		300, // Number
+		-1,  // MathExpr
+		391, // AddSub
+		392, // MulDiv
+		308, // Pot
+		309, // Factor
+	},
+	gotoRow{ // S347
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		300, // Number
+		-1,  // MathExpr
+		391, // AddSub
+		393, // MulDiv
+		308, // Pot
+		309, // Factor
+	},
+	gotoRow{ // S348
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		300, // Number
+		-1,  // MathExpr
+		391, // AddSub
+		307, // MulDiv
+		394, // Pot
+		309, // Factor
+	},
+	gotoRow{ // S349
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		300, // Number
+		-1,  // MathExpr
+		391, // AddSub
+		307, // MulDiv
+		395, // Pot
+		309, // Factor
+	},
+	gotoRow{ // S350
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
 		-1,  // StructDef
 		-1,  // EnumDef
 		-1,  // GenericType
@@ -7970,6 +16125,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -7981,86 +16137,1084 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		164, // Number
+		300, // Number
 		-1,  // MathExpr
-		255, // AddSub
-		171, // MulDiv
-		258, // Pot
-		173, // Factor
+		391, // AddSub
+		307, // MulDiv
+		308, // Pot
+		396, // Factor
+	},
+	gotoRow{ // S351
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S352
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S353
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S354
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S355
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S356
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S357
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S358
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S359
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S360
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S361
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S362
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S363
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S364
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S365
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S366
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S367
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
 	},
-	gotoRow{ // S228
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		164, // Number
-		-1,  // MathExpr
-		255, // AddSub
-		171, // MulDiv
-		259, // Pot
-		173, // Factor
+	gotoRow{ // S368
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
 	},
-	gotoRow{ // S229
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		164, // Number
-		-1,  // MathExpr
-		255, // AddSub
-		171, // MulDiv
-		172, // Pot
-		260, // Factor
+	gotoRow{ // S369
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S370
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S371
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S372
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S373
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
 	},
-	gotoRow{ // S230
+	gotoRow{ // S374
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -8075,6 +17229,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -8086,6 +17241,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -8095,7 +17260,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S231
+	gotoRow{ // S375
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -8110,6 +17275,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -8121,6 +17287,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -8130,7 +17306,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S232
+	gotoRow{ // S376
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -8145,6 +17321,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -8156,6 +17333,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -8165,7 +17352,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S233
+	gotoRow{ // S377
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -8180,6 +17367,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -8191,6 +17379,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -8200,42 +17398,53 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S234
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		264, // GenericType
-		281, // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		282, // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		284, // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		-1,  // Number
-		-1,  // MathExpr
-		-1,  // AddSub
-		-1,  // MulDiv
-		-1,  // Pot
-		-1,  // Factor
+	gotoRow{ // S378
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
 	},
-	gotoRow{ // S235
+	gotoRow{ // S379
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -8250,6 +17459,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -8261,6 +17471,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -8270,7 +17490,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S236
+	gotoRow{ // S380
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -8285,6 +17505,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -8296,6 +17517,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -8305,7 +17536,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S237
+	gotoRow{ // S381
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -8320,6 +17551,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -8331,6 +17563,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -8340,7 +17582,53 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S238
+	gotoRow{ // S382
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S383
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -8355,7 +17643,8 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
-		286, // StructBody
+		408, // VariableName
+		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
 		-1,  // AttributeGroupBody
@@ -8366,6 +17655,16 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
 		-1,  // Number
@@ -8375,7 +17674,99 @@ var gotoTab = gotoTable{
 		-1,  // Pot
 		-1,  // Factor
 	},
-	gotoRow{ // S239
+	gotoRow{ // S384
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S385
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		430, // Attribute
+		431, // RangeAttribute
+		432, // ExportAsAttribute
+		433, // PrecisionAttribute
+		434, // EncodingAttribute
+		435, // MaxLengthAttribute
+		436, // CharsetAttribute
+		437, // MaxCountAttribute
+		438, // DeltaAttribute
+		439, // QuaternionAttribute
+		440, // NormalizedAttribute
+		441, // AlignmentAttribute
+		442, // EntropyAttribute
+		443, // IdAttribute
+		444, // MessageAttribute
+		-1,  // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
+	},
+	gotoRow{ // S386
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -8390,6 +17781,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -8401,6 +17793,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -8410,7 +17812,99 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S240
+	gotoRow{ // S387
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		449, // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
+	},
+	gotoRow{ // S388
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		338, // VarDecl
+		339, // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		336, // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
+	},
+	gotoRow{ // S389
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -8423,19 +17917,30 @@ var gotoTab = gotoTable{
 		-1,  // GenericType
 		-1,  // Type
 		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		287, // StructBody
+		338, // VarDecl
+		339, // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
 		-1,  // AttributeGroupBody
 		-1,  // AttributeGroupElement
 		-1,  // SingleAttribute
-		-1,  // Attributes
+		336, // Attributes
 		-1,  // Attribute
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
 		-1,  // Number
@@ -8445,7 +17950,7 @@ var gotoTab = gotoTable{
 		-1,  // Pot
 		-1,  // Factor
 	},
-	gotoRow{ // S241
+	gotoRow{ // S390
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -8460,6 +17965,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -8471,6 +17977,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -8480,7 +17996,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S242
+	gotoRow{ // S391
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -8495,6 +18011,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -8506,6 +18023,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -8515,7 +18042,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S243
+	gotoRow{ // S392
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -8530,6 +18057,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -8541,6 +18069,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -8550,7 +18088,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S244
+	gotoRow{ // S393
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -8565,6 +18103,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -8576,6 +18115,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -8585,7 +18134,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S245
+	gotoRow{ // S394
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -8600,6 +18149,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -8611,6 +18161,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -8620,182 +18180,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S246
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		208, // Number
-		-1,  // MathExpr
-		291, // AddSub
-		292, // MulDiv
-		216, // Pot
-		217, // Factor
-	},
-	gotoRow{ // S247
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		208, // Number
-		-1,  // MathExpr
-		291, // AddSub
-		293, // MulDiv
-		216, // Pot
-		217, // Factor
-	},
-	gotoRow{ // S248
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		208, // Number
-		-1,  // MathExpr
-		291, // AddSub
-		215, // MulDiv
-		294, // Pot
-		217, // Factor
-	},
-	gotoRow{ // S249
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		208, // Number
-		-1,  // MathExpr
-		291, // AddSub
-		215, // MulDiv
-		295, // Pot
-		217, // Factor
-	},
-	gotoRow{ // S250
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		208, // Number
-		-1,  // MathExpr
-		291, // AddSub
-		215, // MulDiv
-		216, // Pot
-		296, // Factor
-	},
-	gotoRow{ // S251
+	gotoRow{ // S395
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -8810,6 +18195,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -8821,6 +18207,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -8830,7 +18226,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S252
+	gotoRow{ // S396
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -8845,6 +18241,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -8856,6 +18253,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -8865,7 +18272,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S253
+	gotoRow{ // S397
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -8880,6 +18287,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -8891,6 +18299,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -8900,7 +18318,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S254
+	gotoRow{ // S398
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -8915,6 +18333,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -8926,6 +18345,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -8935,42 +18364,99 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S255
-		-1, // S'
-		-1, // Package
-		-1, // PackageName
-		-1, // PackageBody
-		-1, // PackageElement
-		-1, // Import
-		-1, // ClassDef
-		-1, // StructDef
-		-1, // EnumDef
-		-1, // GenericType
-		-1, // Type
-		-1, // TypeName
-		-1, // VarDecl
-		-1, // MultiVarDecl
-		-1, // StructBody
-		-1, // EnumBody
-		-1, // AttributeGroup
-		-1, // AttributeGroupBody
-		-1, // AttributeGroupElement
-		-1, // SingleAttribute
-		-1, // Attributes
-		-1, // Attribute
-		-1, // RangeAttribute
-		-1, // ExportAsAttribute
-		-1, // PrecisionAttribute
-		-1, // MessageAttribute
-		-1, // Range
-		-1, // Number
-		-1, // MathExpr
-		-1, // AddSub
-		-1, // MulDiv
-		-1, // Pot
-		-1, // Factor
+	gotoRow{ // S399
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		267, // Number
+		452, // MathExpr
+		273, // AddSub
+		274, // MulDiv
+		275, // Pot
+		276, // Factor
+	},
+	gotoRow{ // S400
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		267, // Number
+		453, // MathExpr
+		273, // AddSub
+		274, // MulDiv
+		275, // Pot
+		276, // Factor
 	},
-	gotoRow{ // S256
+	gotoRow{ // S401
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -8985,6 +18471,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -8996,6 +18483,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9005,7 +18502,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S257
+	gotoRow{ // S402
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9020,6 +18517,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9031,6 +18529,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9040,7 +18548,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S258
+	gotoRow{ // S403
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9055,6 +18563,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9066,6 +18575,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9075,7 +18594,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S259
+	gotoRow{ // S404
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9090,6 +18609,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9101,6 +18621,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9110,7 +18640,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S260
+	gotoRow{ // S405
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9125,6 +18655,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9136,6 +18667,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9145,7 +18686,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S261
+	gotoRow{ // S406
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9160,6 +18701,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9171,6 +18713,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9180,7 +18732,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S262
+	gotoRow{ // S407
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9195,6 +18747,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9206,6 +18759,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9215,7 +18778,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S263
+	gotoRow{ // S408
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9230,6 +18793,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9241,6 +18805,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9250,7 +18824,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S264
+	gotoRow{ // S409
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9265,6 +18839,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9276,6 +18851,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9285,7 +18870,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S265
+	gotoRow{ // S410
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9300,6 +18885,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9311,6 +18897,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9320,7 +18916,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S266
+	gotoRow{ // S411
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9335,6 +18931,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9346,6 +18943,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9355,7 +18962,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S267
+	gotoRow{ // S412
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9370,6 +18977,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9381,6 +18989,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9390,7 +19008,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S268
+	gotoRow{ // S413
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9405,6 +19023,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9416,6 +19035,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9425,7 +19054,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S269
+	gotoRow{ // S414
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9440,6 +19069,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9451,6 +19081,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9460,7 +19100,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S270
+	gotoRow{ // S415
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9475,6 +19115,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9486,6 +19127,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9495,7 +19146,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S271
+	gotoRow{ // S416
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9510,6 +19161,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9521,6 +19173,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9530,7 +19192,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S272
+	gotoRow{ // S417
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9545,6 +19207,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9556,6 +19219,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9565,7 +19238,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S273
+	gotoRow{ // S418
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9580,6 +19253,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9591,6 +19265,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9600,42 +19284,53 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S274
-		-1, // S'
-		-1, // Package
-		-1, // PackageName
-		-1, // PackageBody
-		-1, // PackageElement
-		-1, // Import
-		-1, // ClassDef
-		-1, // StructDef
-		-1, // EnumDef
-		-1, // GenericType
-		-1, // Type
-		-1, // TypeName
-		-1, // VarDecl
-		-1, // MultiVarDecl
-		-1, // StructBody
-		-1, // EnumBody
-		-1, // AttributeGroup
-		-1, // AttributeGroupBody
-		-1, // AttributeGroupElement
-		-1, // SingleAttribute
-		-1, // Attributes
-		-1, // Attribute
-		-1, // RangeAttribute
-		-1, // ExportAsAttribute
-		-1, // PrecisionAttribute
-		-1, // MessageAttribute
-		-1, // Range
-		-1, // Number
-		-1, // MathExpr
-		-1, // AddSub
-		-1, // MulDiv
-		-1, // Pot
-		-1, // Factor
+	gotoRow{ // S419
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		456, // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
 	},
-	gotoRow{ // S275
+	gotoRow{ // S420
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9650,6 +19345,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9661,6 +19357,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9670,7 +19376,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S276
+	gotoRow{ // S421
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9685,6 +19391,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9696,6 +19403,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9705,7 +19422,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S277
+	gotoRow{ // S422
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9720,6 +19437,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9731,6 +19449,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9740,7 +19468,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S278
+	gotoRow{ // S423
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9755,6 +19483,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9766,6 +19495,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9775,7 +19514,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S279
+	gotoRow{ // S424
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9790,6 +19529,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9801,6 +19541,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9810,7 +19560,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S280
+	gotoRow{ // S425
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9825,6 +19575,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9836,6 +19587,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9845,7 +19606,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S281
+	gotoRow{ // S426
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9860,6 +19621,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9871,6 +19633,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9880,7 +19652,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S282
+	gotoRow{ // S427
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9895,6 +19667,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9906,6 +19679,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9915,42 +19698,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S283
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		307, // Attribute
-		308, // RangeAttribute
-		309, // ExportAsAttribute
-		310, // PrecisionAttribute
-		311, // MessageAttribute
-		-1,  // Range
-		-1,  // Number
-		-1,  // MathExpr
-		-1,  // AddSub
-		-1,  // MulDiv
-		-1,  // Pot
-		-1,  // Factor
-	},
-	gotoRow{ // S284
+	gotoRow{ // S428
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -9965,6 +19713,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -9976,6 +19725,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -9985,7 +19744,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S285
+	gotoRow{ // S429
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10000,6 +19759,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10011,6 +19771,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10020,77 +19790,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S286
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		236, // VarDecl
-		237, // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		234, // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		-1,  // Number
-		-1,  // MathExpr
-		-1,  // AddSub
-		-1,  // MulDiv
-		-1,  // Pot
-		-1,  // Factor
-	},
-	gotoRow{ // S287
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		236, // VarDecl
-		237, // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		234, // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		-1,  // Number
-		-1,  // MathExpr
-		-1,  // AddSub
-		-1,  // MulDiv
-		-1,  // Pot
-		-1,  // Factor
-	},
-	gotoRow{ // S288
+	gotoRow{ // S430
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10105,6 +19805,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10116,6 +19817,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10125,77 +19836,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S289
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		164, // Number
-		319, // MathExpr
-		170, // AddSub
-		171, // MulDiv
-		172, // Pot
-		173, // Factor
-	},
-	gotoRow{ // S290
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		164, // Number
-		320, // MathExpr
-		170, // AddSub
-		171, // MulDiv
-		172, // Pot
-		173, // Factor
-	},
-	gotoRow{ // S291
+	gotoRow{ // S431
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10210,6 +19851,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10221,6 +19863,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10230,7 +19882,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S292
+	gotoRow{ // S432
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10245,6 +19897,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10256,6 +19909,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10265,7 +19928,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S293
+	gotoRow{ // S433
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10280,6 +19943,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10291,6 +19955,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10300,7 +19974,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S294
+	gotoRow{ // S434
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10315,6 +19989,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10326,6 +20001,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10335,7 +20020,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S295
+	gotoRow{ // S435
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10350,6 +20035,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10361,6 +20047,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10370,7 +20066,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S296
+	gotoRow{ // S436
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10385,6 +20081,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10396,6 +20093,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10405,7 +20112,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S297
+	gotoRow{ // S437
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10420,6 +20127,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10431,6 +20139,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10440,7 +20158,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S298
+	gotoRow{ // S438
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10455,6 +20173,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10466,6 +20185,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10475,7 +20204,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S299
+	gotoRow{ // S439
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10490,6 +20219,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10501,6 +20231,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10510,7 +20250,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S300
+	gotoRow{ // S440
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10525,6 +20265,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10536,6 +20277,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10545,7 +20296,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S301
+	gotoRow{ // S441
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10560,6 +20311,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10571,6 +20323,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10580,7 +20342,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S302
+	gotoRow{ // S442
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10595,6 +20357,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10606,6 +20369,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10615,7 +20388,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S303
+	gotoRow{ // S443
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10630,6 +20403,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10641,6 +20415,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10650,7 +20434,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S304
+	gotoRow{ // S444
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10665,6 +20449,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10676,6 +20461,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10685,7 +20480,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S305
+	gotoRow{ // S445
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10700,6 +20495,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10711,6 +20507,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10720,42 +20526,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S306
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		323, // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		-1,  // Number
-		-1,  // MathExpr
-		-1,  // AddSub
-		-1,  // MulDiv
-		-1,  // Pot
-		-1,  // Factor
-	},
-	gotoRow{ // S307
+	gotoRow{ // S446
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10770,6 +20541,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10781,6 +20553,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10790,7 +20572,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S308
+	gotoRow{ // S447
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10805,6 +20587,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10816,6 +20599,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10825,7 +20618,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S309
+	gotoRow{ // S448
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10840,6 +20633,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10851,6 +20645,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10860,7 +20664,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S310
+	gotoRow{ // S449
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10875,6 +20679,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10886,6 +20691,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10895,7 +20710,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S311
+	gotoRow{ // S450
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10910,6 +20725,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10921,6 +20737,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10930,7 +20756,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S312
+	gotoRow{ // S451
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10945,6 +20771,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10956,6 +20783,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -10965,7 +20802,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S313
+	gotoRow{ // S452
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -10980,6 +20817,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -10991,6 +20829,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11000,7 +20848,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S314
+	gotoRow{ // S453
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11015,6 +20863,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11026,6 +20875,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11035,7 +20894,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S315
+	gotoRow{ // S454
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11050,6 +20909,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11061,6 +20921,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11070,7 +20940,99 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S316
+	gotoRow{ // S455
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		472, // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
+	},
+	gotoRow{ // S456
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		69, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		70, // Attribute
+		71, // RangeAttribute
+		72, // ExportAsAttribute
+		73, // PrecisionAttribute
+		74, // EncodingAttribute
+		75, // MaxLengthAttribute
+		76, // CharsetAttribute
+		77, // MaxCountAttribute
+		78, // DeltaAttribute
+		79, // QuaternionAttribute
+		80, // NormalizedAttribute
+		81, // AlignmentAttribute
+		82, // EntropyAttribute
+		83, // IdAttribute
+		84, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S457
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11085,6 +21047,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11096,6 +21059,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11105,7 +21078,53 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S317
+	gotoRow{ // S458
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		477, // Number
+		476, // MathExpr
+		483, // AddSub
+		484, // MulDiv
+		485, // Pot
+		486, // Factor
+	},
+	gotoRow{ // S459
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11120,6 +21139,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11131,6 +21151,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11140,7 +21170,99 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S318
+	gotoRow{ // S460
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		477, // Number
+		490, // MathExpr
+		483, // AddSub
+		484, // MulDiv
+		485, // Pot
+		486, // Factor
+	},
+	gotoRow{ // S461
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		477, // Number
+		491, // MathExpr
+		483, // AddSub
+		484, // MulDiv
+		485, // Pot
+		486, // Factor
+	},
+	gotoRow{ // S462
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11155,6 +21277,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11166,6 +21289,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11175,7 +21308,99 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S319
+	gotoRow{ // S463
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		477, // Number
+		493, // MathExpr
+		483, // AddSub
+		484, // MulDiv
+		485, // Pot
+		486, // Factor
+	},
+	gotoRow{ // S464
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		495, // Range
+		-1,  // Number
+		-1,  // MathExpr
+		-1,  // AddSub
+		-1,  // MulDiv
+		-1,  // Pot
+		-1,  // Factor
+	},
+	gotoRow{ // S465
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11190,6 +21415,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11201,6 +21427,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11210,7 +21446,53 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S320
+	gotoRow{ // S466
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		477, // Number
+		498, // MathExpr
+		483, // AddSub
+		484, // MulDiv
+		485, // Pot
+		486, // Factor
+	},
+	gotoRow{ // S467
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11225,6 +21507,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11236,6 +21519,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11245,7 +21538,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S321
+	gotoRow{ // S468
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11260,6 +21553,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11271,6 +21565,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11280,7 +21584,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S322
+	gotoRow{ // S469
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11295,6 +21599,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11306,6 +21611,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11315,77 +21630,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S323
-		-1, // S'
-		-1, // Package
-		-1, // PackageName
-		-1, // PackageBody
-		-1, // PackageElement
-		-1, // Import
-		-1, // ClassDef
-		-1, // StructDef
-		-1, // EnumDef
-		-1, // GenericType
-		-1, // Type
-		-1, // TypeName
-		-1, // VarDecl
-		-1, // MultiVarDecl
-		-1, // StructBody
-		-1, // EnumBody
-		-1, // AttributeGroup
-		-1, // AttributeGroupBody
-		32, // AttributeGroupElement
-		-1, // SingleAttribute
-		-1, // Attributes
-		33, // Attribute
-		34, // RangeAttribute
-		35, // ExportAsAttribute
-		36, // PrecisionAttribute
-		37, // MessageAttribute
-		-1, // Range
-		-1, // Number
-		-1, // MathExpr
-		-1, // AddSub
-		-1, // MulDiv
-		-1, // Pot
-		-1, // Factor
-	},
-	gotoRow{ // S324
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		335, // Range
-		-1,  // Number
-		-1,  // MathExpr
-		-1,  // AddSub
-		-1,  // MulDiv
-		-1,  // Pot
-		-1,  // Factor
-	},
-	gotoRow{ // S325
+	gotoRow{ // S470
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11400,6 +21645,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11411,6 +21657,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11420,42 +21676,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S326
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		340, // Number
-		339, // MathExpr
-		346, // AddSub
-		347, // MulDiv
-		348, // Pot
-		349, // Factor
-	},
-	gotoRow{ // S327
+	gotoRow{ // S471
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11470,6 +21691,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11481,6 +21703,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11490,7 +21722,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S328
+	gotoRow{ // S472
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11505,6 +21737,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11516,6 +21749,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11525,7 +21768,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S329
+	gotoRow{ // S473
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11540,6 +21783,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11551,6 +21795,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11560,7 +21814,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S330
+	gotoRow{ // S474
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11575,6 +21829,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11586,6 +21841,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11595,7 +21860,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S331
+	gotoRow{ // S475
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11610,6 +21875,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11621,6 +21887,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11630,7 +21906,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S332
+	gotoRow{ // S476
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11645,6 +21921,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11656,6 +21933,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11665,7 +21952,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S333
+	gotoRow{ // S477
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11680,6 +21967,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11691,6 +21979,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11700,42 +21998,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S334
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		73,  // Number
-		352, // MathExpr
-		79,  // AddSub
-		80,  // MulDiv
-		81,  // Pot
-		82,  // Factor
-	},
-	gotoRow{ // S335
+	gotoRow{ // S478
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11750,6 +22013,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11761,6 +22025,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11770,42 +22044,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S336
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		73,  // Number
-		353, // MathExpr
-		79,  // AddSub
-		80,  // MulDiv
-		81,  // Pot
-		82,  // Factor
-	},
-	gotoRow{ // S337
+	gotoRow{ // S479
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11820,6 +22059,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11831,6 +22071,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11840,7 +22090,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S338
+	gotoRow{ // S480
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11855,6 +22105,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11866,6 +22117,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11875,7 +22136,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S339
+	gotoRow{ // S481
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11890,6 +22151,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11901,6 +22163,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11910,7 +22182,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S340
+	gotoRow{ // S482
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11925,6 +22197,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11936,6 +22209,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11945,7 +22228,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S341
+	gotoRow{ // S483
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11960,6 +22243,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -11971,6 +22255,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -11980,7 +22274,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S342
+	gotoRow{ // S484
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -11995,6 +22289,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -12006,6 +22301,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -12015,7 +22320,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S343
+	gotoRow{ // S485
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -12030,6 +22335,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -12041,6 +22347,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -12050,7 +22366,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S344
+	gotoRow{ // S486
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -12065,6 +22381,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -12076,6 +22393,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -12085,7 +22412,99 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S345
+	gotoRow{ // S487
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		139, // Number
+		-1,  // MathExpr
+		505, // AddSub
+		146, // MulDiv
+		147, // Pot
+		148, // Factor
+	},
+	gotoRow{ // S488
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		139, // Number
+		-1,  // MathExpr
+		506, // AddSub
+		146, // MulDiv
+		147, // Pot
+		148, // Factor
+	},
+	gotoRow{ // S489
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -12100,6 +22519,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -12111,6 +22531,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -12120,7 +22550,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S346
+	gotoRow{ // S490
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -12135,6 +22565,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -12146,6 +22577,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -12155,7 +22596,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S347
+	gotoRow{ // S491
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -12170,6 +22611,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -12181,6 +22623,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -12190,7 +22642,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S348
+	gotoRow{ // S492
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -12205,6 +22657,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -12216,6 +22669,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -12225,7 +22688,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S349
+	gotoRow{ // S493
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -12240,6 +22703,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -12251,6 +22715,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -12260,7 +22734,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S350
+	gotoRow{ // S494
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -12275,6 +22749,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -12286,16 +22761,72 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		93,  // Number
-		-1,  // MathExpr
-		360, // AddSub
-		100, // MulDiv
-		101, // Pot
-		102, // Factor
+		154, // Number
+		507, // MathExpr
+		160, // AddSub
+		161, // MulDiv
+		162, // Pot
+		163, // Factor
 	},
-	gotoRow{ // S351
+	gotoRow{ // S495
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
+	},
+	gotoRow{ // S496
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -12310,6 +22841,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -12321,16 +22853,26 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		93,  // Number
-		-1,  // MathExpr
-		361, // AddSub
-		100, // MulDiv
-		101, // Pot
-		102, // Factor
+		154, // Number
+		508, // MathExpr
+		160, // AddSub
+		161, // MulDiv
+		162, // Pot
+		163, // Factor
 	},
-	gotoRow{ // S352
+	gotoRow{ // S497
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -12345,6 +22887,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -12356,6 +22899,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -12365,7 +22918,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S353
+	gotoRow{ // S498
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -12380,6 +22933,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -12391,6 +22945,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -12400,7 +22964,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S354
+	gotoRow{ // S499
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -12415,6 +22979,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -12426,6 +22991,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -12435,7 +23010,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S355
+	gotoRow{ // S500
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -12450,6 +23025,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -12461,16 +23037,26 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		340, // Number
+		477, // Number
 		-1,  // MathExpr
-		364, // AddSub
-		365, // MulDiv
-		348, // Pot
-		349, // Factor
+		509, // AddSub
+		510, // MulDiv
+		485, // Pot
+		486, // Factor
 	},
-	gotoRow{ // S356
+	gotoRow{ // S501
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -12485,6 +23071,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -12496,16 +23083,26 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		340, // Number
+		477, // Number
 		-1,  // MathExpr
-		364, // AddSub
-		366, // MulDiv
-		348, // Pot
-		349, // Factor
+		509, // AddSub
+		511, // MulDiv
+		485, // Pot
+		486, // Factor
 	},
-	gotoRow{ // S357
+	gotoRow{ // S502
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -12520,6 +23117,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -12531,16 +23129,26 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		340, // Number
+		477, // Number
 		-1,  // MathExpr
-		364, // AddSub
-		347, // MulDiv
-		367, // Pot
-		349, // Factor
+		509, // AddSub
+		484, // MulDiv
+		512, // Pot
+		486, // Factor
 	},
-	gotoRow{ // S358
+	gotoRow{ // S503
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -12555,6 +23163,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -12566,16 +23175,26 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		340, // Number
+		477, // Number
 		-1,  // MathExpr
-		364, // AddSub
-		347, // MulDiv
-		368, // Pot
-		349, // Factor
+		509, // AddSub
+		484, // MulDiv
+		513, // Pot
+		486, // Factor
 	},
-	gotoRow{ // S359
+	gotoRow{ // S504
 		-1,  // S'
 		-1,  // Package
 		-1,  // PackageName
@@ -12590,6 +23209,7 @@ var gotoTab = gotoTable{
 		-1,  // TypeName
 		-1,  // VarDecl
 		-1,  // MultiVarDecl
+		-1,  // VariableName
 		-1,  // StructBody
 		-1,  // EnumBody
 		-1,  // AttributeGroup
@@ -12601,16 +23221,26 @@ var gotoTab = gotoTable{
 		-1,  // RangeAttribute
 		-1,  // ExportAsAttribute
 		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
 		-1,  // MessageAttribute
 		-1,  // Range
-		340, // Number
+		477, // Number
 		-1,  // MathExpr
-		364, // AddSub
-		347, // MulDiv
-		348, // Pot
-		369, // Factor
+		509, // AddSub
+		484, // MulDiv
+		485, // Pot
+		514, // Factor
 	},
-	gotoRow{ // S360
+	gotoRow{ // S505
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -12625,6 +23255,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -12636,6 +23267,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -12645,7 +23286,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S361
+	gotoRow{ // S506
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -12660,6 +23301,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -12671,6 +23313,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -12680,77 +23332,99 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S362
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		164, // Number
-		372, // MathExpr
-		170, // AddSub
-		171, // MulDiv
-		172, // Pot
-		173, // Factor
+	gotoRow{ // S507
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
 	},
-	gotoRow{ // S363
-		-1,  // S'
-		-1,  // Package
-		-1,  // PackageName
-		-1,  // PackageBody
-		-1,  // PackageElement
-		-1,  // Import
-		-1,  // ClassDef
-		-1,  // StructDef
-		-1,  // EnumDef
-		-1,  // GenericType
-		-1,  // Type
-		-1,  // TypeName
-		-1,  // VarDecl
-		-1,  // MultiVarDecl
-		-1,  // StructBody
-		-1,  // EnumBody
-		-1,  // AttributeGroup
-		-1,  // AttributeGroupBody
-		-1,  // AttributeGroupElement
-		-1,  // SingleAttribute
-		-1,  // Attributes
-		-1,  // Attribute
-		-1,  // RangeAttribute
-		-1,  // ExportAsAttribute
-		-1,  // PrecisionAttribute
-		-1,  // MessageAttribute
-		-1,  // Range
-		164, // Number
-		373, // MathExpr
-		170, // AddSub
-		171, // MulDiv
-		172, // Pot
-		173, // Factor
+	gotoRow{ // S508
+		-1, // S'
+		-1, // Package
+		-1, // PackageName
+		-1, // PackageBody
+		-1, // PackageElement
+		-1, // Import
+		-1, // ClassDef
+		-1, // StructDef
+		-1, // EnumDef
+		-1, // GenericType
+		-1, // Type
+		-1, // TypeName
+		-1, // VarDecl
+		-1, // MultiVarDecl
+		-1, // VariableName
+		-1, // StructBody
+		-1, // EnumBody
+		-1, // AttributeGroup
+		-1, // AttributeGroupBody
+		-1, // AttributeGroupElement
+		-1, // SingleAttribute
+		-1, // Attributes
+		-1, // Attribute
+		-1, // RangeAttribute
+		-1, // ExportAsAttribute
+		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
+		-1, // MessageAttribute
+		-1, // Range
+		-1, // Number
+		-1, // MathExpr
+		-1, // AddSub
+		-1, // MulDiv
+		-1, // Pot
+		-1, // Factor
 	},
-	gotoRow{ // S364
+	gotoRow{ // S509
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -12765,6 +23439,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -12776,6 +23451,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -12785,7 +23470,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S365
+	gotoRow{ // S510
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -12800,6 +23485,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -12811,6 +23497,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -12820,7 +23516,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S366
+	gotoRow{ // S511
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -12835,6 +23531,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -12846,6 +23543,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -12855,7 +23562,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S367
+	gotoRow{ // S512
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -12870,6 +23577,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -12881,6 +23589,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -12890,7 +23608,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S368
+	gotoRow{ // S513
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -12905,6 +23623,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -12916,6 +23635,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -12925,7 +23654,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S369
+	gotoRow{ // S514
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -12940,6 +23669,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -12951,6 +23681,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -12960,7 +23700,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S370
+	gotoRow{ // S515
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -12975,6 +23715,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -12986,6 +23727,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -12995,7 +23746,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S371
+	gotoRow{ // S516
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -13010,6 +23761,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -13021,6 +23773,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -13030,7 +23792,99 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S372
+	gotoRow{ // S517
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		267, // Number
+		519, // MathExpr
+		273, // AddSub
+		274, // MulDiv
+		275, // Pot
+		276, // Factor
+	},
+	gotoRow{ // S518
+		-1,  // S'
+		-1,  // Package
+		-1,  // PackageName
+		-1,  // PackageBody
+		-1,  // PackageElement
+		-1,  // Import
+		-1,  // ClassDef
+		-1,  // StructDef
+		-1,  // EnumDef
+		-1,  // GenericType
+		-1,  // Type
+		-1,  // TypeName
+		-1,  // VarDecl
+		-1,  // MultiVarDecl
+		-1,  // VariableName
+		-1,  // StructBody
+		-1,  // EnumBody
+		-1,  // AttributeGroup
+		-1,  // AttributeGroupBody
+		-1,  // AttributeGroupElement
+		-1,  // SingleAttribute
+		-1,  // Attributes
+		-1,  // Attribute
+		-1,  // RangeAttribute
+		-1,  // ExportAsAttribute
+		-1,  // PrecisionAttribute
+		-1,  // EncodingAttribute
+		-1,  // MaxLengthAttribute
+		-1,  // CharsetAttribute
+		-1,  // MaxCountAttribute
+		-1,  // DeltaAttribute
+		-1,  // QuaternionAttribute
+		-1,  // NormalizedAttribute
+		-1,  // AlignmentAttribute
+		-1,  // EntropyAttribute
+		-1,  // IdAttribute
+		-1,  // MessageAttribute
+		-1,  // Range
+		267, // Number
+		520, // MathExpr
+		273, // AddSub
+		274, // MulDiv
+		275, // Pot
+		276, // Factor
+	},
+	gotoRow{ // S519
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -13045,6 +23899,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -13056,6 +23911,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -13065,7 +23930,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S373
+	gotoRow{ // S520
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -13080,6 +23945,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -13091,6 +23957,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -13100,7 +23976,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S374
+	gotoRow{ // S521
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -13115,6 +23991,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -13126,6 +24003,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -13135,7 +24022,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S375
+	gotoRow{ // S522
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -13150,6 +24037,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -13161,6 +24049,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -13170,7 +24068,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S376
+	gotoRow{ // S523
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -13185,6 +24083,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -13196,6 +24095,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
@@ -13205,7 +24114,7 @@ var gotoTab = gotoTable{
 		-1, // Pot
 		-1, // Factor
 	},
-	gotoRow{ // S377
+	gotoRow{ // S524
 		-1, // S'
 		-1, // Package
 		-1, // PackageName
@@ -13220,6 +24129,7 @@ var gotoTab = gotoTable{
 		-1, // TypeName
 		-1, // VarDecl
 		-1, // MultiVarDecl
+		-1, // VariableName
 		-1, // StructBody
 		-1, // EnumBody
 		-1, // AttributeGroup
@@ -13231,6 +24141,16 @@ var gotoTab = gotoTable{
 		-1, // RangeAttribute
 		-1, // ExportAsAttribute
 		-1, // PrecisionAttribute
+		-1, // EncodingAttribute
+		-1, // MaxLengthAttribute
+		-1, // CharsetAttribute
+		-1, // MaxCountAttribute
+		-1, // DeltaAttribute
+		-1, // QuaternionAttribute
+		-1, // NormalizedAttribute
+		-1, // AlignmentAttribute
+		-1, // EntropyAttribute
+		-1, // IdAttribute
 		-1, // MessageAttribute
 		-1, // Range
 		-1, // Number
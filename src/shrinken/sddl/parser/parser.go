@@ -11,9 +11,9 @@ import (
 )
 
 const (
-	numProductions = 85
-	numStates      = 378
-	numSymbols     = 87
+	numProductions = 116
+	numStates      = 525
+	numSymbols     = 108
 )
 
 // Stack
@@ -403,7 +403,7 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `VarDecl : Attributes Type letters	<< ast.NewVariable(X[1], X[2], X[0]), nil >>`,
+		String: `VarDecl : Attributes Type VariableName	<< ast.NewVariable(X[1], X[2], X[0]), nil >>`,
 		Id:         "VarDecl",
 		NTType:     12,
 		Index:      38,
@@ -413,7 +413,7 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `MultiVarDecl : Attributes Type letters "," letters	<< ast.NewMultiVariable(X[1], X[2], X[4], X[0]), nil >>`,
+		String: `MultiVarDecl : Attributes Type VariableName "," VariableName	<< ast.NewMultiVariable(X[1], X[2], X[4], X[0]), nil >>`,
 		Id:         "MultiVarDecl",
 		NTType:     13,
 		Index:      39,
@@ -423,7 +423,7 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `MultiVarDecl : MultiVarDecl "," letters	<< ast.AddToMultiVariable(X[0], X[2]), nil >>`,
+		String: `MultiVarDecl : MultiVarDecl "," VariableName	<< ast.AddToMultiVariable(X[0], X[2]), nil >>`,
 		Id:         "MultiVarDecl",
 		NTType:     13,
 		Index:      40,
@@ -433,10 +433,120 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `StructBody : empty	<< ast.NewStructBody(), nil >>`,
-		Id:         "StructBody",
+		String: `VariableName : letters	<< X[0], nil >>`,
+		Id:         "VariableName",
 		NTType:     14,
 		Index:      41,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `VariableName : "encoding"	<< X[0], nil >>`,
+		Id:         "VariableName",
+		NTType:     14,
+		Index:      42,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `VariableName : "maxLength"	<< X[0], nil >>`,
+		Id:         "VariableName",
+		NTType:     14,
+		Index:      43,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `VariableName : "charset"	<< X[0], nil >>`,
+		Id:         "VariableName",
+		NTType:     14,
+		Index:      44,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `VariableName : "maxCount"	<< X[0], nil >>`,
+		Id:         "VariableName",
+		NTType:     14,
+		Index:      45,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `VariableName : "delta"	<< X[0], nil >>`,
+		Id:         "VariableName",
+		NTType:     14,
+		Index:      46,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `VariableName : "quaternion"	<< X[0], nil >>`,
+		Id:         "VariableName",
+		NTType:     14,
+		Index:      47,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `VariableName : "normalized"	<< X[0], nil >>`,
+		Id:         "VariableName",
+		NTType:     14,
+		Index:      48,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `VariableName : "alignment"	<< X[0], nil >>`,
+		Id:         "VariableName",
+		NTType:     14,
+		Index:      49,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `VariableName : "entropy"	<< X[0], nil >>`,
+		Id:         "VariableName",
+		NTType:     14,
+		Index:      50,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `VariableName : "id"	<< X[0], nil >>`,
+		Id:         "VariableName",
+		NTType:     14,
+		Index:      51,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `StructBody : empty	<< ast.NewStructBody(), nil >>`,
+		Id:         "StructBody",
+		NTType:     15,
+		Index:      52,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewStructBody(), nil
@@ -445,8 +555,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `StructBody : StructBody VarDecl	<< ast.AddToStructBody(X[0], X[1]), nil >>`,
 		Id:         "StructBody",
-		NTType:     14,
-		Index:      42,
+		NTType:     15,
+		Index:      53,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.AddToStructBody(X[0], X[1]), nil
@@ -455,8 +565,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `StructBody : StructBody MultiVarDecl	<< ast.AddMultiVariableToStructBody(X[0], X[1]), nil >>`,
 		Id:         "StructBody",
-		NTType:     14,
-		Index:      43,
+		NTType:     15,
+		Index:      54,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.AddMultiVariableToStructBody(X[0], X[1]), nil
@@ -465,8 +575,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `EnumBody : empty	<< ast.NewEnumBody(), nil >>`,
 		Id:         "EnumBody",
-		NTType:     15,
-		Index:      44,
+		NTType:     16,
+		Index:      55,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewEnumBody(), nil
@@ -475,8 +585,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `EnumBody : EnumBody letters ","	<< ast.AddToEnumBody(X[0], X[1]), nil >>`,
 		Id:         "EnumBody",
-		NTType:     15,
-		Index:      45,
+		NTType:     16,
+		Index:      56,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.AddToEnumBody(X[0], X[1]), nil
@@ -485,8 +595,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `AttributeGroup : "@" "{" AttributeGroupBody "}"	<< ast.NewAttributeGroup(X[2]), nil >>`,
 		Id:         "AttributeGroup",
-		NTType:     16,
-		Index:      46,
+		NTType:     17,
+		Index:      57,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewAttributeGroup(X[2]), nil
@@ -495,8 +605,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `AttributeGroupBody : empty	<< ast.NewAttributeGroupBody(), nil >>`,
 		Id:         "AttributeGroupBody",
-		NTType:     17,
-		Index:      47,
+		NTType:     18,
+		Index:      58,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewAttributeGroupBody(), nil
@@ -505,8 +615,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `AttributeGroupBody : AttributeGroupBody AttributeGroupElement	<< ast.AddToAttributeGroupBody(X[0], X[1]), nil >>`,
 		Id:         "AttributeGroupBody",
-		NTType:     17,
-		Index:      48,
+		NTType:     18,
+		Index:      59,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.AddToAttributeGroupBody(X[0], X[1]), nil
@@ -515,8 +625,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `AttributeGroupElement : Attribute ","	<< X[0], nil >>`,
 		Id:         "AttributeGroupElement",
-		NTType:     18,
-		Index:      49,
+		NTType:     19,
+		Index:      60,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -525,8 +635,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `SingleAttribute : "@" Attribute	<< X[1], nil >>`,
 		Id:         "SingleAttribute",
-		NTType:     19,
-		Index:      50,
+		NTType:     20,
+		Index:      61,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[1], nil
@@ -535,8 +645,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Attributes : empty	<< ast.NewAttributesList(), nil >>`,
 		Id:         "Attributes",
-		NTType:     20,
-		Index:      51,
+		NTType:     21,
+		Index:      62,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewAttributesList(), nil
@@ -545,8 +655,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Attributes : Attributes SingleAttribute	<< ast.AddToAttributesList(X[0], X[1]), nil >>`,
 		Id:         "Attributes",
-		NTType:     20,
-		Index:      52,
+		NTType:     21,
+		Index:      63,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.AddToAttributesList(X[0], X[1]), nil
@@ -555,8 +665,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Attributes : Attributes AttributeGroup	<< ast.AddGroupToAttributesList(X[0], X[1]), nil >>`,
 		Id:         "Attributes",
-		NTType:     20,
-		Index:      53,
+		NTType:     21,
+		Index:      64,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.AddGroupToAttributesList(X[0], X[1]), nil
@@ -565,8 +675,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Attribute : RangeAttribute	<< X[0], nil >>`,
 		Id:         "Attribute",
-		NTType:     21,
-		Index:      54,
+		NTType:     22,
+		Index:      65,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -575,8 +685,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Attribute : ExportAsAttribute	<< X[0], nil >>`,
 		Id:         "Attribute",
-		NTType:     21,
-		Index:      55,
+		NTType:     22,
+		Index:      66,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -585,8 +695,108 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Attribute : PrecisionAttribute	<< X[0], nil >>`,
 		Id:         "Attribute",
-		NTType:     21,
-		Index:      56,
+		NTType:     22,
+		Index:      67,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Attribute : EncodingAttribute	<< X[0], nil >>`,
+		Id:         "Attribute",
+		NTType:     22,
+		Index:      68,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Attribute : MaxLengthAttribute	<< X[0], nil >>`,
+		Id:         "Attribute",
+		NTType:     22,
+		Index:      69,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Attribute : CharsetAttribute	<< X[0], nil >>`,
+		Id:         "Attribute",
+		NTType:     22,
+		Index:      70,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Attribute : MaxCountAttribute	<< X[0], nil >>`,
+		Id:         "Attribute",
+		NTType:     22,
+		Index:      71,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Attribute : DeltaAttribute	<< X[0], nil >>`,
+		Id:         "Attribute",
+		NTType:     22,
+		Index:      72,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Attribute : QuaternionAttribute	<< X[0], nil >>`,
+		Id:         "Attribute",
+		NTType:     22,
+		Index:      73,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Attribute : NormalizedAttribute	<< X[0], nil >>`,
+		Id:         "Attribute",
+		NTType:     22,
+		Index:      74,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Attribute : AlignmentAttribute	<< X[0], nil >>`,
+		Id:         "Attribute",
+		NTType:     22,
+		Index:      75,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Attribute : EntropyAttribute	<< X[0], nil >>`,
+		Id:         "Attribute",
+		NTType:     22,
+		Index:      76,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Attribute : IdAttribute	<< X[0], nil >>`,
+		Id:         "Attribute",
+		NTType:     22,
+		Index:      77,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -595,8 +805,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Attribute : MessageAttribute	<< X[0], nil >>`,
 		Id:         "Attribute",
-		NTType:     21,
-		Index:      57,
+		NTType:     22,
+		Index:      78,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -605,8 +815,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `RangeAttribute : "range" ":" Range	<< attributes.NewRangeAttribute(X[2]), nil >>`,
 		Id:         "RangeAttribute",
-		NTType:     22,
-		Index:      58,
+		NTType:     23,
+		Index:      79,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return attributes.NewRangeAttribute(X[2]), nil
@@ -615,8 +825,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ExportAsAttribute : "exportAs" ":" str	<< attributes.NewExportAsAttribute(X[2]), nil >>`,
 		Id:         "ExportAsAttribute",
-		NTType:     23,
-		Index:      59,
+		NTType:     24,
+		Index:      80,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return attributes.NewExportAsAttribute(X[2]), nil
@@ -625,18 +835,118 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `PrecisionAttribute : "precision" ":" MathExpr	<< attributes.NewPrecisionAttribute(X[2]), nil >>`,
 		Id:         "PrecisionAttribute",
-		NTType:     24,
-		Index:      60,
+		NTType:     25,
+		Index:      81,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return attributes.NewPrecisionAttribute(X[2]), nil
 		},
 	},
+	ProdTabEntry{
+		String: `EncodingAttribute : "encoding" ":" str	<< attributes.NewEncodingAttribute(X[2]), nil >>`,
+		Id:         "EncodingAttribute",
+		NTType:     26,
+		Index:      82,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return attributes.NewEncodingAttribute(X[2]), nil
+		},
+	},
+	ProdTabEntry{
+		String: `MaxLengthAttribute : "maxLength" ":" MathExpr	<< attributes.NewMaxLengthAttribute(X[2]), nil >>`,
+		Id:         "MaxLengthAttribute",
+		NTType:     27,
+		Index:      83,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return attributes.NewMaxLengthAttribute(X[2]), nil
+		},
+	},
+	ProdTabEntry{
+		String: `CharsetAttribute : "charset" ":" str	<< attributes.NewCharsetAttribute(X[2]), nil >>`,
+		Id:         "CharsetAttribute",
+		NTType:     28,
+		Index:      84,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return attributes.NewCharsetAttribute(X[2]), nil
+		},
+	},
+	ProdTabEntry{
+		String: `MaxCountAttribute : "maxCount" ":" MathExpr	<< attributes.NewMaxCountAttribute(X[2]), nil >>`,
+		Id:         "MaxCountAttribute",
+		NTType:     29,
+		Index:      85,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return attributes.NewMaxCountAttribute(X[2]), nil
+		},
+	},
+	ProdTabEntry{
+		String: `DeltaAttribute : "delta"	<< attributes.NewDeltaAttribute(), nil >>`,
+		Id:         "DeltaAttribute",
+		NTType:     30,
+		Index:      86,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return attributes.NewDeltaAttribute(), nil
+		},
+	},
+	ProdTabEntry{
+		String: `QuaternionAttribute : "quaternion"	<< attributes.NewQuaternionAttribute(), nil >>`,
+		Id:         "QuaternionAttribute",
+		NTType:     31,
+		Index:      87,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return attributes.NewQuaternionAttribute(), nil
+		},
+	},
+	ProdTabEntry{
+		String: `NormalizedAttribute : "normalized" ":" MathExpr	<< attributes.NewNormalizedAttribute(X[2]), nil >>`,
+		Id:         "NormalizedAttribute",
+		NTType:     32,
+		Index:      88,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return attributes.NewNormalizedAttribute(X[2]), nil
+		},
+	},
+	ProdTabEntry{
+		String: `AlignmentAttribute : "alignment" ":" str	<< attributes.NewAlignmentAttribute(X[2]), nil >>`,
+		Id:         "AlignmentAttribute",
+		NTType:     33,
+		Index:      89,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return attributes.NewAlignmentAttribute(X[2]), nil
+		},
+	},
+	ProdTabEntry{
+		String: `EntropyAttribute : "entropy"	<< attributes.NewEntropyAttribute(), nil >>`,
+		Id:         "EntropyAttribute",
+		NTType:     34,
+		Index:      90,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return attributes.NewEntropyAttribute(), nil
+		},
+	},
+	ProdTabEntry{
+		String: `IdAttribute : "id" ":" MathExpr	<< attributes.NewIdAttribute(X[2]), nil >>`,
+		Id:         "IdAttribute",
+		NTType:     35,
+		Index:      91,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return attributes.NewIdAttribute(X[2]), nil
+		},
+	},
 	ProdTabEntry{
 		String: `MessageAttribute : "message"	<< attributes.NewMessageAttribute(), nil >>`,
 		Id:         "MessageAttribute",
-		NTType:     25,
-		Index:      61,
+		NTType:     36,
+		Index:      92,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return attributes.NewMessageAttribute(), nil
@@ -645,8 +955,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Range : "[" MathExpr "," MathExpr "]"	<< ast.NewRange(X[1], true, X[3], true) >>`,
 		Id:         "Range",
-		NTType:     26,
-		Index:      62,
+		NTType:     37,
+		Index:      93,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewRange(X[1], true, X[3], true)
@@ -655,8 +965,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Range : "[" MathExpr "," MathExpr ">"	<< ast.NewRange(X[1], true, X[3], false) >>`,
 		Id:         "Range",
-		NTType:     26,
-		Index:      63,
+		NTType:     37,
+		Index:      94,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewRange(X[1], true, X[3], false)
@@ -665,8 +975,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Range : "<" MathExpr "," MathExpr "]"	<< ast.NewRange(X[1], false, X[3], true) >>`,
 		Id:         "Range",
-		NTType:     26,
-		Index:      64,
+		NTType:     37,
+		Index:      95,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewRange(X[1], false, X[3], true)
@@ -675,8 +985,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Range : "<" MathExpr "," MathExpr ">"	<< ast.NewRange(X[1], false, X[3], false) >>`,
 		Id:         "Range",
-		NTType:     26,
-		Index:      65,
+		NTType:     37,
+		Index:      96,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewRange(X[1], false, X[3], false)
@@ -685,8 +995,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Number : integer	<< ast.ToFloat64(X[0]), nil >>`,
 		Id:         "Number",
-		NTType:     27,
-		Index:      66,
+		NTType:     38,
+		Index:      97,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.ToFloat64(X[0]), nil
@@ -695,8 +1005,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Number : realNumber	<< ast.ToFloat64(X[0]), nil >>`,
 		Id:         "Number",
-		NTType:     27,
-		Index:      67,
+		NTType:     38,
+		Index:      98,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.ToFloat64(X[0]), nil
@@ -705,8 +1015,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Number : "pi"	<< math.Pi, nil >>`,
 		Id:         "Number",
-		NTType:     27,
-		Index:      68,
+		NTType:     38,
+		Index:      99,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return math.Pi, nil
@@ -715,8 +1025,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Number : "e"	<< math.E, nil >>`,
 		Id:         "Number",
-		NTType:     27,
-		Index:      69,
+		NTType:     38,
+		Index:      100,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return math.E, nil
@@ -725,8 +1035,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Number : "-" "inf"	<< math.Inf(-1), nil >>`,
 		Id:         "Number",
-		NTType:     27,
-		Index:      70,
+		NTType:     38,
+		Index:      101,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return math.Inf(-1), nil
@@ -735,8 +1045,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Number : "inf"	<< math.Inf(1), nil >>`,
 		Id:         "Number",
-		NTType:     27,
-		Index:      71,
+		NTType:     38,
+		Index:      102,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return math.Inf(1), nil
@@ -745,8 +1055,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `MathExpr : AddSub	<<  >>`,
 		Id:         "MathExpr",
-		NTType:     28,
-		Index:      72,
+		NTType:     39,
+		Index:      103,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -755,8 +1065,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `AddSub : AddSub "+" MulDiv	<< X[0].(float64) + X[2].(float64), nil >>`,
 		Id:         "AddSub",
-		NTType:     29,
-		Index:      73,
+		NTType:     40,
+		Index:      104,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0].(float64) + X[2].(float64), nil
@@ -765,8 +1075,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `AddSub : AddSub "-" MulDiv	<< X[0].(float64) - X[2].(float64), nil >>`,
 		Id:         "AddSub",
-		NTType:     29,
-		Index:      74,
+		NTType:     40,
+		Index:      105,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0].(float64) - X[2].(float64), nil
@@ -775,8 +1085,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `AddSub : MulDiv	<< X[0], nil >>`,
 		Id:         "AddSub",
-		NTType:     29,
-		Index:      75,
+		NTType:     40,
+		Index:      106,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -785,8 +1095,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `MulDiv : MulDiv "*" Pot	<< X[0].(float64) * X[2].(float64), nil >>`,
 		Id:         "MulDiv",
-		NTType:     30,
-		Index:      76,
+		NTType:     41,
+		Index:      107,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0].(float64) * X[2].(float64), nil
@@ -795,8 +1105,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `MulDiv : MulDiv "/" Pot	<< X[0].(float64) / X[2].(float64), nil >>`,
 		Id:         "MulDiv",
-		NTType:     30,
-		Index:      77,
+		NTType:     41,
+		Index:      108,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0].(float64) / X[2].(float64), nil
@@ -805,8 +1115,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `MulDiv : Pot	<< X[0], nil >>`,
 		Id:         "MulDiv",
-		NTType:     30,
-		Index:      78,
+		NTType:     41,
+		Index:      109,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -815,8 +1125,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Pot : Pot "^" Factor	<< math.Pow(X[0].(float64), X[2].(float64)), nil >>`,
 		Id:         "Pot",
-		NTType:     31,
-		Index:      79,
+		NTType:     42,
+		Index:      110,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return math.Pow(X[0].(float64), X[2].(float64)), nil
@@ -825,8 +1135,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Pot : Factor	<< X[0], nil >>`,
 		Id:         "Pot",
-		NTType:     31,
-		Index:      80,
+		NTType:     42,
+		Index:      111,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -835,8 +1145,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Factor : "sqrt(" AddSub ")"	<< math.Sqrt(X[1].(float64)), nil >>`,
 		Id:         "Factor",
-		NTType:     32,
-		Index:      81,
+		NTType:     43,
+		Index:      112,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return math.Sqrt(X[1].(float64)), nil
@@ -845,8 +1155,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Factor : "(" AddSub ")"	<< X[1], nil >>`,
 		Id:         "Factor",
-		NTType:     32,
-		Index:      82,
+		NTType:     43,
+		Index:      113,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[1], nil
@@ -855,8 +1165,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Factor : AddSub	<< X[0], nil >>`,
 		Id:         "Factor",
-		NTType:     32,
-		Index:      83,
+		NTType:     43,
+		Index:      114,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -865,8 +1175,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Factor : Number	<< X[0], nil >>`,
 		Id:         "Factor",
-		NTType:     32,
-		Index:      84,
+		NTType:     43,
+		Index:      115,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
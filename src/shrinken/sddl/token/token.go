@@ -94,6 +94,16 @@ var TokMap = TokenMap{
 		"integer",
 		"]",
 		",",
+		"encoding",
+		"maxLength",
+		"charset",
+		"maxCount",
+		"delta",
+		"quaternion",
+		"normalized",
+		"alignment",
+		"entropy",
+		"id",
 		"@",
 		"range",
 		"exportAs",
@@ -151,24 +161,34 @@ var TokMap = TokenMap{
 		"integer":     32,
 		"]":           33,
 		",":           34,
-		"@":           35,
-		"range":       36,
-		"exportAs":    37,
-		"precision":   38,
-		"message":     39,
-		">":           40,
-		"<":           41,
-		"realNumber":  42,
-		"pi":          43,
-		"e":           44,
-		"-":           45,
-		"inf":         46,
-		"+":           47,
-		"*":           48,
-		"/":           49,
-		"^":           50,
-		"sqrt(":       51,
-		")":           52,
-		"(":           53,
+		"encoding":    35,
+		"maxLength":   36,
+		"charset":     37,
+		"maxCount":    38,
+		"delta":       39,
+		"quaternion":  40,
+		"normalized":  41,
+		"alignment":   42,
+		"entropy":     43,
+		"id":          44,
+		"@":           45,
+		"range":       46,
+		"exportAs":    47,
+		"precision":   48,
+		"message":     49,
+		">":           50,
+		"<":           51,
+		"realNumber":  52,
+		"pi":          53,
+		"e":           54,
+		"-":           55,
+		"inf":         56,
+		"+":           57,
+		"*":           58,
+		"/":           59,
+		"^":           60,
+		"sqrt(":       61,
+		")":           62,
+		"(":           63,
 	},
 }
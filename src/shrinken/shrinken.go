@@ -2,9 +2,19 @@ package main
 
 import (
 	"fmt"
+	"os"
 	"shrinken/gen"
+	_ "shrinken/gen/c"
+	_ "shrinken/gen/cpp"
+	_ "shrinken/gen/csharp"
+	_ "shrinken/gen/golang"
+	_ "shrinken/gen/java"
+	_ "shrinken/gen/python"
+	_ "shrinken/gen/rust"
+	_ "shrinken/gen/typescript"
 	"shrinken/sddl"
 	"shrinken/sddl/dbgvisitor"
+	"shrinken/train"
 
 	"github.com/docopt/docopt-go"
 )
@@ -12,47 +22,89 @@ import (
 var version = "v0.0.1"
 var usage = `shrinken
 Usage:
-    shrinken <path> <output-path> <lang>
+    shrinken train [--entropy=<tables>] <path> <samples-path>
+    shrinken [--entropy=<tables>] <path> <output-path> <lang>
     shrinken print-ast <path>
     shrinken (-h | --help)
     shrinken --version
 
 Options:
-    -h --help    Show this screen.
-    --version    Show version.
+    -h --help            Show this screen.
+    --version            Show version.
+    --entropy=<tables>   Entropy tables of messages, printed by train.
 
 Commands:
+    train        Print entropy tables trained from sample messages. Samples of each message are files
+                 in directory named by the message (game.Move), one serialized message per file.
     print-ast    Print parsed AST from specified file. Only for debugging.
 `
 
 func main() {
 	opts, err := docopt.ParseArgs(usage, nil, version)
 	if err != nil {
-		fmt.Println("Error parsing arguments:", err)
-		return
+		fmt.Fprintln(os.Stderr, "Error parsing arguments:", err)
+		os.Exit(1)
 	}
 
-	if opts["print-ast"].(bool) {
-		path, _ := opts.String("<path>")
+	err = run(opts)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// run runs command given by options
+func run(opts docopt.Opts) error {
+	path, _ := opts.String("<path>")
 
+	if opts["print-ast"].(bool) {
 		r, err := sddl.ParseMergeAndAnalyze(path)
 		if err != nil {
-			fmt.Println(err)
-			return
+			return err
 		}
 
 		dbgvisitor.PrintASTs(r)
-	} else {
-		path, _ := opts.String("<path>")
-		outputPath, _ := opts.String("<output-path>")
-		lang, _ := opts.String("<lang>")
+		return nil
+	}
 
-		r, err := sddl.ParseMergeAndAnalyze(path)
+	r, err := parse(opts, path)
+	if err != nil {
+		return err
+	}
+
+	if opts["train"].(bool) {
+		samplesPath, _ := opts.String("<samples-path>")
+
+		schema, err := gen.NewSchema(r)
 		if err != nil {
-			fmt.Println(err)
-			return
+			return err
 		}
 
-		gen.Generate(r, lang, outputPath)
+		tables, err := train.Train(schema, samplesPath)
+		if err != nil {
+			return err
+		}
+
+		return tables.Write(os.Stdout)
+	}
+
+	outputPath, _ := opts.String("<output-path>")
+	lang, _ := opts.String("<lang>")
+	return gen.Generate(r, lang, outputPath)
+}
+
+// parse parses and analyzes SDDL and applies entropy tables given by options to it
+func parse(opts docopt.Opts, path string) (*sddl.SDDLTree, error) {
+	r, err := sddl.ParseMergeAndAnalyze(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if tables, _ := opts.String("--entropy"); tables != "" {
+		err = gen.LoadEntropyTables(r, tables)
+		if err != nil {
+			return nil, err
+		}
 	}
+	return r, nil
 }
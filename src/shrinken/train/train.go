@@ -0,0 +1,167 @@
+// Package train builds entropy tables of messages from samples of their serialized values. Samples of
+// message are files in directory named by its qualified name (game.Move), each holding one message
+// serialized whole.
+package train
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"shrinken/gen"
+	"shrinken/wire"
+	"sort"
+)
+
+// Train decodes samples of entropy coded messages of schema and returns tables of their fields. Samples are
+// decoded by schema, so samples of messages which already have tables have to be written with them.
+// Messages without samples are left out of tables.
+func Train(schema *gen.Schema, samplesDir string) (gen.EntropyTables, error) {
+	messages := make(map[string]*gen.Struct)
+	for _, pkg := range schema.Packages {
+		for _, s := range pkg.Structs {
+			if s.IsMessage && s.IsEntropy {
+				messages[s.QualifiedName()] = s
+			}
+		}
+	}
+
+	dirs, err := ioutil.ReadDir(samplesDir)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make(gen.EntropyTables)
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+
+		s, ok := messages[dir.Name()]
+		if !ok {
+			return nil, fmt.Errorf("Samples %v don't belong to any entropy coded message", dir.Name())
+		}
+
+		counts, err := tally(s, filepath.Join(samplesDir, dir.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		table := make(map[string][]int)
+		for name, c := range counts {
+			table[name] = CodeLengths(c)
+		}
+		if len(table) > 0 {
+			tables[s.QualifiedName()] = table
+		}
+	}
+	return tables, nil
+}
+
+// tally returns number of occurrences of every value of entropy coded fields of message in its samples
+func tally(s *gen.Struct, dir string) (map[string][]uint64, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := s.EntropyFields()
+	counts := make(map[string][]uint64)
+	for _, field := range fields {
+		counts[field.Name] = make([]uint64, field.Codec.EntropySymbols())
+	}
+
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+
+		filename := filepath.Join(dir, file.Name())
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := wire.Decode(s.Codec(), data)
+		if err != nil {
+			return nil, fmt.Errorf("Sample %v couldn't be decoded as %v: %v", filename, s.QualifiedName(), err)
+		}
+
+		for _, field := range fields {
+			counts[field.Name][symbol(field.Codec, value.(wire.Struct)[field.Name])]++
+		}
+	}
+	return counts, nil
+}
+
+// symbol returns index of decoded enumeral or offset of decoded ranged integer from lower bound of range
+func symbol(c *gen.Codec, value interface{}) uint64 {
+	switch v := value.(type) {
+	case int:
+		return uint64(v)
+	case int64:
+		return uint64(v) - c.Range.Min
+	case uint64:
+		return v - c.Range.Min
+	}
+	return 0
+}
+
+// CodeLengths returns lengths of Huffman codes of values, which occurred counts times. Every value gets
+// code, as if it occurred once more than it did, and codes are limited to gen.MaxCodeLength bits by
+// halving counts until they fit.
+func CodeLengths(counts []uint64) []int {
+	weights := make([]uint64, len(counts))
+	for i, count := range counts {
+		weights[i] = count + 1
+	}
+
+	for {
+		lengths := huffmanLengths(weights)
+		fits := true
+		for _, length := range lengths {
+			if length > gen.MaxCodeLength {
+				fits = false
+			}
+		}
+		if fits {
+			return lengths
+		}
+
+		for i := range weights {
+			weights[i] = (weights[i] + 1) / 2
+		}
+	}
+}
+
+// huffmanLengths returns depths of leaves of Huffman tree built from weights of at least two values.
+// Two lightest nodes are merged first, older nodes first among equally heavy ones.
+func huffmanLengths(weights []uint64) []int {
+	n := len(weights)
+	nodes := append(make([]uint64, 0, 2*n-1), weights...)
+	parent := make([]int, 2*n-1)
+
+	active := make([]int, n)
+	for i := range active {
+		active[i] = i
+	}
+	for len(active) > 1 {
+		sort.SliceStable(active, func(i, j int) bool {
+			return nodes[active[i]] < nodes[active[j]]
+		})
+
+		node := len(nodes)
+		nodes = append(nodes, nodes[active[0]]+nodes[active[1]])
+		parent[active[0]] = node
+		parent[active[1]] = node
+		active = append(active[2:], node)
+	}
+
+	root := len(nodes) - 1
+	lengths := make([]int, n)
+	for i := range lengths {
+		for node := i; node != root; node = parent[node] {
+			lengths[i]++
+		}
+	}
+	return lengths
+}
@@ -0,0 +1,128 @@
+package train
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"shrinken/gen"
+	"shrinken/sddl"
+	"shrinken/wire"
+	"testing"
+)
+
+const schemaFile = "../sddl/test_data/wire/conformance.sddl"
+
+func TestCodeLengths(t *testing.T) {
+	lengths := CodeLengths([]uint64{99, 0, 49, 0})
+	if !reflect.DeepEqual(lengths, []int{1, 3, 2, 3}) {
+		t.Fatalf("Code lengths are %v, expected [1 3 2 3]", lengths)
+	}
+
+	lengths = CodeLengths([]uint64{5, 5, 5, 5})
+	if !reflect.DeepEqual(lengths, []int{2, 2, 2, 2}) {
+		t.Fatalf("Code lengths of equally frequent values are %v, expected [2 2 2 2]", lengths)
+	}
+
+	// fibonacci counts make the deepest tree, codes have to be limited
+	counts := make([]uint64, 30)
+	a, b := uint64(1), uint64(1)
+	for i := range counts {
+		counts[i] = a
+		a, b = b, a+b
+	}
+	for _, length := range CodeLengths(counts) {
+		if length > gen.MaxCodeLength {
+			t.Fatalf("Code length %v is longer than %v", length, gen.MaxCodeLength)
+		}
+	}
+}
+
+// codedStruct returns entropy coded message Coded of conformance schema
+func codedStruct(t *testing.T, schema *gen.Schema) *gen.Struct {
+	for _, s := range schema.Packages[0].Structs {
+		if s.Name == "Coded" {
+			return s
+		}
+	}
+	t.Fatal("Struct Coded is missing")
+	return nil
+}
+
+func TestTrain(t *testing.T) {
+	tree, err := sddl.ParseMergeAndAnalyze(schemaFile)
+	if err != nil {
+		t.Fatal("SDDL couldn't be parsed!", err)
+	}
+	schema, err := gen.NewSchema(tree)
+	if err != nil {
+		t.Fatal("Schema couldn't be created!", err)
+	}
+	coded := codedStruct(t, schema)
+
+	dir, err := ioutil.TempDir("", "shrinken")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// color is mostly Red, state mostly 2, offset spread evenly
+	samples := filepath.Join(dir, "conformance.Coded")
+	err = os.Mkdir(samples, 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100; i++ {
+		value := wire.Struct{"color": "Red", "state": 2, "offset": i%21 - 10}
+		if i%10 == 0 {
+			value["color"] = "Blue"
+		}
+		data, err := wire.Encode(coded.Codec(), value)
+		if err != nil {
+			t.Fatal("Sample couldn't be encoded!", err)
+		}
+		err = ioutil.WriteFile(filepath.Join(samples, fmt.Sprint(i)), data, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tables, err := Train(schema, dir)
+	if err != nil {
+		t.Fatal("Tables couldn't be trained!", err)
+	}
+
+	table := tables["conformance.Coded"]
+	if len(table) != 3 || !reflect.DeepEqual(table["color"], []int{1, 2, 2}) || table["state"][2] != 1 {
+		t.Fatalf("Unexpected tables %v", tables)
+	}
+
+	// trained message takes fewer bits
+	err = tables.Apply(tree)
+	if err != nil {
+		t.Fatal("Tables couldn't be applied!", err)
+	}
+	trained, err := gen.NewSchema(tree)
+	if err != nil {
+		t.Fatal("Schema with tables couldn't be created!", err)
+	}
+	codedTrained := codedStruct(t, trained)
+
+	value := wire.Struct{"color": "Red", "state": 2}
+	before, after := wire.NewBitWriter(), wire.NewBitWriter()
+	wire.EncodeValue(before, coded.Codec(), value)
+	wire.EncodeValue(after, codedTrained.Codec(), value)
+	if after.Len() >= before.Len() {
+		t.Fatalf("Trained message takes %v bits, untrained %v", after.Len(), before.Len())
+	}
+
+	err = os.Mkdir(filepath.Join(dir, "conformance.Sample"), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = Train(schema, dir)
+	if err == nil {
+		t.Fatal("Samples of message without entropy attribute were accepted")
+	}
+}
@@ -0,0 +1,247 @@
+package wire
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	ErrUnexpectedEnd = errors.New("Unexpected end of data")
+	ErrInvalidValue  = errors.New("Invalid value")
+)
+
+// BitWriter writes values as little-endian bit fields, starting from least significant bit of first byte
+type BitWriter struct {
+	buf         []byte
+	bits        uint
+	byteAligned bool
+}
+
+func NewBitWriter() *BitWriter {
+	return &BitWriter{
+		buf: make([]byte, 0, 64),
+	}
+}
+
+// WriteBits writes lowest bits (up to 64) of value
+func (w *BitWriter) WriteBits(value uint64, bits uint) {
+	for bits > 0 {
+		used := w.bits & 7
+		if used == 0 {
+			w.buf = append(w.buf, 0)
+		}
+
+		n := 8 - used
+		if bits < n {
+			n = bits
+		}
+
+		w.buf[len(w.buf)-1] |= byte(value&(1<<n-1)) << used
+		value >>= n
+		bits -= n
+		w.bits += n
+	}
+
+	if w.byteAligned {
+		w.bits = (w.bits + 7) &^ 7
+	}
+}
+
+// SetByteAligned makes writer pad every following bit field with zero bits to whole bytes
+func (w *BitWriter) SetByteAligned(aligned bool) {
+	w.byteAligned = aligned
+}
+
+// WriteVarint writes lowest bits (up to 64) of value in groups of 7 bits, starting from the lowest one.
+// Each group is written as 8 bit field, which has the highest bit set if another group follows.
+func (w *BitWriter) WriteVarint(value uint64, bits uint) {
+	if bits < 64 {
+		value &= 1<<bits - 1
+	}
+	for value >= 0x80 {
+		w.WriteBits(value&0x7F|0x80, 8)
+		value >>= 7
+	}
+	w.WriteBits(value, 8)
+}
+
+// WriteZigZag writes signed value as varint, after mapping 0, -1, 1, -2... to 0, 1, 2, 3...
+func (w *BitWriter) WriteZigZag(value int64) {
+	w.WriteVarint(uint64(value<<1)^uint64(value>>63), 64)
+}
+
+func (w *BitWriter) WriteBool(value bool) {
+	if value {
+		w.WriteBits(1, 1)
+	} else {
+		w.WriteBits(0, 1)
+	}
+}
+
+func (w *BitWriter) WriteFloat32(value float32) {
+	w.WriteBits(uint64(math.Float32bits(value)), 32)
+}
+
+func (w *BitWriter) WriteFloat64(value float64) {
+	w.WriteBits(math.Float64bits(value), 64)
+}
+
+// WriteString writes length of value in bytes as countBits wide integer, followed by lowest charBits
+// of each byte
+func (w *BitWriter) WriteString(value string, countBits uint, charBits uint) {
+	w.WriteBits(uint64(len(value)), countBits)
+	for i := 0; i < len(value); i++ {
+		w.WriteBits(uint64(value[i]), charBits)
+	}
+}
+
+// Len returns number of written bits
+func (w *BitWriter) Len() uint {
+	return w.bits
+}
+
+// Bytes returns written bytes, unused bits of last byte are zero
+func (w *BitWriter) Bytes() []byte {
+	return w.buf
+}
+
+// BitReader reads values written by BitWriter. After first error all reads return zero values.
+type BitReader struct {
+	buf         []byte
+	pos         uint
+	err         error
+	byteAligned bool
+}
+
+func NewBitReader(data []byte) *BitReader {
+	return &BitReader{
+		buf: data,
+	}
+}
+
+// ReadBits reads unsigned value of up to 64 bits
+func (r *BitReader) ReadBits(bits uint) uint64 {
+	if r.err != nil {
+		return 0
+	}
+
+	if bits > r.Remaining() {
+		r.err = ErrUnexpectedEnd
+		return 0
+	}
+
+	var value uint64
+	var shift uint
+	for bits > 0 {
+		used := r.pos & 7
+
+		n := 8 - used
+		if bits < n {
+			n = bits
+		}
+
+		value |= uint64(r.buf[r.pos>>3]>>used&(1<<n-1)) << shift
+		shift += n
+		bits -= n
+		r.pos += n
+	}
+
+	if r.byteAligned {
+		r.pos = (r.pos + 7) &^ 7
+	}
+	return value
+}
+
+// SetByteAligned makes reader skip padding after every following bit field, up to whole bytes
+func (r *BitReader) SetByteAligned(aligned bool) {
+	r.byteAligned = aligned
+}
+
+// ReadSigned reads two's complement value of up to 64 bits
+func (r *BitReader) ReadSigned(bits uint) int64 {
+	value := r.ReadBits(bits)
+	if bits < 64 && value&(1<<(bits-1)) != 0 {
+		value |= ^uint64(0) << bits
+	}
+	return int64(value)
+}
+
+// ReadVarint reads value written by WriteVarint. Value which doesn't fit bits is invalid.
+func (r *BitReader) ReadVarint(bits uint) uint64 {
+	var value uint64
+	for shift := uint(0); shift < bits; shift += 7 {
+		group := r.ReadBits(8)
+		if r.err != nil {
+			return 0
+		}
+
+		data := group & 0x7F
+		if shift+7 > bits && data>>(bits-shift) != 0 {
+			r.Fail(ErrInvalidValue)
+			return 0
+		}
+		value |= data << shift
+
+		if group&0x80 == 0 {
+			return value
+		}
+	}
+
+	// more groups than needed for bits
+	r.Fail(ErrInvalidValue)
+	return 0
+}
+
+// ReadZigZag reads value written by WriteZigZag, which has to fit bits wide signed integer
+func (r *BitReader) ReadZigZag(bits uint) int64 {
+	value := r.ReadVarint(bits)
+	return int64(value>>1) ^ -int64(value&1)
+}
+
+func (r *BitReader) ReadBool() bool {
+	return r.ReadBits(1) == 1
+}
+
+func (r *BitReader) ReadFloat32() float32 {
+	return math.Float32frombits(uint32(r.ReadBits(32)))
+}
+
+func (r *BitReader) ReadFloat64() float64 {
+	return math.Float64frombits(r.ReadBits(64))
+}
+
+// ReadString reads string written by WriteString with the same countBits and charBits
+func (r *BitReader) ReadString(countBits uint, charBits uint) string {
+	n := r.ReadBits(countBits)
+	if n > uint64(r.Remaining()/charBits) {
+		r.Fail(ErrUnexpectedEnd)
+		return ""
+	}
+
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(r.ReadBits(charBits))
+	}
+	return string(b)
+}
+
+// Remaining returns number of bits left to read
+func (r *BitReader) Remaining() uint {
+	return uint(len(r.buf))*8 - r.pos
+}
+
+// Pos returns number of read bits
+func (r *BitReader) Pos() uint {
+	return r.pos
+}
+
+// Fail records first error that occurred during decoding
+func (r *BitReader) Fail(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+func (r *BitReader) Err() error {
+	return r.err
+}
@@ -0,0 +1,131 @@
+package wire
+
+import (
+	"fmt"
+	"shrinken/gen"
+)
+
+// EncodeDelta encodes value of delta message laid out by codec as its difference from baseline
+func EncodeDelta(c *gen.Codec, value, baseline interface{}) ([]byte, error) {
+	v, err := normalize(c, value)
+	if err != nil {
+		return nil, err
+	}
+	base, err := normalize(c, baseline)
+	if err != nil {
+		return nil, fmt.Errorf("%v (baseline)", err)
+	}
+
+	w := NewBitWriter()
+	w.SetByteAligned(c.IsByteAligned())
+	encodeDelta(w, c, v, base)
+	return w.Bytes(), nil
+}
+
+// DecodeDelta decodes delta written by EncodeDelta and returns baseline with changed fields replaced.
+// Baseline itself is not modified.
+func DecodeDelta(c *gen.Codec, data []byte, baseline interface{}) (interface{}, error) {
+	base, err := normalize(c, baseline)
+	if err != nil {
+		return nil, fmt.Errorf("%v (baseline)", err)
+	}
+
+	r := NewBitReader(data)
+	r.SetByteAligned(c.IsByteAligned())
+	value := decodeDelta(r, c, base)
+	if r.Err() != nil {
+		return nil, r.Err()
+	}
+	return value, nil
+}
+
+// normalize returns struct value in decoded form, which holds every field and no null references
+func normalize(c *gen.Codec, value interface{}) (Struct, error) {
+	data, err := Encode(c, value)
+	if err != nil {
+		return nil, err
+	}
+	decoded, err := Decode(c, data)
+	if err != nil {
+		return nil, err
+	}
+	return decoded.(Struct), nil
+}
+
+// encodeDelta writes mask of changed fields of struct followed by changed fields, values are decoded ones
+func encodeDelta(w *BitWriter, c *gen.Codec, value, baseline Struct) {
+	all := c.Struct.AllFields()
+	changed := make([]bool, len(all))
+	for i, field := range all {
+		changed[i] = differs(field.Codec, value[field.Name], baseline[field.Name])
+		w.WriteBool(changed[i])
+	}
+
+	for i, field := range all {
+		if !changed[i] {
+			continue
+		}
+		if field.Codec.IsDelta() {
+			encodeDelta(w, field.Codec, value[field.Name].(Struct), baseline[field.Name].(Struct))
+		} else {
+			// decoded values are always valid
+			EncodeValue(w, field.Codec, value[field.Name])
+		}
+	}
+}
+
+// decodeDelta reads delta written by encodeDelta and returns copy of baseline with changed fields replaced
+func decodeDelta(r *BitReader, c *gen.Codec, baseline Struct) Struct {
+	all := c.Struct.AllFields()
+	changed := make([]bool, len(all))
+	for i := range all {
+		changed[i] = r.ReadBool()
+	}
+
+	fields := make(Struct, len(baseline))
+	for name, value := range baseline {
+		fields[name] = value
+	}
+	for i, field := range all {
+		if !changed[i] {
+			continue
+		}
+		if field.Codec.IsDelta() {
+			fields[field.Name] = decodeDelta(r, field.Codec, baseline[field.Name].(Struct))
+		} else {
+			fields[field.Name] = DecodeValue(r, field.Codec)
+		}
+	}
+	return fields
+}
+
+// differs reports whether decoded value is changed against baseline, as defined by specification
+func differs(c *gen.Codec, value, baseline interface{}) bool {
+	switch c.Kind {
+	case gen.StructCodec:
+		if c.IsPolymorphic() {
+			return true
+		}
+		v, base := value.(Struct), baseline.(Struct)
+		for _, field := range c.Struct.AllFields() {
+			if differs(field.Codec, v[field.Name], base[field.Name]) {
+				return true
+			}
+		}
+		return false
+	case gen.ArrayCodec:
+		v, base := value.([]interface{}), baseline.([]interface{})
+		if len(v) != len(base) {
+			return true
+		}
+		for i := range v {
+			if differs(c.Elem, v[i], base[i]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// decoded floats are compared by value, so zero equals negative zero and NaN differs from itself
+	return value != baseline
+}
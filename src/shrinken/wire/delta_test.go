@@ -0,0 +1,120 @@
+package wire
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestDelta(t *testing.T) {
+	s := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", "Sample")
+	c := s.Codec()
+	all := s.AllFields()
+
+	// expected writes mask of changed fields followed by values written by write functions
+	expected := func(changed map[string]func(w *BitWriter)) []byte {
+		w := NewBitWriter()
+		for _, field := range all {
+			w.WriteBool(changed[field.Name] != nil)
+		}
+		for _, field := range all {
+			if write := changed[field.Name]; write != nil {
+				write(w)
+			}
+		}
+		return w.Bytes()
+	}
+	whole := func(name string, value interface{}) func(w *BitWriter) {
+		return func(w *BitWriter) {
+			err := EncodeValue(w, fieldByName(all, name).Codec, value)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	// references to polymorphic classes are always changed
+	polymorphic := map[string]func(w *BitWriter){
+		"next":     whole("next", sample["next"]),
+		"shapes":   whole("shapes", sample["shapes"]),
+		"entities": whole("entities", sample["entities"]),
+		"boss":     whole("boss", sample["boss"]),
+	}
+	data, err := EncodeDelta(c, sample, sample)
+	if err != nil {
+		t.Fatal("Delta couldn't be encoded!", err)
+	}
+	if e := expected(polymorphic); !bytes.Equal(data, e) {
+		t.Fatalf("Delta of unchanged sample was encoded as\n%x\nexpected\n%x", data, e)
+	}
+
+	update := make(Struct)
+	for name, value := range sample {
+		update[name] = value
+	}
+	update["flag"] = false
+	update["pos"] = Struct{"x": float32(1), "y": float32(5)}
+	update["bigs"] = []int64{5, -6, 7}
+
+	changed := map[string]func(w *BitWriter){
+		"flag": whole("flag", false),
+		// nested struct is written as delta
+		"pos": func(w *BitWriter) {
+			w.WriteBool(false)
+			w.WriteBool(true)
+			w.WriteFloat32(5)
+		},
+		"bigs": whole("bigs", update["bigs"]),
+	}
+	for name, write := range polymorphic {
+		changed[name] = write
+	}
+
+	data, err = EncodeDelta(c, update, sample)
+	if err != nil {
+		t.Fatal("Delta couldn't be encoded!", err)
+	}
+	if e := expected(changed); !bytes.Equal(data, e) {
+		t.Fatalf("Delta of updated sample was encoded as\n%x\nexpected\n%x", data, e)
+	}
+
+	decoded, err := DecodeDelta(c, data, sample)
+	if err != nil {
+		t.Fatal("Delta couldn't be decoded!", err)
+	}
+	a, _ := Encode(c, decoded)
+	b, _ := Encode(c, update)
+	if !bytes.Equal(a, b) {
+		t.Fatal("Delta was decoded to different value than the one it was encoded from")
+	}
+
+	// baseline of decoder is not modified
+	if !reflect.DeepEqual(sample["flag"], true) {
+		t.Fatal("Decoding delta modified baseline")
+	}
+
+	_, err = DecodeDelta(c, data[:len(data)-1], sample)
+	if err != ErrUnexpectedEnd {
+		t.Fatalf("Decoding truncated delta didn't fail with unexpected end (%v)", err)
+	}
+}
+
+func TestDeltaFloats(t *testing.T) {
+	s := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", "Vec")
+
+	// floats are compared by value
+	data, err := EncodeDelta(s.Codec(), Struct{"x": float32(math.Copysign(0, -1))}, Struct{"x": float32(0)})
+	if err != nil {
+		t.Fatal("Delta couldn't be encoded!", err)
+	}
+	if !bytes.Equal(data, []byte{0}) {
+		t.Fatalf("Negative zero was encoded as changed (%x)", data)
+	}
+
+	nan := float32(math.NaN())
+	data, _ = EncodeDelta(s.Codec(), Struct{"x": nan}, Struct{"x": nan})
+	if data[0] != 1 {
+		t.Fatalf("NaN was encoded as unchanged (%x)", data)
+	}
+}
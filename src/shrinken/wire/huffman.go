@@ -0,0 +1,40 @@
+package wire
+
+import "shrinken/gen"
+
+// WriteSymbol writes Huffman code of value as single bit field
+func (w *BitWriter) WriteSymbol(h *gen.HuffmanTable, symbol uint64) {
+	w.WriteBits(uint64(h.Codes[symbol]), uint(h.Lengths[symbol]))
+}
+
+// ReadSymbol reads value written by WriteSymbol. Code is read bit by bit, padding of byte aligned
+// reader is skipped only after the whole code.
+func (r *BitReader) ReadSymbol(h *gen.HuffmanTable) uint64 {
+	aligned := r.byteAligned
+	r.byteAligned = false
+	defer func() {
+		r.byteAligned = aligned
+		if aligned && r.err == nil {
+			r.pos = (r.pos + 7) &^ 7
+		}
+	}()
+
+	// codes of each length follow all shorter ones, counting up from first
+	code, first, index := 0, 0, 0
+	for length := 1; length < len(h.Counts); length++ {
+		code |= int(r.ReadBits(1))
+		if r.err != nil {
+			return 0
+		}
+		if code-first < h.Counts[length] {
+			return uint64(h.Symbols[index+code-first])
+		}
+		index += h.Counts[length]
+		first = (first + h.Counts[length]) << 1
+		code <<= 1
+	}
+
+	// incomplete code
+	r.Fail(ErrInvalidValue)
+	return 0
+}
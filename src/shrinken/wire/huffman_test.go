@@ -0,0 +1,168 @@
+package wire
+
+import (
+	"encoding/hex"
+	"reflect"
+	"shrinken/gen"
+	"shrinken/sddl"
+	"testing"
+)
+
+func TestHuffmanCode(t *testing.T) {
+	// codes are 0, 10 and 11, written from their first bit
+	h := gen.NewHuffmanTable("test", []int{1, 2, 2})
+	w := NewBitWriter()
+	for _, symbol := range []uint64{2, 0, 1, 0} {
+		w.WriteSymbol(h, symbol)
+	}
+	if hex.EncodeToString(w.Bytes()) != "0b" || w.Len() != 6 {
+		t.Fatalf("Symbols were written as %x (%v bits), expected 0b (6 bits)", w.Bytes(), w.Len())
+	}
+
+	r := NewBitReader(w.Bytes())
+	for _, expected := range []uint64{2, 0, 1, 0} {
+		if symbol := r.ReadSymbol(h); symbol != expected {
+			t.Fatalf("Symbol was read as %v, expected %v", symbol, expected)
+		}
+	}
+
+	// byte aligned code is padded only after its last bit
+	w = NewBitWriter()
+	w.SetByteAligned(true)
+	w.WriteSymbol(h, 2)
+	w.WriteSymbol(h, 1)
+	if hex.EncodeToString(w.Bytes()) != "0301" {
+		t.Fatalf("Byte aligned symbols were written as %x, expected 0301", w.Bytes())
+	}
+
+	r = NewBitReader(w.Bytes())
+	r.SetByteAligned(true)
+	if a, b := r.ReadSymbol(h), r.ReadSymbol(h); a != 2 || b != 1 || r.Err() != nil {
+		t.Fatalf("Byte aligned symbols were read as %v and %v (%v)", a, b, r.Err())
+	}
+
+	r = NewBitReader([]byte{})
+	r.ReadSymbol(h)
+	if r.Err() != ErrUnexpectedEnd {
+		t.Fatalf("Reading symbol from empty data didn't fail with unexpected end (%v)", r.Err())
+	}
+}
+
+func TestEntropy(t *testing.T) {
+	filename := "../sddl/test_data/wire/conformance.sddl"
+	value := Struct{"color": "Blue", "state": 0, "offset": -9, "flag": true, "slots": []string{"Body", "Head"}}
+
+	// message without tables is written as if it had no entropy attribute
+	plain := loadStruct(t, filename, "Coded")
+	b, err := Encode(plain.Codec(), value)
+	if err != nil {
+		t.Fatal("Coded couldn't be encoded!", err)
+	}
+	if hex.EncodeToString(b) != "220c" {
+		t.Fatalf("Coded without tables was encoded as %x, expected 220c", b)
+	}
+
+	tree, err := sddl.ParseMergeAndAnalyze(filename)
+	if err != nil {
+		t.Fatal("SDDL couldn't be parsed!", err)
+	}
+	err = gen.LoadEntropyTables(tree, "../sddl/test_data/wire/conformance.entropy.json")
+	if err != nil {
+		t.Fatal("Entropy tables couldn't be loaded!", err)
+	}
+	schema, err := gen.NewSchema(tree)
+	if err != nil {
+		t.Fatal("Schema couldn't be created!", err)
+	}
+
+	var coded, aligned *gen.Struct
+	for _, s := range schema.Packages[0].Structs {
+		switch s.Name {
+		case "Coded":
+			coded = s
+		case "Aligned":
+			aligned = s
+		}
+	}
+
+	// color 11, state 0, offset 1111101, flag 1, slots 01 and 00
+	b, err = Encode(coded.Codec(), value)
+	if err != nil {
+		t.Fatal("Coded couldn't be encoded!", err)
+	}
+	if hex.EncodeToString(b) != "fb0e" {
+		t.Fatalf("Coded was encoded as %x, expected fb0e", b)
+	}
+
+	decoded, err := Decode(coded.Codec(), b)
+	if err != nil {
+		t.Fatal("Coded couldn't be decoded!", err)
+	}
+	expected := Struct{"color": 2, "state": int64(0), "offset": int64(-9), "flag": true, "slots": []interface{}{1, 0}}
+	if !reflect.DeepEqual(decoded, expected) {
+		t.Fatalf("Coded was decoded as %v, expected %v", decoded, expected)
+	}
+
+	_, err = Decode(coded.Codec(), b[:1])
+	if err != ErrUnexpectedEnd {
+		t.Fatalf("Decoding truncated message didn't fail with unexpected end (%v)", err)
+	}
+
+	// changed fields of delta are entropy coded too, mask 11100, color 0, state 11111, offset 0
+	update := Struct{"color": "Red", "state": 5, "offset": 0, "flag": true, "slots": []string{"Body", "Head"}}
+	delta, err := EncodeDelta(coded.Codec(), update, value)
+	if err != nil {
+		t.Fatal("Delta couldn't be encoded!", err)
+	}
+	if hex.EncodeToString(delta) != "c707" {
+		t.Fatalf("Delta was encoded as %x, expected c707", delta)
+	}
+	applied, err := DecodeDelta(coded.Codec(), delta, value)
+	if err != nil {
+		t.Fatal("Delta couldn't be decoded!", err)
+	}
+	if applied.(Struct)["state"] != int64(5) || applied.(Struct)["offset"] != int64(0) {
+		t.Fatalf("Delta was decoded as %v", applied)
+	}
+
+	// entropy coded message nested in byte aligned one pads whole codes
+	b, err = Encode(aligned.Codec(), Struct{"coded": value})
+	if err != nil {
+		t.Fatal("Aligned couldn't be encoded!", err)
+	}
+	decoded, err = Decode(aligned.Codec(), b)
+	if err != nil {
+		t.Fatal("Aligned couldn't be decoded!", err)
+	}
+	if !reflect.DeepEqual(decoded.(Struct)["coded"], expected) {
+		t.Fatalf("Nested coded message was decoded as %v, expected %v", decoded.(Struct)["coded"], expected)
+	}
+}
+
+func TestEntropyTables(t *testing.T) {
+	tables := []gen.EntropyTables{
+		{"conformance.Sample": {"state": {1, 2, 3, 4, 5, 5}}},
+		{"conformance.Missing": {"state": {1, 1}}},
+		{"conformance.Coded": {"flag": {1, 1}}},
+		{"conformance.Coded": {"slots": {1, 2, 2}}},
+		{"conformance.Coded": {"color": {1, 2}}},
+		{"conformance.Coded": {"color": {1, 2, 3}}},
+		{"conformance.Coded": {"color": {1, 1, 1}}},
+		{"conformance.Coded": {"color": {0, 1, 1}}},
+	}
+
+	for _, table := range tables {
+		tree, err := sddl.ParseMergeAndAnalyze("../sddl/test_data/wire/conformance.sddl")
+		if err != nil {
+			t.Fatal("SDDL couldn't be parsed!", err)
+		}
+
+		err = table.Apply(tree)
+		if err == nil {
+			_, err = gen.NewSchema(tree)
+		}
+		if err == nil {
+			t.Fatalf("Invalid entropy tables %v were accepted", table)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package wire
+
+import (
+	"bytes"
+	"hash/crc32"
+	"shrinken/gen"
+)
+
+// EncodeAny encodes value of message with given ID, prefixed with the ID as 16 bit varint
+func EncodeAny(id uint16, c *gen.Codec, value interface{}) ([]byte, error) {
+	data, err := Encode(c, value)
+	if err != nil {
+		return nil, err
+	}
+
+	w := NewBitWriter()
+	w.WriteVarint(uint64(id), 16)
+	return append(w.Bytes(), data...), nil
+}
+
+// ReadMessageID reads ID written by EncodeAny and returns it with the encoded message
+func ReadMessageID(data []byte) (uint16, []byte, error) {
+	r := NewBitReader(data)
+	id := r.ReadVarint(16)
+	if r.Err() != nil {
+		return 0, nil, r.Err()
+	}
+	return uint16(id), data[r.Pos()/8:], nil
+}
+
+// EncodeFrame encodes value of message with given ID as frame of stream, which is length of the message
+// with its ID as 32 bit varint, the message with its ID and, if checksum is set, CRC32 of all of that
+func EncodeFrame(id uint16, c *gen.Codec, value interface{}, checksum bool) ([]byte, error) {
+	data, err := EncodeAny(id, c, value)
+	if err != nil {
+		return nil, err
+	}
+
+	w := NewBitWriter()
+	w.WriteVarint(uint64(len(data)), 32)
+	frame := append(w.Bytes(), data...)
+	if checksum {
+		frame = appendChecksum(frame)
+	}
+	return frame, nil
+}
+
+// ReadFrame reads frame written by EncodeFrame and returns the message with its ID and data which
+// follows the frame. Frame with wrong checksum is ErrInvalidValue.
+func ReadFrame(data []byte, checksum bool) ([]byte, []byte, error) {
+	r := NewBitReader(data)
+	length := r.ReadVarint(32)
+	if r.Err() != nil {
+		return nil, nil, r.Err()
+	}
+
+	start := r.Pos() / 8
+	end := start + uint(length)
+	size := end
+	if checksum {
+		size += 4
+	}
+	if size > uint(len(data)) {
+		return nil, nil, ErrUnexpectedEnd
+	}
+
+	if checksum && !bytes.Equal(appendChecksum(data[:end:end]), data[:size]) {
+		return nil, nil, ErrInvalidValue
+	}
+	return data[start:end], data[size:], nil
+}
+
+// appendChecksum appends CRC32 (IEEE) of data as 4 bytes, least significant first
+func appendChecksum(data []byte) []byte {
+	sum := crc32.ChecksumIEEE(data)
+	return append(data, byte(sum), byte(sum>>8), byte(sum>>16), byte(sum>>24))
+}
+
+// WritePacketMessage writes value of message with given ID to packet, right after the previous message,
+// as the ID followed by the message. Byte aligned message starts at the next byte boundary.
+func WritePacketMessage(w *BitWriter, id uint16, c *gen.Codec, value interface{}) error {
+	w.SetByteAligned(false)
+	w.WriteVarint(uint64(id), 16)
+	if c.IsByteAligned() {
+		w.bits = (w.bits + 7) &^ 7
+		w.SetByteAligned(true)
+	}
+	err := EncodeValue(w, c, value)
+	w.SetByteAligned(false)
+	return err
+}
+
+// ReadPacketMessage reads message written by WritePacketMessage and returns its ID and value. Codec returns
+// codec of message with given ID, or nil for unknown message, which is ErrInvalidValue.
+func ReadPacketMessage(r *BitReader, codec func(id uint16) *gen.Codec) (uint16, interface{}, error) {
+	id := uint16(r.ReadVarint(16))
+	if r.Err() != nil {
+		return 0, nil, r.Err()
+	}
+
+	c := codec(id)
+	if c == nil {
+		return 0, nil, ErrInvalidValue
+	}
+	if c.IsByteAligned() {
+		r.pos = (r.pos + 7) &^ 7
+		r.SetByteAligned(true)
+	}
+	value := DecodeValue(r, c)
+	r.SetByteAligned(false)
+	if r.Err() != nil {
+		return 0, nil, r.Err()
+	}
+	return id, value, nil
+}
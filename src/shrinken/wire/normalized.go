@@ -0,0 +1,66 @@
+package wire
+
+import (
+	"fmt"
+	"math"
+	"shrinken/gen"
+)
+
+// encodeNormalized writes fields of normalized vector struct as octahedral coordinates of vector
+func encodeNormalized(w *BitWriter, s *gen.Struct, fields Struct) error {
+	var v [3]float64
+	for i, field := range s.AllFields() {
+		f, err := toFloat(fields[field.Name])
+		if err != nil {
+			return fmt.Errorf("%v (field %v of %v)", err, field.Name, s.Name)
+		}
+		v[i] = float64(float32(f))
+	}
+
+	// projection to octahedron |x| + |y| + |z| = 1, vectors which can't be projected point up
+	n := math.Abs(v[0]) + math.Abs(v[1]) + math.Abs(v[2])
+	if n > 0 && !math.IsInf(n, 0) {
+		for i := range v {
+			v[i] /= n
+		}
+	} else {
+		v = [3]float64{0, 0, 1}
+	}
+
+	// lower half of octahedron is folded over upper one
+	x, y := v[0], v[1]
+	if v[2] < 0 {
+		x, y = (1-math.Abs(v[1]))*signNotZero(v[0]), (1-math.Abs(v[0]))*signNotZero(v[1])
+	}
+
+	w.WriteBits(quantize(s.Normalized.Quantization, float32(x)), uint(s.Normalized.Bits))
+	w.WriteBits(quantize(s.Normalized.Quantization, float32(y)), uint(s.Normalized.Bits))
+	return nil
+}
+
+// decodeNormalized reads vector written by encodeNormalized and returns it with unit length
+func decodeNormalized(r *BitReader, s *gen.Struct) Struct {
+	x := float64(DecodeValue(r, s.Normalized).(float32))
+	y := float64(DecodeValue(r, s.Normalized).(float32))
+
+	z := 1 - math.Abs(x) - math.Abs(y)
+	if z < 0 {
+		x, y = (1-math.Abs(y))*signNotZero(x), (1-math.Abs(x))*signNotZero(y)
+	}
+	// explicit conversions keep products from being fused with sums
+	n := math.Sqrt(float64(x*x) + float64(y*y) + float64(z*z))
+
+	fields := make(Struct)
+	for i, field := range s.AllFields() {
+		fields[field.Name] = float32([3]float64{x, y, z}[i] / n)
+	}
+	return fields
+}
+
+// signNotZero returns -1 for negative values and 1 for others, including negative zero
+func signNotZero(v float64) float64 {
+	if v < 0 {
+		return -1
+	}
+	return 1
+}
@@ -0,0 +1,71 @@
+package wire
+
+import (
+	"fmt"
+	"math"
+	"shrinken/gen"
+)
+
+// encodeQuaternion writes fields of quaternion struct as index of their largest component followed
+// by the other three components of normalized quaternion
+func encodeQuaternion(w *BitWriter, s *gen.Struct, fields Struct) error {
+	var q [4]float64
+	for i, field := range s.AllFields() {
+		f, err := toFloat(fields[field.Name])
+		if err != nil {
+			return fmt.Errorf("%v (field %v of %v)", err, field.Name, s.Name)
+		}
+		q[i] = float64(float32(f))
+	}
+
+	// explicit conversions keep products from being fused with sums
+	n := math.Sqrt(float64(q[0]*q[0]) + float64(q[1]*q[1]) + float64(q[2]*q[2]) + float64(q[3]*q[3]))
+	if n > 0 && !math.IsInf(n, 0) {
+		for i := range q {
+			q[i] /= n
+		}
+	}
+
+	largest := 0
+	for i := 1; i < 4; i++ {
+		if math.Abs(q[i]) > math.Abs(q[largest]) {
+			largest = i
+		}
+	}
+	// q and -q are the same rotation
+	if q[largest] < 0 {
+		for i := range q {
+			q[i] = -q[i]
+		}
+	}
+
+	w.WriteBits(uint64(largest), 2)
+	for i := range q {
+		if i != largest {
+			w.WriteBits(quantize(s.Quaternion.Quantization, float32(q[i])), uint(s.Quaternion.Bits))
+		}
+	}
+	return nil
+}
+
+// decodeQuaternion reads quaternion written by encodeQuaternion, its largest component is computed from
+// the other three
+func decodeQuaternion(r *BitReader, s *gen.Struct) Struct {
+	largest := int(r.ReadBits(2))
+
+	var q [4]float32
+	sum := 0.0
+	for i := range q {
+		if i != largest {
+			q[i] = DecodeValue(r, s.Quaternion).(float32)
+			sum += float64(float64(q[i]) * float64(q[i]))
+		}
+	}
+	q[largest] = float32(math.Sqrt(math.Max(0, 1-sum)))
+
+	fields := make(Struct)
+	for i, field := range s.AllFields() {
+		fields[field.Name] = q[i]
+	}
+	return fields
+}
@@ -0,0 +1,527 @@
+package wire
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"shrinken/gen"
+	"unicode/utf8"
+)
+
+// Values are represented by plain Go values:
+//   bool                      bool
+//   byte, ushort, uint, ulong any unsigned or non-negative signed integer, decoded as uint64
+//   short, int, long          any integer, decoded as int64
+//   float, double             float32 or float64, decoded as float32 or float64 (quantized float is rounded to float32 first)
+//   char                      rune (or any other integer holding unicode code point), decoded as rune
+//   string                    string
+//   enum                      index of enumeral or its name, decoded as int index
+//   struct, class             Struct, decoded as Struct with all fields (nil class is encoded as default instance),
+//                             class reference holds class named by TypeKey, see below
+//   array                     slice or array, decoded as []interface{}
+
+// Struct is value of struct or class. Fields are keyed by their name in SDDL, missing fields have zero value.
+type Struct map[string]interface{}
+
+// TypeKey is key of Struct holding qualified name of class (game.Player), when value of class reference is
+// instance of class derived from the referenced one. Decoded values of references which can hold more
+// than one class always have it.
+const TypeKey = "@type"
+
+// Encode encodes value laid out by codec and returns encoded bytes
+func Encode(c *gen.Codec, value interface{}) ([]byte, error) {
+	w := NewBitWriter()
+	w.SetByteAligned(c.IsByteAligned())
+	err := EncodeValue(w, c, value)
+	if err != nil {
+		return nil, err
+	}
+	return w.Bytes(), nil
+}
+
+// Decode decodes single value laid out by codec from data. Bytes after the value are ignored,
+// just like in generated deserializers.
+func Decode(c *gen.Codec, data []byte) (interface{}, error) {
+	r := NewBitReader(data)
+	r.SetByteAligned(c.IsByteAligned())
+	value := DecodeValue(r, c)
+	if r.Err() != nil {
+		return nil, r.Err()
+	}
+	return value, nil
+}
+
+// EncodeValue writes value laid out by codec
+func EncodeValue(w *BitWriter, c *gen.Codec, value interface{}) error {
+	switch c.Kind {
+	case gen.BoolCodec:
+		if value == nil {
+			value = false
+		}
+		b, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("Expected bool, got %T", value)
+		}
+		w.WriteBool(b)
+	case gen.IntCodec:
+		if c.Range != nil {
+			offset, err := rangeOffset(c, value)
+			if err != nil {
+				return err
+			}
+			if c.Huffman != nil {
+				w.WriteSymbol(c.Huffman, offset)
+			} else {
+				w.WriteBits(offset, uint(c.Bits))
+			}
+			break
+		}
+
+		bits, err := integerBits(c, value)
+		if err != nil {
+			return err
+		}
+
+		switch c.Encoding {
+		case gen.VarintEncoding:
+			w.WriteVarint(bits, uint(c.Bits))
+		case gen.ZigZagEncoding:
+			// sign extension of two's complement truncated to bits
+			shift := 64 - uint(c.Bits)
+			w.WriteZigZag(int64(bits<<shift) >> shift)
+		default:
+			w.WriteBits(bits, uint(c.Bits))
+		}
+	case gen.FloatCodec:
+		f, err := toFloat(value)
+		if err != nil {
+			return err
+		}
+		if q := c.Quantization; q != nil {
+			w.WriteBits(quantize(q, float32(f)), uint(c.Bits))
+		} else if c.Bits == 32 {
+			w.WriteFloat32(float32(f))
+		} else {
+			w.WriteFloat64(f)
+		}
+	case gen.CharCodec:
+		if value == nil {
+			value = rune(0)
+		}
+		v, ok := toInt64(value)
+		if !ok || v < 0 || v > math.MaxInt32 || !utf8.ValidRune(rune(v)) {
+			return fmt.Errorf("Expected unicode code point, got %v", value)
+		}
+		if c.IsASCII() && v >= utf8.RuneSelf {
+			return fmt.Errorf("Character %q is not ASCII", rune(v))
+		}
+		w.WriteBits(uint64(v), uint(c.Bits))
+	case gen.StringCodec:
+		if value == nil {
+			value = ""
+		}
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("Expected string, got %T", value)
+		}
+		if c.IsASCII() && !isASCII(s) {
+			return fmt.Errorf("String %q is not ASCII", s)
+		}
+		if !utf8.ValidString(s) {
+			return fmt.Errorf("String %q is not valid UTF-8", s)
+		}
+		if uint64(len(s)) > c.MaxLength {
+			return fmt.Errorf("String of %v bytes is longer than %v", len(s), c.MaxLength)
+		}
+		w.WriteString(s, uint(c.CountBits), uint(c.Bits))
+	case gen.EnumCodec:
+		index, err := enumIndex(c.Enum, value)
+		if err != nil {
+			return err
+		}
+		if c.Huffman != nil {
+			w.WriteSymbol(c.Huffman, uint64(index))
+		} else {
+			w.WriteBits(uint64(index), uint(c.Bits))
+		}
+	case gen.StructCodec:
+		fields, err := toStruct(c.Struct, value)
+		if err != nil {
+			return err
+		}
+
+		s := c.Struct
+		if len(c.Subtypes) > 0 {
+			tag, err := subtypeTag(c, fields[TypeKey])
+			if err != nil {
+				return err
+			}
+			w.WriteBits(uint64(tag), uint(c.Bits))
+			s = c.Subtypes[tag]
+		}
+
+		all := s.AllFields()
+		for name := range fields {
+			if fieldByName(all, name) == nil && (name != TypeKey || len(c.Subtypes) == 0) {
+				return fmt.Errorf("Struct %v has no field %v", s.Name, name)
+			}
+		}
+
+		if s.Quaternion != nil {
+			return encodeQuaternion(w, s, fields)
+		}
+		if s.Normalized != nil {
+			return encodeNormalized(w, s, fields)
+		}
+
+		for _, field := range all {
+			err := EncodeValue(w, field.Codec, fields[field.Name])
+			if err != nil {
+				return fmt.Errorf("%v (field %v of %v)", err, field.Name, s.Name)
+			}
+		}
+	case gen.ArrayCodec:
+		elems := reflect.ValueOf(value)
+		n := 0
+		if value != nil {
+			if elems.Kind() != reflect.Slice && elems.Kind() != reflect.Array {
+				return fmt.Errorf("Expected array, got %T", value)
+			}
+			n = elems.Len()
+		}
+
+		if c.IsDynamic() {
+			if uint64(n) > c.MaxCount {
+				return fmt.Errorf("Array of %v elements is longer than %v", n, c.MaxCount)
+			}
+			w.WriteBits(uint64(n), uint(c.CountBits))
+		} else if n != c.Size {
+			if value != nil {
+				return fmt.Errorf("Array has %v elements, expected %v", n, c.Size)
+			}
+			// missing fixed array is encoded as array of zero values
+			for i := 0; i < c.Size; i++ {
+				EncodeValue(w, c.Elem, nil)
+			}
+			return nil
+		}
+
+		for i := 0; i < n; i++ {
+			err := EncodeValue(w, c.Elem, elems.Index(i).Interface())
+			if err != nil {
+				return fmt.Errorf("%v (element %v)", err, i)
+			}
+		}
+	default:
+		return fmt.Errorf("Unknown codec kind %v", c.Kind)
+	}
+
+	return nil
+}
+
+// DecodeValue reads value laid out by codec. Errors are recorded by reader.
+func DecodeValue(r *BitReader, c *gen.Codec) interface{} {
+	switch c.Kind {
+	case gen.BoolCodec:
+		return r.ReadBool()
+	case gen.IntCodec:
+		if c.Range != nil {
+			var offset uint64
+			if c.Huffman != nil {
+				offset = r.ReadSymbol(c.Huffman)
+			} else {
+				offset = r.ReadBits(uint(c.Bits))
+			}
+			if offset > c.Range.Span() {
+				r.Fail(ErrInvalidValue)
+				offset = 0
+			}
+			if c.Signed {
+				return int64(c.Range.Min + offset)
+			}
+			return c.Range.Min + offset
+		}
+
+		switch c.Encoding {
+		case gen.VarintEncoding:
+			v := r.ReadVarint(uint(c.Bits))
+			if c.Signed {
+				shift := 64 - uint(c.Bits)
+				return int64(v<<shift) >> shift
+			}
+			return v
+		case gen.ZigZagEncoding:
+			return r.ReadZigZag(uint(c.Bits))
+		}
+
+		if c.Signed {
+			return r.ReadSigned(uint(c.Bits))
+		}
+		return r.ReadBits(uint(c.Bits))
+	case gen.FloatCodec:
+		if q := c.Quantization; q != nil {
+			steps := r.ReadBits(uint(c.Bits))
+			if steps > q.Steps {
+				r.Fail(ErrInvalidValue)
+				steps = 0
+			}
+			return float32(q.Min + (q.Max-q.Min)*float64(steps)/float64(q.Steps))
+		}
+		if c.Bits == 32 {
+			return r.ReadFloat32()
+		}
+		return r.ReadFloat64()
+	case gen.CharCodec:
+		v := r.ReadBits(uint(c.Bits))
+		if v > math.MaxInt32 || !utf8.ValidRune(rune(v)) {
+			r.Fail(ErrInvalidValue)
+			return rune(0)
+		}
+		return rune(v)
+	case gen.StringCodec:
+		s := r.ReadString(uint(c.CountBits), uint(c.Bits))
+		if uint64(len(s)) > c.MaxLength || !utf8.ValidString(s) {
+			r.Fail(ErrInvalidValue)
+			return ""
+		}
+		return s
+	case gen.EnumCodec:
+		var index uint64
+		if c.Huffman != nil {
+			index = r.ReadSymbol(c.Huffman)
+		} else {
+			index = r.ReadBits(uint(c.Bits))
+		}
+		if index >= uint64(len(c.Enum.Values)) {
+			r.Fail(ErrInvalidValue)
+			return 0
+		}
+		return int(index)
+	case gen.StructCodec:
+		s := c.Struct
+		if len(c.Subtypes) > 0 {
+			tag := r.ReadBits(uint(c.Bits))
+			if tag >= uint64(len(c.Subtypes)) {
+				r.Fail(ErrInvalidValue)
+				tag = 0
+			}
+			s = c.Subtypes[tag]
+		}
+
+		if s.Quaternion != nil {
+			return decodeQuaternion(r, s)
+		}
+		if s.Normalized != nil {
+			return decodeNormalized(r, s)
+		}
+
+		fields := make(Struct)
+		if c.IsPolymorphic() {
+			fields[TypeKey] = s.QualifiedName()
+		}
+		for _, field := range s.AllFields() {
+			fields[field.Name] = DecodeValue(r, field.Codec)
+		}
+		return fields
+	case gen.ArrayCodec:
+		n := uint64(c.Size)
+		if c.IsDynamic() {
+			n = r.ReadBits(uint(c.CountBits))
+			if n > c.MaxCount {
+				r.Fail(ErrInvalidValue)
+			}
+			if bits := c.Elem.MinBits(); bits > 0 && n > uint64(r.Remaining())/bits {
+				r.Fail(ErrUnexpectedEnd)
+			}
+		}
+
+		elems := make([]interface{}, 0)
+		for i := uint64(0); i < n && r.Err() == nil; i++ {
+			elems = append(elems, DecodeValue(r, c.Elem))
+		}
+		return elems
+	}
+
+	r.Fail(fmt.Errorf("Unknown codec kind %v", c.Kind))
+	return nil
+}
+
+// toStruct returns fields of struct value, nil stands for struct with all fields missing
+func toStruct(s *gen.Struct, value interface{}) (Struct, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case Struct:
+		return v, nil
+	case map[string]interface{}:
+		return v, nil
+	}
+	return nil, fmt.Errorf("Expected struct %v, got %T", s.Name, value)
+}
+
+// subtypeTag returns index of class named by value of TypeKey in subtypes of class reference
+func subtypeTag(c *gen.Codec, name interface{}) (int, error) {
+	if name == nil {
+		return 0, nil
+	}
+
+	for i, s := range c.Subtypes {
+		if s.QualifiedName() == name {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("Class %v is not %v or class derived from it", name, c.Struct.QualifiedName())
+}
+
+func integerBits(c *gen.Codec, value interface{}) (uint64, error) {
+	if value == nil {
+		return 0, nil
+	}
+
+	bits := uint(c.Bits)
+	if u, ok := toUint64(value); ok && !c.Signed {
+		if bits < 64 && u >= 1<<bits {
+			return 0, fmt.Errorf("Value %v doesn't fit %v bit unsigned integer", value, bits)
+		}
+		return u, nil
+	}
+
+	v, ok := toInt64(value)
+	if !ok {
+		if _, ok := toUint64(value); ok {
+			return 0, fmt.Errorf("Value %v doesn't fit %v bit signed integer", value, bits)
+		}
+		return 0, fmt.Errorf("Expected integer, got %T", value)
+	}
+
+	if c.Signed {
+		if bits < 64 && (v < -1<<(bits-1) || v >= 1<<(bits-1)) {
+			return 0, fmt.Errorf("Value %v doesn't fit %v bit signed integer", value, bits)
+		}
+		// two's complement, truncated to bits
+		return uint64(v) & (^uint64(0) >> (64 - bits)), nil
+	}
+
+	return 0, fmt.Errorf("Value %v doesn't fit %v bit unsigned integer", value, bits)
+}
+
+// rangeOffset returns distance of value from lower bound of range, values outside of range are clamped
+func rangeOffset(c *gen.Codec, value interface{}) (uint64, error) {
+	if value == nil {
+		value = 0
+	}
+
+	if c.Signed {
+		v, ok := toInt64(value)
+		if !ok {
+			return 0, fmt.Errorf("Expected signed integer, got %v (%T)", value, value)
+		}
+		if v < int64(c.Range.Min) {
+			v = int64(c.Range.Min)
+		} else if v > int64(c.Range.Max) {
+			v = int64(c.Range.Max)
+		}
+		return uint64(v) - c.Range.Min, nil
+	}
+
+	v, ok := toUint64(value)
+	if !ok {
+		return 0, fmt.Errorf("Expected unsigned integer, got %v (%T)", value, value)
+	}
+	if v < c.Range.Min {
+		v = c.Range.Min
+	} else if v > c.Range.Max {
+		v = c.Range.Max
+	}
+	return v - c.Range.Min, nil
+}
+
+func toFloat(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case float32:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	}
+	return 0, fmt.Errorf("Expected floating point number, got %T", value)
+}
+
+// quantize returns the nearest of steps from lower bound of quantization to value clamped to its bounds
+func quantize(q *gen.Quantization, value float32) uint64 {
+	v := float64(value)
+	if !(v >= q.Min) {
+		v = q.Min
+	} else if v > q.Max {
+		v = q.Max
+	}
+	return uint64((v-q.Min)*float64(q.Steps)/(q.Max-q.Min) + 0.5)
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+func toInt64(value interface{}) (int64, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if v.Uint() > math.MaxInt64 {
+			return 0, false
+		}
+		return int64(v.Uint()), true
+	}
+	return 0, false
+}
+
+func toUint64(value interface{}) (uint64, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Int() < 0 {
+			return 0, false
+		}
+		return uint64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint(), true
+	}
+	return 0, false
+}
+
+func enumIndex(e *gen.Enum, value interface{}) (int, error) {
+	if value == nil {
+		return 0, nil
+	}
+
+	if name, ok := value.(string); ok {
+		for i, enumeral := range e.Values {
+			if enumeral == name {
+				return i, nil
+			}
+		}
+		return 0, fmt.Errorf("Enum %v has no enumeral %v", e.Name, name)
+	}
+
+	index, ok := toInt64(value)
+	if !ok || index < 0 || index >= int64(len(e.Values)) {
+		return 0, fmt.Errorf("Value %v is not enumeral of %v", value, e.Name)
+	}
+	return int(index), nil
+}
+
+func fieldByName(fields []*gen.Field, name string) *gen.Field {
+	for _, field := range fields {
+		if field.Name == name {
+			return field
+		}
+	}
+	return nil
+}
@@ -0,0 +1,8 @@
+// Package wire is reference implementation of shrinken wire format, which is specified in SPEC.md.
+// Serializers generated for every target language have to produce the same bytes as Encode
+// and accept everything Decode accepts, so backends are tested against this package.
+package wire
+
+// Version of wire format specification implemented by this package.
+// It changes whenever the same schema and values could be encoded to different bytes.
+const Version = 16
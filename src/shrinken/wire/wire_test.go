@@ -0,0 +1,981 @@
+package wire
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"shrinken/gen"
+	"shrinken/sddl"
+	"strings"
+	"testing"
+)
+
+func loadStruct(t *testing.T, filename string, name string) *gen.Struct {
+	tree, err := sddl.ParseMergeAndAnalyze(filename)
+	if err != nil {
+		t.Fatal("SDDL couldn't be parsed!", err)
+	}
+
+	schema, err := gen.NewSchema(tree)
+	if err != nil {
+		t.Fatal("Schema couldn't be created!", err)
+	}
+
+	for _, pkg := range schema.Packages {
+		for _, s := range pkg.Structs {
+			if s.Name == name {
+				return s
+			}
+		}
+	}
+
+	t.Fatalf("Struct %v is missing", name)
+	return nil
+}
+
+// sample holds value of every generic type and ranged integers, its encoding is checked against serializer generated by Go backend
+var sample = Struct{
+	"id":       -7,
+	"flag":     true,
+	"b":        200,
+	"s":        -2,
+	"us":       65535,
+	"i":        -100000,
+	"ui":       uint32(1 << 31),
+	"l":        int64(-1 << 62),
+	"ul":       ^uint64(0),
+	"f":        float32(1.5),
+	"d":        -3.25,
+	"c":        'é',
+	"str":      "héllo",
+	"color":    "Blue",
+	"pos":      Struct{"x": float32(1), "y": float32(-2)},
+	"triple":   []int16{-1, 2, -32768},
+	"bigs":     []int64{5, -6},
+	"grid":     [][]interface{}{{Struct{"x": float32(1)}}, {}},
+	"pairs":    [][2]int{{1, 2}, {3, 4}},
+	"next":     Struct{"id": 9},
+	"shapes":   []interface{}{Struct{"id": 1}, nil},
+	"state":    4,
+	"offset":   -9,
+	"near":     255,
+	"constant": 7,
+	"big":      uint64(1002),
+	"full":     int64(-5),
+	"x":        float32(12.34),
+	"ratio":    float32(1) / 3,
+	"coarse":   float32(0.26),
+
+	"fixedInt":    3,
+	"varintInt":   -1,
+	"zigzagShort": -300,
+	"varintByte":  255,
+	"varintLong":  int64(1) << 40,
+
+	"nick":    "bob",
+	"chat":    "¡hola!",
+	"initial": 'Z',
+
+	"payload": []byte{1, 2, 3, 4},
+	"lists":   [][]int{{1, 2, 3}, {}},
+	"matrix":  [][]int{{1}, {2, 3}},
+
+	"entities": []interface{}{
+		Struct{TypeKey: "conformance.Player", "hp": 100, "level": 7},
+		Struct{TypeKey: "conformance.Boss", "hp": 5000, "angry": true, "phase": 2},
+		Struct{"hp": 1},
+		nil,
+	},
+	"boss": Struct{TypeKey: "conformance.Boss", "phase": 3},
+
+	"slots": []string{"Feet", "Head"},
+
+	"rot": Struct{"i": float32(1), "j": float32(-2), "k": float32(0.5), "w": float32(-3)},
+	"aim": Struct{"x": float32(0.5), "y": float32(-2), "z": float32(-1)},
+}
+
+const sampleHex = "0d91fdffffff7f35190001010111fefffffffffffffffffeffffffffffffffff030000807f0000000000001480d30100000c000000d08653d9d8de040000fc01000000feff1700000014000000505808000000000000fc01000000000000001000000010203040202100000040000041b9ffffffffffffff27be6a0a07000000feffffff1fae09fe0300010101014186f82d3e201c8af6c61616a225081018205880c0000b0050006000800000006032808310274600000000010028ebc69a3f625b9200"
+
+func TestBits(t *testing.T) {
+	w := NewBitWriter()
+	w.WriteBits(5, 3)
+	w.WriteBits(0x2AB, 10)
+	w.WriteBits(1, 1)
+
+	if hex.EncodeToString(w.Bytes()) != "5d35" {
+		t.Fatalf("Unexpected bytes %x", w.Bytes())
+	}
+
+	if w.Len() != 14 {
+		t.Fatalf("Expected 14 written bits, got %v", w.Len())
+	}
+
+	r := NewBitReader(w.Bytes())
+	if r.ReadBits(3) != 5 || r.ReadBits(10) != 0x2AB || r.ReadBits(1) != 1 {
+		t.Fatal("Read values differ from written ones")
+	}
+
+	if r.Remaining() != 2 {
+		t.Fatalf("Expected 2 remaining bits, got %v", r.Remaining())
+	}
+
+	r.ReadBits(3)
+	if r.Err() != ErrUnexpectedEnd {
+		t.Fatal("Reading past end of data didn't fail")
+	}
+}
+
+func TestByteAlignedBits(t *testing.T) {
+	w := NewBitWriter()
+	w.SetByteAligned(true)
+	w.WriteBits(5, 3)
+	w.WriteBits(0x2AB, 10)
+	w.WriteBits(7, 0)
+	w.WriteBits(1, 1)
+
+	if hex.EncodeToString(w.Bytes()) != "05ab0201" {
+		t.Fatalf("Unexpected bytes %x", w.Bytes())
+	}
+
+	r := NewBitReader(w.Bytes())
+	r.SetByteAligned(true)
+	if r.ReadBits(3) != 5 || r.ReadBits(10) != 0x2AB || r.ReadBits(0) != 0 || r.ReadBits(1) != 1 {
+		t.Fatal("Read values differ from written ones")
+	}
+
+	if r.Remaining() != 0 {
+		t.Fatalf("Expected no remaining bits, got %v", r.Remaining())
+	}
+}
+
+func TestSigned(t *testing.T) {
+	w := NewBitWriter()
+	w.WriteBits(uint64(0xFFFE), 16)
+	w.WriteBits(uint64(0x7FFF), 16)
+	w.WriteBits(^uint64(0), 64)
+
+	r := NewBitReader(w.Bytes())
+	if v := r.ReadSigned(16); v != -2 {
+		t.Fatalf("Expected -2, got %v", v)
+	}
+	if v := r.ReadSigned(16); v != 32767 {
+		t.Fatalf("Expected 32767, got %v", v)
+	}
+	if v := r.ReadSigned(64); v != -1 {
+		t.Fatalf("Expected -1, got %v", v)
+	}
+}
+
+func TestVarint(t *testing.T) {
+	w := NewBitWriter()
+	w.WriteVarint(300, 32)
+	w.WriteVarint(^uint64(0), 32)
+	w.WriteZigZag(-1)
+	w.WriteZigZag(1)
+	w.WriteZigZag(-1 << 63)
+
+	expected := "ac02ffffffff0f0102ffffffffffffffffff01"
+	if hex.EncodeToString(w.Bytes()) != expected {
+		t.Fatalf("Unexpected bytes %x, expected %v", w.Bytes(), expected)
+	}
+
+	r := NewBitReader(w.Bytes())
+	if v := r.ReadVarint(32); v != 300 {
+		t.Fatalf("Expected 300, got %v", v)
+	}
+	if v := r.ReadVarint(32); v != 0xFFFFFFFF {
+		t.Fatalf("Expected 0xFFFFFFFF, got %v", v)
+	}
+	for _, e := range []int64{-1, 1, -1 << 63} {
+		if v := r.ReadZigZag(64); v != e {
+			t.Fatalf("Expected %v, got %v", e, v)
+		}
+	}
+
+	invalid := []string{
+		"ffffffff1f",           // 33 bits
+		"808080808000",         // 6 groups
+		"ffff",                 // truncated
+		"ffffffffffffffffff02", // 65 bits
+	}
+	for _, h := range invalid {
+		data, _ := hex.DecodeString(h)
+		bits := uint(32)
+		if len(data) > 6 {
+			bits = 64
+		}
+
+		r := NewBitReader(data)
+		r.ReadVarint(bits)
+		if r.Err() == nil {
+			t.Fatalf("Invalid varint %v was decoded", h)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	s := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", "Sample")
+
+	b, err := Encode(s.Codec(), sample)
+	if err != nil {
+		t.Fatal("Sample couldn't be encoded!", err)
+	}
+
+	if hex.EncodeToString(b) != sampleHex {
+		t.Fatalf("Unexpected encoding of sample:\n%x\nexpected:\n%v", b, sampleHex)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	s := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", "Sample")
+
+	data, _ := hex.DecodeString(sampleHex)
+	value, err := Decode(s.Codec(), data)
+	if err != nil {
+		t.Fatal("Sample couldn't be decoded!", err)
+	}
+
+	decoded := value.(Struct)
+	expected := map[string]interface{}{
+		"id":    int64(-7),
+		"b":     uint64(200),
+		"ul":    ^uint64(0),
+		"f":     float32(1.5),
+		"c":     'é',
+		"str":   "héllo",
+		"color": 2,
+		"pos":   Struct{"x": float32(1), "y": float32(-2)},
+		"bigs":  []interface{}{int64(5), int64(-6)},
+		"pairs": []interface{}{[]interface{}{int64(1), int64(2)}, []interface{}{int64(3), int64(4)}},
+		// null reference is decoded as default instance
+		"shapes": []interface{}{
+			Struct{TypeKey: "conformance.Shape", "id": int64(1)},
+			Struct{TypeKey: "conformance.Shape", "id": int64(0)},
+		},
+		"boss":   Struct{TypeKey: "conformance.Boss", "hp": uint64(0), "angry": false, "phase": int64(3)},
+		"offset": int64(-9),
+		"near":   uint64(255),
+		"big":    uint64(1002),
+		"full":   int64(-5),
+		"slots":  []interface{}{2, 0},
+	}
+	for name, e := range expected {
+		if !reflect.DeepEqual(decoded[name], e) {
+			t.Fatalf("Field %v was decoded as %#v, expected %#v", name, decoded[name], e)
+		}
+	}
+
+	// decoded value has to encode to the same bytes
+	b, err := Encode(s.Codec(), decoded)
+	if err != nil {
+		t.Fatal("Decoded sample couldn't be encoded!", err)
+	}
+	if hex.EncodeToString(b) != sampleHex {
+		t.Fatal("Decoded sample was encoded to different bytes")
+	}
+
+	for _, n := range []int{0, 10, len(data) - 1} {
+		_, err = Decode(s.Codec(), data[:n])
+		if err != ErrUnexpectedEnd {
+			t.Fatalf("Decoding first %v bytes of sample didn't fail with unexpected end (%v)", n, err)
+		}
+	}
+}
+
+func TestTruncatedArray(t *testing.T) {
+	s := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", "Bulk")
+
+	// 2^31-1 longs claimed by 4 bytes are rejected before they are allocated
+	_, err := Decode(s.Codec(), []byte{0xff, 0xff, 0xff, 0x7f})
+	if err != ErrUnexpectedEnd {
+		t.Fatal("Array longer than remaining data was decoded, err:", err)
+	}
+
+	// each long is varint of at least 8 bits
+	_, err = Decode(s.Codec(), []byte{2, 0, 0, 0, 0})
+	if err != ErrUnexpectedEnd {
+		t.Fatal("Array of 2 longs was decoded from 1 byte, err:", err)
+	}
+
+	value, err := Decode(s.Codec(), []byte{2, 0, 0, 0, 0, 0, 0})
+	if err != nil {
+		t.Fatal("Array of 2 longs couldn't be decoded from 2 bytes!", err)
+	}
+	if !reflect.DeepEqual(value, Struct{"values": []interface{}{int64(0), int64(0)}, "only": []interface{}{}}) {
+		t.Fatalf("Array of 2 longs was decoded as %v", value)
+	}
+}
+
+func TestDefaults(t *testing.T) {
+	s := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", "Sample")
+
+	empty, err := Encode(s.Codec(), nil)
+	if err != nil {
+		t.Fatal("Default sample couldn't be encoded!", err)
+	}
+
+	zeros, err := Encode(s.Codec(), Struct{"id": 0, "triple": []int{0, 0, 0}, "grid": [2][]int{}, "next": Struct{}})
+	if err != nil {
+		t.Fatal("Sample with zero values couldn't be encoded!", err)
+	}
+
+	if !reflect.DeepEqual(empty, zeros) {
+		t.Fatal("Missing fields are not encoded as default values")
+	}
+}
+
+func TestInvalidValues(t *testing.T) {
+	s := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", "Sample")
+
+	invalid := []Struct{
+		{"b": 256},
+		{"b": -1},
+		{"s": 32768},
+		{"l": ^uint64(0)},
+		{"c": 0xD800},
+		{"str": "\xff"},
+		{"color": 3},
+		{"color": "Purple"},
+		{"triple": []int{1, 2}},
+		{"pos": Struct{"z": float32(1)}},
+		{"unknown": 1},
+		{"flag": 1},
+		{"nick": "héllo"},
+		{"nick": "abcdefghijklmnopq"},
+		{"initial": 'é'},
+		{"lists": [][]int{{1, 2, 3, 4}, {}}},
+		{"matrix": [][]int{{}, {}, {}}},
+	}
+	for _, value := range invalid {
+		_, err := Encode(s.Codec(), value)
+		if err == nil {
+			t.Fatalf("Sample %v was encoded, but expected encoding to fail", value)
+		}
+	}
+
+	// Color has only 3 enumerals
+	_, err := Decode(fieldByName(s.Fields, "color").Codec, []byte{3})
+	if err != ErrInvalidValue {
+		t.Fatalf("Invalid enumeral was decoded (%v)", err)
+	}
+}
+
+func TestEnums(t *testing.T) {
+	s := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", "Sample")
+
+	// three enumerals fit 2 bits
+	c := fieldByName(s.Fields, "slots").Codec
+	if c.Elem.Bits != 2 {
+		t.Fatalf("Slot is written as %v bits, expected 2", c.Elem.Bits)
+	}
+
+	data, err := Encode(c, []string{"Feet", "Body"})
+	if err != nil {
+		t.Fatal("Slots couldn't be encoded!", err)
+	}
+	if !bytes.Equal(data, []byte{0x06}) {
+		t.Fatalf("Slots were encoded as %x, expected 06", data)
+	}
+
+	single := &gen.Enum{Name: "Single", Values: []string{"Only"}}
+	if bits := single.Codec().Bits; bits != 0 {
+		t.Fatalf("Enum with single enumeral is written as %v bits, expected 0", bits)
+	}
+}
+
+func TestRanges(t *testing.T) {
+	s := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", "Sample")
+
+	bits := map[string]int{"state": 3, "offset": 5, "near": 4, "constant": 0, "big": 2, "full": 64}
+	for name, b := range bits {
+		if c := fieldByName(s.Fields, name).Codec; c.Bits != b {
+			t.Fatalf("Field %v takes %v bits, expected %v", name, c.Bits, b)
+		}
+	}
+
+	// values outside of range are clamped to the nearest bound
+	clamped := map[string][2]interface{}{
+		"state":  {-3, int64(0)},
+		"offset": {10, int64(9)},
+		"near":   {0, uint64(250)},
+		"big":    {^uint64(0), uint64(1003)},
+	}
+	for name, v := range clamped {
+		c := fieldByName(s.Fields, name).Codec
+		b, err := Encode(c, v[0])
+		if err != nil {
+			t.Fatalf("Value %v of field %v couldn't be encoded! %v", v[0], name, err)
+		}
+		decoded, err := Decode(c, b)
+		if err != nil || decoded != v[1] {
+			t.Fatalf("Value %v of field %v was decoded as %v, expected %v (%v)", v[0], name, decoded, v[1], err)
+		}
+	}
+
+	// offset 6 doesn't fit range [0, 5]
+	_, err := Decode(fieldByName(s.Fields, "state").Codec, []byte{6})
+	if err != ErrInvalidValue {
+		t.Fatalf("Value outside of range was decoded (%v)", err)
+	}
+}
+
+func TestQuantization(t *testing.T) {
+	s := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", "Sample")
+
+	bits := map[string]int{"x": 15, "ratio": 10, "coarse": 4}
+	for name, b := range bits {
+		if c := fieldByName(s.Fields, name).Codec; c.Bits != b {
+			t.Fatalf("Field %v takes %v bits, expected %v", name, c.Bits, b)
+		}
+	}
+
+	// values are decoded as the nearest step, values outside of range and NaN are clamped
+	coarse := fieldByName(s.Fields, "coarse").Codec
+	decoded := map[float32]float32{
+		0.26:                  0.3,
+		-0.04:                 0,
+		0.5:                   0.5,
+		7:                     0.5,
+		float32(math.Inf(-1)): -0.5,
+		float32(math.NaN()):   -0.5,
+	}
+	for value, expected := range decoded {
+		b, err := Encode(coarse, value)
+		if err != nil {
+			t.Fatalf("Value %v couldn't be encoded! %v", value, err)
+		}
+		v, err := Decode(coarse, b)
+		if err != nil || v != expected {
+			t.Fatalf("Value %v was decoded as %v, expected %v (%v)", value, v, expected, err)
+		}
+	}
+
+	// range [-0.5, 0.5] has only 10 steps
+	_, err := Decode(coarse, []byte{11})
+	if err != ErrInvalidValue {
+		t.Fatalf("Value outside of range was decoded (%v)", err)
+	}
+}
+
+func TestQuaternions(t *testing.T) {
+	s := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", "Rotation")
+
+	// precision 1/1000 divides [-1/sqrt2, 1/sqrt2] to 1415 steps
+	if s.Quaternion.Bits != 11 || s.Quaternion.Quantization.Steps != 1415 {
+		t.Fatalf("Quaternion components take %v bits and %v steps, expected 11 and 1415",
+			s.Quaternion.Bits, s.Quaternion.Quantization.Steps)
+	}
+	if s.Codec().IsDelta() {
+		t.Fatal("Quaternion is written as delta")
+	}
+
+	// quaternion is normalized and negated, so that its largest component is positive
+	decoded := []struct {
+		value, expected [4]float32
+	}{
+		{[4]float32{0, 0, 0, 1}, [4]float32{0, 0, 0, 1}},
+		{[4]float32{0, 0, 0, -2}, [4]float32{0, 0, 0, 1}},
+		{[4]float32{0.6, 0, -0.8, 0}, [4]float32{-0.6, 0, 0.8, 0}},
+		{[4]float32{1, 1, 1, 1}, [4]float32{0.5, 0.5, 0.5, 0.5}},
+		// zero can't be normalized, so its first component becomes the largest one
+		{[4]float32{0, 0, 0, 0}, [4]float32{1, 0, 0, 0}},
+	}
+	for _, d := range decoded {
+		value := Struct{"i": d.value[0], "j": d.value[1], "k": d.value[2], "w": d.value[3]}
+		b, err := Encode(s.Codec(), value)
+		if err != nil {
+			t.Fatalf("Quaternion %v couldn't be encoded! %v", d.value, err)
+		}
+		if len(b) != 5 {
+			t.Fatalf("Quaternion %v was encoded to %v bytes, expected 5", d.value, len(b))
+		}
+
+		v, err := Decode(s.Codec(), b)
+		if err != nil {
+			t.Fatalf("Quaternion %v couldn't be decoded! %v", d.value, err)
+		}
+		for i, name := range []string{"i", "j", "k", "w"} {
+			if math.Abs(float64(v.(Struct)[name].(float32)-d.expected[i])) > 0.001 {
+				t.Fatalf("Quaternion %v was decoded as %v, expected %v", d.value, v, d.expected)
+			}
+		}
+	}
+
+	// 11 bits of component can hold more than 1415 steps
+	_, err := Decode(s.Codec(), []byte{0xff, 0xff, 0xff, 0xff, 0xff})
+	if err != ErrInvalidValue {
+		t.Fatalf("Component outside of range was decoded (%v)", err)
+	}
+}
+
+func TestNormalized(t *testing.T) {
+	s := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", "Dir")
+
+	// 12 bits hold 4094 steps, so that zero is exact
+	if s.Normalized.Bits != 12 || s.Normalized.Quantization.Steps != 4094 {
+		t.Fatalf("Coordinates take %v bits and %v steps, expected 12 and 4094",
+			s.Normalized.Bits, s.Normalized.Quantization.Steps)
+	}
+	if s.Codec().IsDelta() {
+		t.Fatal("Normalized vector is written as delta")
+	}
+
+	// vectors are decoded with unit length
+	decoded := []struct {
+		value, expected [3]float32
+	}{
+		{[3]float32{0, 0, 1}, [3]float32{0, 0, 1}},
+		{[3]float32{0, 0, -5}, [3]float32{0, 0, -1}},
+		{[3]float32{3, 0, 4}, [3]float32{0.6, 0, 0.8}},
+		{[3]float32{0, -3, -4}, [3]float32{0, -0.6, -0.8}},
+		{[3]float32{1, 1, -1}, [3]float32{0.57735, 0.57735, -0.57735}},
+		// zero can't be normalized, so it points up
+		{[3]float32{0, 0, 0}, [3]float32{0, 0, 1}},
+	}
+	for _, d := range decoded {
+		value := Struct{"x": d.value[0], "y": d.value[1], "z": d.value[2]}
+		b, err := Encode(s.Codec(), value)
+		if err != nil {
+			t.Fatalf("Vector %v couldn't be encoded! %v", d.value, err)
+		}
+		if len(b) != 3 {
+			t.Fatalf("Vector %v was encoded to %v bytes, expected 3", d.value, len(b))
+		}
+
+		v, err := Decode(s.Codec(), b)
+		if err != nil {
+			t.Fatalf("Vector %v couldn't be decoded! %v", d.value, err)
+		}
+		for i, name := range []string{"x", "y", "z"} {
+			if math.Abs(float64(v.(Struct)[name].(float32)-d.expected[i])) > 0.001 {
+				t.Fatalf("Vector %v was decoded as %v, expected %v", d.value, v, d.expected)
+			}
+		}
+	}
+
+	// 12 bits of coordinate can hold 4095
+	_, err := Decode(s.Codec(), []byte{0xff, 0x0f, 0x00})
+	if err != ErrInvalidValue {
+		t.Fatalf("Coordinate outside of range was decoded (%v)", err)
+	}
+}
+
+func TestStrings(t *testing.T) {
+	s := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", "Sample")
+
+	counts := map[string]int{"str": 32, "nick": 5, "chat": 9}
+	for name, n := range counts {
+		if c := fieldByName(s.Fields, name).Codec; c.CountBits != n {
+			t.Fatalf("Length of field %v takes %v bits, expected %v", name, c.CountBits, n)
+		}
+	}
+
+	nick := fieldByName(s.Fields, "nick").Codec
+	b, err := Encode(nick, "abcdefghijklmnop")
+	if err != nil {
+		t.Fatal("String of maximum length couldn't be encoded!", err)
+	}
+	if len(b) != 15 {
+		t.Fatalf("String of 16 ASCII characters was encoded to %v bytes, expected 15", len(b))
+	}
+
+	// length 17 fits 5 bits, but not maxLength
+	_, err = Decode(nick, []byte{17, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+	if err != ErrInvalidValue {
+		t.Fatalf("String longer than maxLength was decoded (%v)", err)
+	}
+
+	// 0xC0 starts two byte sequence, but string ends
+	_, err = Decode(fieldByName(s.Fields, "chat").Codec, []byte{1, 0x80, 0x01})
+	if err != ErrInvalidValue {
+		t.Fatalf("Invalid UTF-8 was decoded (%v)", err)
+	}
+}
+
+func TestArrays(t *testing.T) {
+	s := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", "Sample")
+
+	payload := fieldByName(s.Fields, "payload").Codec
+	if payload.CountBits != 8 || payload.MaxCount != 200 {
+		t.Fatalf("Length of payload takes %v bits up to %v, expected 8 bits up to 200", payload.CountBits, payload.MaxCount)
+	}
+
+	// maxCount applies to dynamic arrays at every level
+	lists := fieldByName(s.Fields, "lists").Codec
+	if lists.IsDynamic() || lists.Elem.CountBits != 2 {
+		t.Fatalf("Fixed array of dynamic arrays has unexpected lengths %v, %v", lists.CountBits, lists.Elem.CountBits)
+	}
+	matrix := fieldByName(s.Fields, "matrix").Codec
+	if matrix.CountBits != 2 || matrix.Elem.CountBits != 2 {
+		t.Fatalf("Lengths of nested dynamic arrays take %v and %v bits, expected 2", matrix.CountBits, matrix.Elem.CountBits)
+	}
+	if c := fieldByName(s.Fields, "bigs").Codec; c.CountBits != 32 {
+		t.Fatalf("Length of array without maxCount takes %v bits, expected 32", c.CountBits)
+	}
+
+	// length 201 fits 8 bits, but not maxCount
+	data := make([]byte, 202)
+	data[0] = 201
+	_, err := Decode(payload, data)
+	if err != ErrInvalidValue {
+		t.Fatalf("Array longer than maxCount was decoded (%v)", err)
+	}
+
+	// 3 fits 2 bits, but not maxCount of matrix
+	_, err = Decode(matrix, []byte{3, 0, 0})
+	if err != ErrInvalidValue {
+		t.Fatalf("Array longer than maxCount was decoded (%v)", err)
+	}
+}
+
+func TestEmptyElements(t *testing.T) {
+	dir, err := ioutil.TempDir("", "shrinken")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// single enumeral takes no bits, so only maxCount limits length of array of it
+	schemas := map[string]bool{"": false, "@maxCount: 65537": false, "@maxCount: 65536": true}
+	for attb, valid := range schemas {
+		filename := filepath.Join(dir, "empty.sddl")
+		err = ioutil.WriteFile(filename, []byte(`package empty
+
+enum Only {
+	One,
+}
+
+struct Example {
+	`+attb+`
+	Only[] xs
+}
+`), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tree, err := sddl.ParseMergeAndAnalyze(filename)
+		if err != nil {
+			t.Fatal("SDDL couldn't be parsed!", err)
+		}
+		_, err = gen.NewSchema(tree)
+		if (err == nil) != valid {
+			t.Fatalf("Array of elements which take no bits with %q was accepted: %v, expected %v (%v)", attb, err == nil, valid, err)
+		}
+	}
+
+	// 127 elements are more than maxCount of 100
+	s := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", "Bulk")
+	_, err = Decode(s.Codec(), []byte{0, 0, 0, 0, 0xff})
+	if err != ErrInvalidValue {
+		t.Fatalf("Array of elements which take no bits longer than maxCount was decoded (%v)", err)
+	}
+}
+
+func TestClasses(t *testing.T) {
+	s := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", "Sample")
+
+	// subtypes are the class itself followed by derived classes ordered by qualified name
+	entities := fieldByName(s.Fields, "entities").Codec.Elem
+	var names []string
+	for _, subtype := range entities.Subtypes {
+		names = append(names, subtype.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"Entity", "Boss", "Monster", "Player"}) || entities.Bits != 2 {
+		t.Fatalf("Entity has subtypes %v tagged by %v bits, expected Entity, Boss, Monster, Player and 2 bits", names, entities.Bits)
+	}
+	if c := fieldByName(s.Fields, "boss").Codec; c.Bits != 1 || !c.IsPolymorphic() {
+		t.Fatalf("Tag of Monster takes %v bits, expected 1", c.Bits)
+	}
+	if c := fieldByName(s.Fields, "pos").Codec; c.Bits != 0 || c.IsPolymorphic() {
+		t.Fatal("Struct is polymorphic")
+	}
+
+	// tag 1 selects Boss, whose fields follow
+	b, err := Encode(entities, Struct{TypeKey: "conformance.Boss", "hp": 3, "angry": true, "phase": 3})
+	if err != nil {
+		t.Fatal("Boss couldn't be encoded!", err)
+	}
+	if !bytes.Equal(b, []byte{0x0d, 0x00, 0x14}) {
+		t.Fatalf("Boss was encoded as %x, expected 0d0014", b)
+	}
+
+	// Player isn't Monster
+	_, err = Encode(fieldByName(s.Fields, "boss").Codec, Struct{TypeKey: "conformance.Player"})
+	if err == nil {
+		t.Fatal("Class which isn't derived from referenced class was encoded")
+	}
+	// fields of derived class can't be set without type
+	_, err = Encode(entities, Struct{"level": 1})
+	if err == nil {
+		t.Fatal("Field of derived class was encoded without type")
+	}
+}
+
+func TestAlignment(t *testing.T) {
+	dir, err := ioutil.TempDir("", "shrinken")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "aligned.sddl")
+	err = ioutil.WriteFile(filename, []byte(`@alignment: "byte"
+package aligned
+
+@message
+struct Example {
+	bool flag
+	@range: [0, 5]
+	int state
+	@maxLength: 16
+	@charset: "ascii"
+	string nick
+}
+
+@message
+@alignment: "bit"
+struct Packed {
+	bool flag
+	@range: [0, 5]
+	int state
+	@maxLength: 16
+	@charset: "ascii"
+	string nick
+}
+`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// message uses alignment of its package, unless it has its own
+	value := Struct{"flag": true, "state": 3, "nick": "bob"}
+	expected := map[string]string{"Example": "010303626f62", "Packed": "37c46f31"}
+	for name, e := range expected {
+		s := loadStruct(t, filename, name)
+		b, err := Encode(s.Codec(), value)
+		if err != nil {
+			t.Fatalf("%v couldn't be encoded! %v", name, err)
+		}
+		if hex.EncodeToString(b) != e {
+			t.Fatalf("%v was encoded as %x, expected %v", name, b, e)
+		}
+	}
+
+	// the same message has to survive round trip in both layouts
+	s := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", "Aligned")
+	if !s.ByteAligned {
+		t.Fatal("Message with byte alignment is not byte aligned")
+	}
+
+	aligned := Struct{
+		"flag":  true,
+		"state": 3,
+		"slot":  "Feet",
+		"nick":  "bob",
+		"str":   "héllo",
+		"i":     -300,
+		"d":     -3.25,
+		"x":     float32(12.34),
+		"path":  []interface{}{Struct{"x": float32(1), "y": float32(-2)}, Struct{}},
+		"boss":  Struct{TypeKey: "conformance.Boss", "hp": 5000, "angry": true, "phase": 2},
+		"rot":   Struct{"i": float32(1), "j": float32(-2), "k": float32(0.5), "w": float32(-3)},
+		"aim":   Struct{"x": float32(0.5), "y": float32(-2), "z": float32(-1)},
+	}
+	update := make(Struct)
+	for name, v := range aligned {
+		update[name] = v
+	}
+	update["state"] = 5
+	update["path"] = []interface{}{}
+
+	sizes := make(map[bool]int)
+	for _, byteAligned := range []bool{false, true} {
+		s.ByteAligned = byteAligned
+
+		b, err := Encode(s.Codec(), aligned)
+		if err != nil {
+			t.Fatal("Aligned couldn't be encoded!", err)
+		}
+		decoded, err := Decode(s.Codec(), b)
+		if err != nil {
+			t.Fatal("Aligned couldn't be decoded!", err)
+		}
+		again, err := Encode(s.Codec(), decoded)
+		if err != nil || !bytes.Equal(b, again) {
+			t.Fatalf("Decoded message with byte alignment %v was encoded to different bytes", byteAligned)
+		}
+		_, err = Decode(s.Codec(), b[:len(b)-1])
+		if err != ErrUnexpectedEnd {
+			t.Fatalf("Decoding truncated message with byte alignment %v didn't fail with unexpected end (%v)", byteAligned, err)
+		}
+		sizes[byteAligned] = len(b)
+
+		delta, err := EncodeDelta(s.Codec(), update, aligned)
+		if err != nil {
+			t.Fatal("Update couldn't be encoded!", err)
+		}
+		applied, err := DecodeDelta(s.Codec(), delta, decoded)
+		if err != nil {
+			t.Fatal("Update couldn't be decoded!", err)
+		}
+		expected, _ := Encode(s.Codec(), update)
+		b, err = Encode(s.Codec(), applied)
+		if err != nil || !bytes.Equal(b, expected) {
+			t.Fatalf("Delta with byte alignment %v was decoded as different message", byteAligned)
+		}
+	}
+
+	if sizes[true] <= sizes[false] {
+		t.Fatalf("Byte aligned message takes %v bytes, bit aligned one %v", sizes[true], sizes[false])
+	}
+}
+
+func TestMessageIds(t *testing.T) {
+	// ID is derived from qualified name of message, unless it's set by id attribute
+	expected := map[string]uint16{"Sample": 54680, "Aligned": 300}
+	prefixes := map[string]string{"Sample": "98ab03", "Aligned": "ac02"}
+	for name, id := range expected {
+		s := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", name)
+		if s.Id != id {
+			t.Fatalf("%v has id %v, expected %v", name, s.Id, id)
+		}
+
+		data, err := EncodeAny(s.Id, s.Codec(), Struct{})
+		if err != nil {
+			t.Fatalf("%v couldn't be encoded! %v", name, err)
+		}
+		if !strings.HasPrefix(hex.EncodeToString(data), prefixes[name]) {
+			t.Fatalf("%v was encoded as %x, expected prefix %v", name, data, prefixes[name])
+		}
+
+		decoded, rest, err := ReadMessageID(data)
+		if err != nil {
+			t.Fatalf("Id of %v couldn't be read! %v", name, err)
+		}
+		if decoded != id {
+			t.Fatalf("Id %v was read as %v", id, decoded)
+		}
+		encoded, err := Encode(s.Codec(), Struct{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(rest, encoded) {
+			t.Fatalf("%v was read as %x, expected %x", name, rest, encoded)
+		}
+	}
+
+	// ID doesn't fit 16 bits
+	_, _, err := ReadMessageID([]byte{0xff, 0xff, 0x7f})
+	if err != ErrInvalidValue {
+		t.Fatal("Too large id was read, err:", err)
+	}
+	_, _, err = ReadMessageID([]byte{0x80})
+	if err != ErrUnexpectedEnd {
+		t.Fatal("Truncated id was read, err:", err)
+	}
+}
+
+func TestFrames(t *testing.T) {
+	s := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", "Aligned")
+	message, err := EncodeAny(s.Id, s.Codec(), Struct{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, checksum := range []bool{false, true} {
+		frame, err := EncodeFrame(s.Id, s.Codec(), Struct{}, checksum)
+		if err != nil {
+			t.Fatal("Frame couldn't be encoded!", err)
+		}
+
+		// length, message with its ID and checksum
+		size := 1 + len(message)
+		if checksum {
+			size += 4
+		}
+		if len(frame) != size || frame[0] != byte(len(message)) {
+			t.Fatalf("Frame was encoded as %x, expected %v bytes starting with length %v", frame, size, len(message))
+		}
+
+		data, rest, err := ReadFrame(append(frame, 0x42), checksum)
+		if err != nil {
+			t.Fatal("Frame couldn't be read!", err)
+		}
+		if !bytes.Equal(data, message) || !bytes.Equal(rest, []byte{0x42}) {
+			t.Fatalf("Frame was read as %x and rest %x", data, rest)
+		}
+
+		_, _, err = ReadFrame(frame[:len(frame)-1], checksum)
+		if err != ErrUnexpectedEnd {
+			t.Fatal("Truncated frame was read, err:", err)
+		}
+	}
+
+	frame, err := EncodeFrame(s.Id, s.Codec(), Struct{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	frame[2] ^= 1
+	_, _, err = ReadFrame(frame, true)
+	if err != ErrInvalidValue {
+		t.Fatal("Frame with wrong checksum was read, err:", err)
+	}
+}
+
+func TestPackets(t *testing.T) {
+	sample := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", "Sample")
+	aligned := loadStruct(t, "../sddl/test_data/wire/conformance.sddl", "Aligned")
+	codecs := map[uint16]*gen.Codec{sample.Id: sample.Codec(), aligned.Id: aligned.Codec()}
+
+	w := NewBitWriter()
+	order := []*gen.Struct{sample, sample, aligned, sample}
+	var ends []uint
+	for _, s := range order {
+		err := WritePacketMessage(w, s.Id, s.Codec(), Struct{})
+		if err != nil {
+			t.Fatal("Message couldn't be written to packet!", err)
+		}
+		ends = append(ends, w.Len())
+	}
+
+	// messages follow each other without padding, except for byte aligned message
+	if ends[1] != 2*ends[0] || ends[0]%8 == 0 {
+		t.Fatalf("Messages of %v bits were written to packet as %v bits", ends[0], ends[1])
+	}
+
+	r := NewBitReader(w.Bytes())
+	for i, s := range order {
+		id, value, err := ReadPacketMessage(r, func(id uint16) *gen.Codec { return codecs[id] })
+		if err != nil {
+			t.Fatalf("Message %v couldn't be read from packet! %v", i, err)
+		}
+		if id != s.Id {
+			t.Fatalf("Message %v was read with id %v, expected %v", i, id, s.Id)
+		}
+		data, err := Encode(s.Codec(), Struct{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected, err := Decode(s.Codec(), data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(value, expected) {
+			t.Fatalf("Message %v was read as %v, expected %v", i, value, expected)
+		}
+		if r.Pos() != ends[i] {
+			t.Fatalf("Message %v ended at bit %v, expected %v", i, r.Pos(), ends[i])
+		}
+	}
+
+	r = NewBitReader([]byte{0x01})
+	_, _, err := ReadPacketMessage(r, func(id uint16) *gen.Codec { return codecs[id] })
+	if err != ErrInvalidValue {
+		t.Fatal("Message with unknown id was read, err:", err)
+	}
+}
@@ -0,0 +1,421 @@
+// Package wiretest provides conformance test of serializers generated by language backends against
+// reference implementation of wire format in package wire.
+package wiretest
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"os/exec"
+	"shrinken/gen"
+	"shrinken/sddl"
+	"shrinken/wire"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Schema is path of conformance schema relative to directory of backend package
+const Schema = "../../sddl/test_data/wire/conformance.sddl"
+
+// Tables is path of entropy tables of conformance schema relative to directory of backend package
+const Tables = "../../sddl/test_data/wire/conformance.entropy.json"
+
+// Sample is value of message Sample from conformance schema. It holds value of every generic type,
+// nested arrays, null class reference, ranged integers, quaternion and normalized vector.
+var Sample = wire.Struct{
+	"id":       -7,
+	"flag":     true,
+	"b":        200,
+	"s":        -2,
+	"us":       65535,
+	"i":        -100000,
+	"ui":       uint32(1 << 31),
+	"l":        int64(-1 << 62),
+	"ul":       ^uint64(0),
+	"f":        float32(1.5),
+	"d":        -3.25,
+	"c":        'é',
+	"str":      "héllo",
+	"color":    "Blue",
+	"pos":      wire.Struct{"x": float32(1), "y": float32(-2)},
+	"triple":   []int16{-1, 2, -32768},
+	"bigs":     []int64{5, -6},
+	"grid":     [][]interface{}{{wire.Struct{"x": float32(1)}}, {}},
+	"pairs":    [][2]int{{1, 2}, {3, 4}},
+	"next":     wire.Struct{"id": 9},
+	"shapes":   []interface{}{wire.Struct{"id": 1}, nil},
+	"state":    4,
+	"offset":   -9,
+	"near":     255,
+	"constant": 7,
+	"big":      uint64(1002),
+	"full":     int64(-5),
+	"x":        float32(12.34),
+	"ratio":    float32(1) / 3,
+	"coarse":   float32(0.26),
+
+	"fixedInt":    3,
+	"varintInt":   -1,
+	"zigzagShort": -300,
+	"varintByte":  255,
+	"varintLong":  int64(1) << 40,
+
+	"nick":    "bob",
+	"chat":    "¡hola!",
+	"initial": 'Z',
+
+	"payload": []byte{1, 2, 3, 4},
+	"lists":   [][]int{{1, 2, 3}, {}},
+	"matrix":  [][]int{{1}, {2, 3}},
+
+	"entities": []interface{}{
+		wire.Struct{wire.TypeKey: "conformance.Player", "hp": 100, "level": 7},
+		wire.Struct{wire.TypeKey: "conformance.Boss", "hp": 5000, "angry": true, "phase": 2},
+		wire.Struct{"hp": 1},
+		nil,
+	},
+	"boss": wire.Struct{wire.TypeKey: "conformance.Boss", "phase": 3},
+
+	"slots": []string{"Feet", "Head"},
+
+	"rot": wire.Struct{"i": float32(1), "j": float32(-2), "k": float32(0.5), "w": float32(-3)},
+	"aim": wire.Struct{"x": float32(0.5), "y": float32(-2), "z": float32(-1)},
+}
+
+// Update is Sample with few changed fields, including fields of nested structs. Delta encoding is tested
+// by encoding it against Sample as baseline.
+var Update = update(Sample, wire.Struct{
+	"flag":   false,
+	"str":    "hello",
+	"pos":    wire.Struct{"x": float32(1), "y": float32(5)},
+	"bigs":   []int64{5, -6, 7},
+	"offset": 3,
+	"x":      float32(-1.5),
+	"slots":  []string{"Feet", "Body"},
+	"rot":    wire.Struct{"i": float32(0), "j": float32(0.6), "k": float32(0), "w": float32(0.8)},
+	"aim":    wire.Struct{"x": float32(0), "y": float32(0), "z": float32(1)},
+})
+
+// Aligned is value of byte aligned message Aligned from conformance schema
+var Aligned = wire.Struct{
+	"flag":  true,
+	"state": 3,
+	"slot":  "Feet",
+	"nick":  "bob",
+	"str":   "héllo",
+	"i":     -300,
+	"d":     -3.25,
+	"x":     float32(12.34),
+	"path":  []interface{}{wire.Struct{"x": float32(1), "y": float32(-2)}, wire.Struct{}},
+	"boss":  wire.Struct{wire.TypeKey: "conformance.Boss", "hp": 5000, "angry": true, "phase": 2},
+	"rot":   wire.Struct{"i": float32(1), "j": float32(-2), "k": float32(0.5), "w": float32(-3)},
+	"aim":   wire.Struct{"x": float32(0.5), "y": float32(-2), "z": float32(-1)},
+	"coded": Coded,
+}
+
+// Coded is value of entropy coded message Coded from conformance schema, its fields hold both frequent
+// and rare values
+var Coded = wire.Struct{
+	"color":  "Blue",
+	"state":  0,
+	"offset": -9,
+	"flag":   true,
+	"slots":  []string{"Body", "Head"},
+}
+
+func update(value wire.Struct, changes wire.Struct) wire.Struct {
+	updated := make(wire.Struct)
+	for name, v := range value {
+		updated[name] = v
+	}
+	for name, v := range changes {
+		updated[name] = v
+	}
+	return updated
+}
+
+// Encode returns Sample encoded by reference encoder
+func Encode(t *testing.T) []byte {
+	data, err := wire.Encode(conformanceStruct(t, "Sample").Codec(), Sample)
+	if err != nil {
+		t.Fatal("Sample couldn't be encoded!", err)
+	}
+	return data
+}
+
+// EncodeDelta returns Update encoded by reference encoder as delta against Sample
+func EncodeDelta(t *testing.T) []byte {
+	data, err := wire.EncodeDelta(conformanceStruct(t, "Sample").Codec(), Update, Sample)
+	if err != nil {
+		t.Fatal("Update couldn't be encoded!", err)
+	}
+	return data
+}
+
+// Parse parses and analyzes SDDL file, conformance schema is parsed together with its entropy tables
+func Parse(t *testing.T, filename string) *sddl.SDDLTree {
+	tree, err := sddl.ParseMergeAndAnalyze(filename)
+	if err != nil {
+		t.Fatal("SDDL couldn't be parsed!", err)
+	}
+
+	if filename == Schema {
+		err = gen.LoadEntropyTables(tree, Tables)
+		if err != nil {
+			t.Fatal("Entropy tables of conformance schema couldn't be loaded!", err)
+		}
+	}
+	return tree
+}
+
+func conformanceStruct(t *testing.T, name string) *gen.Struct {
+	schema, err := gen.NewSchema(Parse(t, Schema))
+	if err != nil {
+		t.Fatal("Conformance schema couldn't be parsed!", err)
+	}
+
+	for _, pkg := range schema.Packages {
+		for _, s := range pkg.Structs {
+			if s.Name == name {
+				return s
+			}
+		}
+	}
+
+	t.Fatalf("Conformance schema doesn't contain %v", name)
+	return nil
+}
+
+// Required is environment variable which makes conformance tests fail rather than skip when
+// executable they need is missing, so that CI can't pass without running them
+const Required = "SHRINKEN_CONFORMANCE"
+
+// Tool returns path of executable needed to run conformance test, test is skipped if there is none
+// unless Required environment variable is set
+func Tool(t *testing.T, names ...string) string {
+	if testing.Short() {
+		t.Skip("Conformance test is skipped in short mode")
+	}
+
+	for _, name := range names {
+		path, err := exec.LookPath(name)
+		if err == nil {
+			return path
+		}
+	}
+
+	if os.Getenv(Required) != "" {
+		t.Fatalf("Conformance test needs %v, but it's not in PATH and %v is set", strings.Join(names, " or "), Required)
+	}
+
+	t.Skipf("Conformance test needs %v", strings.Join(names, " or "))
+	return ""
+}
+
+// Build runs command which compiles conformance program
+func Build(t *testing.T, cmd *exec.Cmd) {
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("Conformance program couldn't be built! %v\n%s", err, output)
+	}
+}
+
+// Run runs program which reads hex encoded Sample from standard input, deserializes it with generated
+// code, serializes it again and writes result as hex to standard output. Result has to match input.
+func Run(t *testing.T, cmd *exec.Cmd) {
+	data := Encode(t)
+
+	output := run(t, cmd, hex.EncodeToString(data))
+	if output != hex.EncodeToString(data) {
+		t.Fatalf("Generated code encoded sample as\n%v\nexpected\n%x", output, data)
+	}
+}
+
+// RunDelta runs program with argument delta, which reads two lines of hex from standard input, Sample
+// and delta of Update against it. Program deserializes Sample as baseline and its copy, applies delta
+// to the copy, and writes copy serialized whole and as delta against baseline, each as hex on its own line.
+func RunDelta(t *testing.T, cmd *exec.Cmd) {
+	cmd.Args = append(cmd.Args, "delta")
+	data := Encode(t)
+	delta := EncodeDelta(t)
+
+	update, err := wire.Encode(conformanceStruct(t, "Sample").Codec(), Update)
+	if err != nil {
+		t.Fatal("Update couldn't be encoded!", err)
+	}
+
+	output := run(t, cmd, hex.EncodeToString(data)+"\n"+hex.EncodeToString(delta)+"\n")
+	expected := hex.EncodeToString(update) + "\n" + hex.EncodeToString(delta)
+	if output != expected {
+		t.Fatalf("Generated code decoded and encoded delta as\n%v\nexpected\n%v", output, expected)
+	}
+}
+
+// RunAligned runs program with argument aligned, which reads hex encoded Aligned from standard input and
+// writes it serialized again as hex to standard output, just like Run does with Sample
+func RunAligned(t *testing.T, cmd *exec.Cmd) {
+	cmd.Args = append(cmd.Args, "aligned")
+	data, err := wire.Encode(conformanceStruct(t, "Aligned").Codec(), Aligned)
+	if err != nil {
+		t.Fatal("Aligned couldn't be encoded!", err)
+	}
+
+	output := run(t, cmd, hex.EncodeToString(data))
+	if output != hex.EncodeToString(data) {
+		t.Fatalf("Generated code encoded aligned message as\n%v\nexpected\n%x", output, data)
+	}
+}
+
+// RunCoded runs program with argument coded, which reads hex encoded Coded from standard input and
+// writes it serialized again as hex to standard output, just like Run does with Sample
+func RunCoded(t *testing.T, cmd *exec.Cmd) {
+	cmd.Args = append(cmd.Args, "coded")
+	data, err := wire.Encode(conformanceStruct(t, "Coded").Codec(), Coded)
+	if err != nil {
+		t.Fatal("Coded couldn't be encoded!", err)
+	}
+
+	output := run(t, cmd, hex.EncodeToString(data))
+	if output != hex.EncodeToString(data) {
+		t.Fatalf("Generated code encoded entropy coded message as\n%v\nexpected\n%x", output, data)
+	}
+}
+
+// RunTruncated runs program with argument truncated, which deserializes Bulk from standard input and writes
+// the error it failed with to standard output, or ok. Input claims 2^31-1 elements of array without maxCount
+// in 4 bytes, which decoders have to reject as unexpected end before they allocate the array. Then it claims
+// 127 elements which take no bits, over maxCount of 100, which decoders have to reject without allocating too.
+func RunTruncated(t *testing.T, cmd *exec.Cmd) {
+	cmd.Args = append(cmd.Args, "truncated")
+	empty := clone(cmd)
+
+	output := run(t, cmd, "ffffff7f\n")
+	if !strings.Contains(strings.ToLower(output), "unexpected end") {
+		t.Fatalf("Generated code deserialized truncated message with %q, expected unexpected end of data", output)
+	}
+
+	output = run(t, empty, "00000000ff\n")
+	if output == "ok" {
+		t.Fatal("Generated code deserialized array of empty elements longer than its maxCount")
+	}
+}
+
+// RunAny runs program with argument any, which reads Sample, Aligned and Coded, each serialized with its
+// message ID as hex on its own line. Program decodes each of them with generated dispatcher and writes it
+// serialized again with its ID as hex on its own line.
+func RunAny(t *testing.T, cmd *exec.Cmd) {
+	cmd.Args = append(cmd.Args, "any")
+
+	values := []wire.Struct{Sample, Aligned, Coded}
+	lines := make([]string, len(values))
+	for i, name := range []string{"Sample", "Aligned", "Coded"} {
+		s := conformanceStruct(t, name)
+		data, err := wire.EncodeAny(s.Id, s.Codec(), values[i])
+		if err != nil {
+			t.Fatalf("%v couldn't be encoded! %v", name, err)
+		}
+		lines[i] = hex.EncodeToString(data)
+	}
+
+	input := strings.Join(lines, "\n")
+	output := run(t, cmd, input+"\n")
+	if output != input {
+		t.Fatalf("Generated code encoded messages with their IDs as\n%v\nexpected\n%v", output, input)
+	}
+}
+
+// RunFrames runs conformance program with argument frames, which reads messages from stream of checksummed
+// frames and writes each of them as a frame again, or line corrupt for corrupted frame. Stream holds
+// Sample, Aligned after three bytes of garbage, Coded with corrupted byte and Sample again.
+func RunFrames(t *testing.T, cmd *exec.Cmd) {
+	cmd.Args = append(cmd.Args, "frames")
+
+	values := []wire.Struct{Sample, Aligned, Coded}
+	frames := make([][]byte, len(values))
+	for i, name := range []string{"Sample", "Aligned", "Coded"} {
+		s := conformanceStruct(t, name)
+		frame, err := wire.EncodeFrame(s.Id, s.Codec(), values[i], true)
+		if err != nil {
+			t.Fatalf("%v couldn't be encoded! %v", name, err)
+		}
+		frames[i] = frame
+	}
+
+	coded := append([]byte(nil), frames[2]...)
+	coded[len(coded)/2] ^= 0x10
+
+	var stream []byte
+	stream = append(stream, frames[0]...)
+	stream = append(stream, 0xff, 0xff, 0xff)
+	stream = append(stream, frames[1]...)
+	stream = append(stream, coded...)
+	stream = append(stream, frames[0]...)
+
+	expected := strings.Join([]string{
+		hex.EncodeToString(frames[0]),
+		"corrupt",
+		hex.EncodeToString(frames[1]),
+		"corrupt",
+		hex.EncodeToString(frames[0]),
+	}, "\n")
+	output := run(t, cmd, hex.EncodeToString(stream)+"\n")
+	if output != expected {
+		t.Fatalf("Generated code read frames as\n%v\nexpected\n%v", output, expected)
+	}
+}
+
+// RunPacket runs conformance program with arguments packet and budget, which reads messages from packet
+// and packs them again into packets of at most budget bytes, writing each of them on its own line.
+// Packet holds Sample, Aligned, Coded and Sample again, and budget fits only the first three.
+func RunPacket(t *testing.T, cmd *exec.Cmd) {
+	values := []wire.Struct{Sample, Aligned, Coded, Sample}
+	names := []string{"Sample", "Aligned", "Coded", "Sample"}
+	packet := func(from, to int) []byte {
+		w := wire.NewBitWriter()
+		for i := from; i < to; i++ {
+			s := conformanceStruct(t, names[i])
+			err := wire.WritePacketMessage(w, s.Id, s.Codec(), values[i])
+			if err != nil {
+				t.Fatalf("%v couldn't be encoded! %v", names[i], err)
+			}
+		}
+		return w.Bytes()
+	}
+
+	first := packet(0, 3)
+	cmd.Args = append(cmd.Args, "packet", strconv.Itoa(len(first)))
+
+	expected := hex.EncodeToString(first) + "\n" + hex.EncodeToString(packet(3, 4))
+	output := run(t, cmd, hex.EncodeToString(packet(0, 4))+"\n")
+	if output != expected {
+		t.Fatalf("Generated code packed messages as\n%v\nexpected\n%v", output, expected)
+	}
+}
+
+// clone returns copy of command which wasn't started yet, so that program can run again with another input
+func clone(cmd *exec.Cmd) *exec.Cmd {
+	c := exec.Command(cmd.Path)
+	c.Args = append([]string(nil), cmd.Args...)
+	c.Dir = cmd.Dir
+	c.Env = cmd.Env
+	return c
+}
+
+// run runs conformance program with input and returns its trimmed output
+func run(t *testing.T, cmd *exec.Cmd, input string) string {
+	var stdout, stderr bytes.Buffer
+	cmd.Stdin = strings.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+
+	err := cmd.Run()
+	if err != nil {
+		t.Fatalf("Conformance program failed! %v\n%v", err, stderr.String())
+	}
+
+	return strings.TrimSpace(stdout.String())
+}